@@ -5,16 +5,38 @@ import (
 	"cli-project/external/api"
 	"cli-project/internal/app/repositories"
 	"cli-project/internal/app/services"
+	"cli-project/internal/cli"
+	"cli-project/internal/config"
+	"cli-project/internal/config/drivers"
+	"cli-project/internal/server"
 	"cli-project/internal/ui"
+	"cli-project/pkg/utils/credentials"
+	"cli-project/pkg/utils/tokens"
+	"flag"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"syscall"
+	"time"
 )
 
 func main() {
 
-	defer repositories.CloseMongoClient()
+	// Storage driver can be picked with flags or environment variables, Mongo stays the default
+	storage := flag.String("storage", envOrDefault(config.STORAGE_DRIVER_ENV, drivers.MONGO), "storage driver to use: mongo or bolt")
+	dsn := flag.String("db", os.Getenv(config.STORAGE_DSN_ENV), "MongoDB URI or Bolt database file")
+	httpAddr := flag.String("http", os.Getenv(config.HTTP_ADDR_ENV), "serve the REST API on this address instead of showing the menus, e.g. :8080")
+	flag.Parse()
+
+	// Initialize Storage Driver
+	storageDriver, err := repositories.NewStorageDriver(*storage, *dsn)
+	if err != nil {
+		log.Fatalf("Failed to initialize storage driver: %v", err)
+	}
+
+	defer closeStorage(storageDriver.Close)
 
 	// Setup graceful shutdown
 	sigChan := make(chan os.Signal, 1)
@@ -24,26 +46,47 @@ func main() {
 		sig := <-sigChan
 		log.Printf("Received signal: %s. Shutting down gracefully...", sig)
 
-		// Call the function to close MongoDB client
-		repositories.CloseMongoClient()
+		// Close the storage driver before exiting
+		closeStorage(storageDriver.Close)
 
 		os.Exit(0)
 	}()
 
 	// Initialize User Repository
-	userRepo := repositories.NewUserRepo()
+	userRepo := storageDriver.UserRepository()
 	if userRepo == nil {
 		log.Fatal("Failed to initialize UserRepository")
 	}
 
+	// Carry solved questions recorded before solve events existed over to the history
+	migrated, err := userRepo.MigrateSolveHistory()
+	if err != nil {
+		log.Fatalf("Failed to migrate solve history: %v", err)
+	}
+	if migrated > 0 {
+		log.Printf("Migrated solve history for %d users", migrated)
+	}
+
 	// Initialize Question Repository
-	questionRepo := repositories.NewQuestionRepo()
+	questionRepo := storageDriver.QuestionRepository()
 	if questionRepo == nil {
 		log.Fatal("Failed to initialize QuestionRepository")
 	}
 
+	// Initialize Access Service, the other services check the permissions of the acting user's role with it
+	accessService := services.NewAccessService(userRepo, storageDriver.BanRepository())
+	if accessService == nil {
+		log.Fatal("Failed to initialize AccessService")
+	}
+
+	// Initialize Audit Service, privileged actions of the question and user services are recorded with it
+	auditService := services.NewAuditService(storageDriver.AuditRepository(), userRepo, accessService)
+	if auditService == nil {
+		log.Fatal("Failed to initialize AuditService")
+	}
+
 	// Initialize Question Service
-	questionService := services.NewQuestionService(questionRepo)
+	questionService := services.NewQuestionService(questionRepo, auditService, accessService)
 	if questionService == nil {
 		log.Fatal("Failed to initialize QuestionService")
 	}
@@ -51,8 +94,18 @@ func main() {
 	// Initialize Leetcode Service
 	LeetcodeAPI := api.NewLeetcodeAPI()
 
+	// Initialize Session Service
+	sessionSecret, err := loadSessionSecret()
+	if err != nil {
+		log.Fatalf("Failed to load session secret: %v", err)
+	}
+	sessionService := services.NewSessionService(storageDriver.SessionRepository(), sessionSecret)
+	if sessionService == nil {
+		log.Fatal("Failed to initialize SessionService")
+	}
+
 	// Initialize User Service
-	userService := services.NewUserService(userRepo, questionService, LeetcodeAPI)
+	userService := services.NewUserService(userRepo, storageDriver.BanRepository(), questionService, sessionService, LeetcodeAPI, auditService, accessService)
 	if userService == nil {
 		log.Fatal("Failed to initialize UserService")
 	}
@@ -63,8 +116,68 @@ func main() {
 		log.Fatal("Failed to initialize AuthService")
 	}
 
+	// Initialize Leaderboard Service
+	leaderboardService := services.NewLeaderboardService(storageDriver.LeaderboardRepository())
+	if leaderboardService == nil {
+		log.Fatal("Failed to initialize LeaderboardService")
+	}
+
+	// Initialize Review Service
+	reviewService := services.NewReviewService(storageDriver.ReviewRepository(), questionService)
+	if reviewService == nil {
+		log.Fatal("Failed to initialize ReviewService")
+	}
+
+	// Initialize Recommendation Service
+	recommendationService := services.NewRecommendationService(userService, questionService)
+	if recommendationService == nil {
+		log.Fatal("Failed to initialize RecommendationService")
+	}
+
+	// Initialize Study List Service
+	studyListService := services.NewStudyListService(storageDriver.StudyListRepository(), userService, questionService, accessService)
+	if studyListService == nil {
+		log.Fatal("Failed to initialize StudyListService")
+	}
+
+	// Initialize Note Service
+	noteService := services.NewNoteService(storageDriver.NoteRepository(), questionService)
+	if noteService == nil {
+		log.Fatal("Failed to initialize NoteService")
+	}
+
+	// Initialize Announcement Service
+	announcementService := services.NewAnnouncementService(storageDriver.AnnouncementRepository(), userService, accessService)
+	if announcementService == nil {
+		log.Fatal("Failed to initialize AnnouncementService")
+	}
+
+	// Serve the REST API when an address is given
+	if *httpAddr != "" {
+		httpServer := &http.Server{
+			Addr:              *httpAddr,
+			Handler:           server.NewServer(userService, questionService, authService, reviewService, recommendationService, studyListService, noteService, announcementService, auditService),
+			ReadHeaderTimeout: 10 * time.Second,
+			ReadTimeout:       30 * time.Second,
+			WriteTimeout:      time.Minute,
+		}
+		log.Printf("Serving the REST API on %s", *httpAddr)
+		if err := httpServer.ListenAndServe(); err != nil {
+			closeStorage(storageDriver.Close)
+			log.Fatalf("HTTP server stopped: %v", err)
+		}
+		return
+	}
+
+	// Run a single subcommand when one is given, otherwise show the menus
+	if flag.NArg() > 0 {
+		code := cli.NewCLI(userService, questionService, reviewService, recommendationService, studyListService, noteService, announcementService, auditService, os.Stdin, os.Stdout, os.Stderr).Run(flag.Args())
+		closeStorage(storageDriver.Close)
+		os.Exit(code)
+	}
+
 	// Initialize UI
-	newUI := ui.NewUI(authService, userService, questionService, bufio.NewReader(os.Stdin))
+	newUI := ui.NewUI(authService, userService, questionService, leaderboardService, reviewService, recommendationService, studyListService, noteService, announcementService, auditService, bufio.NewReader(os.Stdin))
 	if newUI == nil {
 		log.Fatal("Failed to initialize UI")
 	}
@@ -72,3 +185,24 @@ func main() {
 	// Show Main Menu
 	newUI.ShowMainMenu()
 }
+
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// loadSessionSecret returns the key session tokens are signed with, shared deployments can set it explicitly.
+func loadSessionSecret() ([]byte, error) {
+	if secret := os.Getenv(config.SESSION_SECRET_ENV); secret != "" {
+		return []byte(secret), nil
+	}
+	return tokens.LoadOrCreateSecret(filepath.Join(credentials.Dir(), config.SESSION_KEY_FILE))
+}
+
+func closeStorage(closeFunc func() error) {
+	if err := closeFunc(); err != nil {
+		log.Printf("Failed to close storage driver: %v", err)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 type LeetcodeAPI struct{}
@@ -46,41 +48,8 @@ func (api *LeetcodeAPI) GetStats(LeetcodeID string) (*models.LeetcodeStats, erro
 		}
 	}`
 
-	// Function to perform GraphQL request
-	fetchData := func(query string, variables map[string]interface{}) (map[string]interface{}, error) {
-		requestBody := map[string]interface{}{
-			"query":     query,
-			"variables": variables,
-		}
-		jsonBody, err := json.Marshal(requestBody)
-		if err != nil {
-			return nil, fmt.Errorf("could not marshal request body: %v", err)
-		}
-
-		resp, err := http.Post(config.Leetcode_API, "application/json", bytes.NewBuffer(jsonBody))
-		if err != nil {
-			return nil, fmt.Errorf("request failed: %v", err)
-		}
-		defer resp.Body.Close()
-
-		if resp.StatusCode != http.StatusOK {
-			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-		}
-
-		var result map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			return nil, fmt.Errorf("could not decode response: %v", err)
-		}
-
-		data, ok := result["data"].(map[string]interface{})
-		if !ok {
-			return nil, fmt.Errorf("invalid response format")
-		}
-		return data, nil
-	}
-
 	// Fetch user stats
-	statsData, err := fetchData(userStatsQuery, map[string]interface{}{"username": LeetcodeID})
+	statsData, err := fetchGraphQL(userStatsQuery, map[string]interface{}{"username": LeetcodeID})
 	if err != nil {
 		return nil, err
 	}
@@ -132,7 +101,7 @@ func (api *LeetcodeAPI) GetStats(LeetcodeID string) (*models.LeetcodeStats, erro
 	}
 
 	// Fetch recent accepted submissions
-	submissionsData, err := fetchData(recentSubmissionsQuery, map[string]interface{}{"username": LeetcodeID, "limit": recentLimit})
+	submissionsData, err := fetchGraphQL(recentSubmissionsQuery, map[string]interface{}{"username": LeetcodeID, "limit": recentLimit})
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +118,91 @@ func (api *LeetcodeAPI) GetStats(LeetcodeID string) (*models.LeetcodeStats, erro
 	return stats, nil
 }
 
+// GetRecentACSubmissions fetches the user's most recent accepted submissions, newest first
+func (api *LeetcodeAPI) GetRecentACSubmissions(LeetcodeID string) (*[]models.LeetcodeSubmission, error) {
+
+	recentSubmissionsQuery := `
+	query recentAcSubmissions($username: String!, $limit: Int!) {
+		recentAcSubmissionList(username: $username, limit: $limit) {
+			title
+			titleSlug
+			lang
+			timestamp
+		}
+	}`
+
+	data, err := fetchGraphQL(recentSubmissionsQuery, map[string]interface{}{"username": LeetcodeID, "limit": config.SYNC_SUBMISSION_LIMIT})
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := []models.LeetcodeSubmission{}
+
+	recentSubmissions, ok := data["recentAcSubmissionList"].([]interface{})
+	if !ok {
+		return &submissions, nil
+	}
+
+	for _, item := range recentSubmissions {
+		submission, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		var parsed models.LeetcodeSubmission
+		parsed.Title, _ = submission["title"].(string)
+		parsed.TitleSlug, _ = submission["titleSlug"].(string)
+		parsed.Language, _ = submission["lang"].(string)
+
+		// Leetcode sends the timestamp as a string of unix seconds
+		if timestamp, ok := submission["timestamp"].(string); ok {
+			if seconds, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+				parsed.SubmittedAt = time.Unix(seconds, 0).UTC()
+			}
+		}
+
+		if parsed.TitleSlug == "" {
+			continue
+		}
+		submissions = append(submissions, parsed)
+	}
+
+	return &submissions, nil
+}
+
+// fetchGraphQL performs a GraphQL request against the Leetcode API and returns its data
+func fetchGraphQL(query string, variables map[string]interface{}) (map[string]interface{}, error) {
+	requestBody := map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	}
+	jsonBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal request body: %v", err)
+	}
+
+	resp, err := http.Post(config.Leetcode_API, "application/json", bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var result map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("could not decode response: %v", err)
+	}
+
+	data, ok := result["data"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid response format")
+	}
+	return data, nil
+}
+
 func (api *LeetcodeAPI) ValidateUsername(username string) (bool, error) {
 
 	// GraphQL query to check if a Leetcode user exists
@@ -4,5 +4,6 @@ import "cli-project/internal/domain/models"
 
 type LeetcodeAPI interface {
 	GetStats(LeetcodeID string) (*models.LeetcodeStats, error)
+	GetRecentACSubmissions(LeetcodeID string) (*[]models.LeetcodeSubmission, error)
 	ValidateUsername(username string) (bool, error)
 }
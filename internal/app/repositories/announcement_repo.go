@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type announcementRepo struct {
+	driver *mongoDriver
+}
+
+func NewAnnouncementRepo(driver *mongoDriver) interfaces.AnnouncementRepository {
+	return &announcementRepo{driver: driver}
+}
+
+func (r *announcementRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.ANNOUNCEMENT_COLLECTION), nil
+}
+
+func (r *announcementRepo) CreateAnnouncement(announcement *models.Announcement) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	if _, err := collection.InsertOne(ctx, announcement); err != nil {
+		return fmt.Errorf("could not create announcement: %v", err)
+	}
+
+	return nil
+}
+
+func (r *announcementRepo) FetchAnnouncementByID(announcementID string) (*models.Announcement, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	var announcement models.Announcement
+	err = collection.FindOne(ctx, bson.M{"id": announcementID}).Decode(&announcement)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongo.ErrNoDocuments
+		}
+		return nil, fmt.Errorf("could not fetch announcement: %v", err)
+	}
+
+	return &announcement, nil
+}
+
+// FetchAnnouncements returns every announcement, pinned ones first and then the newest first
+func (r *announcementRepo) FetchAnnouncements() (*[]models.Announcement, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "pinned", Value: -1}, {Key: "created_at", Value: -1}})
+
+	cursor, err := collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch announcements: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	announcements := []models.Announcement{}
+	if err := cursor.All(ctx, &announcements); err != nil {
+		return nil, fmt.Errorf("could not decode announcements: %v", err)
+	}
+
+	return &announcements, nil
+}
+
+// UpdateAnnouncement replaces the announcement, its read receipts are kept as they are stored
+func (r *announcementRepo) UpdateAnnouncement(announcement *models.Announcement) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{
+		"title":      announcement.Title,
+		"body":       announcement.Body,
+		"audience":   announcement.Audience,
+		"target":     announcement.Target,
+		"pinned":     announcement.Pinned,
+		"updated_at": announcement.UpdatedAt,
+		"expires_at": announcement.ExpiresAt,
+	}}
+
+	result, err := collection.UpdateOne(ctx, bson.M{"id": announcement.ID}, update)
+	if err != nil {
+		return fmt.Errorf("could not update announcement: %v", err)
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+// AddReadReceipt records that a user read the announcement, a user who already read it keeps their first receipt
+func (r *announcementRepo) AddReadReceipt(announcementID string, receipt models.ReadReceipt) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{"id": announcementID, "reads.user_id": bson.M{"$ne": receipt.UserID}}
+	update := bson.M{"$push": bson.M{"reads": receipt}}
+
+	result, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("could not record read receipt: %v", err)
+	}
+	if result.MatchedCount > 0 {
+		return nil
+	}
+
+	// Nothing matched, either because it was already read or because there is no such announcement
+	count, err := collection.CountDocuments(ctx, bson.M{"id": announcementID})
+	if err != nil {
+		return fmt.Errorf("could not record read receipt: %v", err)
+	}
+	if count == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
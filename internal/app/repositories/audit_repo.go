@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"regexp"
+)
+
+type auditRepo struct {
+	driver *mongoDriver
+}
+
+func NewAuditRepo(driver *mongoDriver) interfaces.AuditRepository {
+	return &auditRepo{driver: driver}
+}
+
+func (r *auditRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.AUDIT_COLLECTION), nil
+}
+
+func (r *auditRepo) AppendAuditEntry(entry *models.AuditEntry) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	if _, err := collection.InsertOne(ctx, entry); err != nil {
+		return fmt.Errorf("could not append audit entry: %v", err)
+	}
+
+	return nil
+}
+
+// FetchAuditEntries returns one page of the matching entries, the newest first
+func (r *auditRepo) FetchAuditEntries(filter models.AuditFilter, page models.PageRequest) (*models.AuditPage, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	query := auditQuery(filter)
+	total, err := collection.CountDocuments(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("could not count audit entries: %v", err)
+	}
+
+	opts := options.Find().
+		SetSort(bson.D{{Key: "timestamp", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(int64((page.Page - 1) * page.PageSize)).
+		SetLimit(int64(page.PageSize))
+
+	cursor, err := collection.Find(ctx, query, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch audit entries: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []models.AuditEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("could not decode audit entries: %v", err)
+	}
+
+	return &models.AuditPage{Entries: entries, PageInfo: newPageInfo(page, total)}, nil
+}
+
+// FetchAllAuditEntries returns every matching entry in the order they were recorded
+func (r *auditRepo) FetchAllAuditEntries(filter models.AuditFilter) (*[]models.AuditEntry, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	// Timestamps are stored to the millisecond, the generated _id keeps entries within one in insertion order
+	opts := options.Find().SetSort(bson.D{{Key: "timestamp", Value: 1}, {Key: "_id", Value: 1}})
+
+	cursor, err := collection.Find(ctx, auditQuery(filter), opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch audit entries: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	entries := []models.AuditEntry{}
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("could not decode audit entries: %v", err)
+	}
+
+	return &entries, nil
+}
+
+// auditQuery builds the Mongo query of an audit filter
+func auditQuery(filter models.AuditFilter) bson.M {
+	query := bson.M{}
+	if filter.Actor != "" {
+		query["$or"] = bson.A{bson.M{"actor_id": filter.Actor}, bson.M{"actor_name": filter.Actor}}
+	}
+	if filter.Action != "" {
+		pattern := "^" + regexp.QuoteMeta(filter.Action) + `(\.|$)`
+		query["action"] = primitive.Regex{Pattern: pattern}
+	}
+	if filter.Target != "" {
+		query["target"] = filter.Target
+	}
+	timestamp := bson.M{}
+	if !filter.Since.IsZero() {
+		timestamp["$gte"] = filter.Since
+	}
+	if !filter.Until.IsZero() {
+		timestamp["$lt"] = filter.Until
+	}
+	if len(timestamp) > 0 {
+		query["timestamp"] = timestamp
+	}
+	return query
+}
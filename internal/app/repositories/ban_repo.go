@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type banRepo struct {
+	driver *mongoDriver
+}
+
+func NewBanRepo(driver *mongoDriver) interfaces.BanRepository {
+	return &banRepo{driver: driver}
+}
+
+func (r *banRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.BAN_COLLECTION), nil
+}
+
+func (r *banRepo) CreateBan(ban *models.Ban) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	if _, err := collection.InsertOne(ctx, ban); err != nil {
+		return fmt.Errorf("could not create ban: %v", err)
+	}
+
+	return nil
+}
+
+func (r *banRepo) FetchBanByID(banID string) (*models.Ban, error) {
+	return r.findOne(bson.M{"id": banID}, nil)
+}
+
+// FetchActiveBan returns the newest ban on the user that has not been lifted
+func (r *banRepo) FetchActiveBan(userID string) (*models.Ban, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "started_at", Value: -1}})
+	return r.findOne(bson.M{"user_id": userID, "lifted_at": time.Time{}}, opts)
+}
+
+// FetchBansByAppealStatus returns the bans with an appeal in the status, the oldest appeal first
+func (r *banRepo) FetchBansByAppealStatus(status string) (*[]models.Ban, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "appeal.submitted_at", Value: 1}})
+
+	cursor, err := collection.Find(ctx, bson.M{"appeal.status": status}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch bans: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	bans := []models.Ban{}
+	if err := cursor.All(ctx, &bans); err != nil {
+		return nil, fmt.Errorf("could not decode bans: %v", err)
+	}
+
+	return &bans, nil
+}
+
+// UpdateBan stores when the ban was lifted and its appeal, what the ban was for does not change
+func (r *banRepo) UpdateBan(ban *models.Ban) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	update := bson.M{"$set": bson.M{
+		"lifted_at": ban.LiftedAt,
+		"lifted_by": ban.LiftedBy,
+		"appeal":    ban.Appeal,
+	}}
+
+	result, err := collection.UpdateOne(ctx, bson.M{"id": ban.ID}, update)
+	if err != nil {
+		return fmt.Errorf("could not update ban: %v", err)
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (r *banRepo) findOne(filter bson.M, opts *options.FindOneOptions) (*models.Ban, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	if opts == nil {
+		opts = options.FindOne()
+	}
+
+	var ban models.Ban
+	err = collection.FindOne(ctx, filter, opts).Decode(&ban)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongo.ErrNoDocuments
+		}
+		return nil, fmt.Errorf("could not fetch ban: %v", err)
+	}
+
+	return &ban, nil
+}
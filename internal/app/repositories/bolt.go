@@ -0,0 +1,216 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/drivers"
+	"cli-project/internal/domain/interfaces"
+	"errors"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/bson"
+	"time"
+)
+
+var errBoltStop = errors.New("stop iteration")
+
+// boltBuckets holds one bucket per collection, they are created when the database is opened
+var boltBuckets = []string{
+	config.USER_COLLECTION,
+	config.QUESTION_COLLECTION,
+	config.SESSION_COLLECTION,
+	config.REVIEW_COLLECTION,
+	config.STUDY_LIST_COLLECTION,
+	config.NOTE_COLLECTION,
+	config.ANNOUNCEMENT_COLLECTION,
+	config.AUDIT_COLLECTION,
+	config.BAN_COLLECTION,
+}
+
+type boltDriver struct {
+	db               *bolt.DB
+	userRepo         interfaces.UserRepository
+	questionRepo     interfaces.QuestionRepository
+	leaderboardRepo  interfaces.LeaderboardRepository
+	sessionRepo      interfaces.SessionRepository
+	reviewRepo       interfaces.ReviewRepository
+	studyListRepo    interfaces.StudyListRepository
+	noteRepo         interfaces.NoteRepository
+	announcementRepo interfaces.AnnouncementRepository
+	auditRepo        interfaces.AuditRepository
+	banRepo          interfaces.BanRepository
+}
+
+// NewBoltDriver opens (or creates) the embedded database file at path and returns the Bolt backed repositories.
+func NewBoltDriver(path string) (interfaces.StorageDriver, error) {
+	if path == "" {
+		path = config.BOLT_DB_PATH
+	}
+
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 2 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt database: %v", err)
+	}
+
+	// Make sure every collection exists before the repositories start using them
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range boltBuckets {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("failed to create bolt buckets: %v", err)
+	}
+
+	return &boltDriver{
+		db:               db,
+		userRepo:         NewBoltUserRepo(db),
+		questionRepo:     NewBoltQuestionRepo(db),
+		leaderboardRepo:  NewBoltLeaderboardRepo(db),
+		sessionRepo:      NewBoltSessionRepo(db),
+		reviewRepo:       NewBoltReviewRepo(db),
+		studyListRepo:    NewBoltStudyListRepo(db),
+		noteRepo:         NewBoltNoteRepo(db),
+		announcementRepo: NewBoltAnnouncementRepo(db),
+		auditRepo:        NewBoltAuditRepo(db),
+		banRepo:          NewBoltBanRepo(db),
+	}, nil
+}
+
+func (d *boltDriver) Name() string {
+	return drivers.BOLT
+}
+
+func (d *boltDriver) UserRepository() interfaces.UserRepository {
+	return d.userRepo
+}
+
+func (d *boltDriver) QuestionRepository() interfaces.QuestionRepository {
+	return d.questionRepo
+}
+
+func (d *boltDriver) LeaderboardRepository() interfaces.LeaderboardRepository {
+	return d.leaderboardRepo
+}
+
+func (d *boltDriver) SessionRepository() interfaces.SessionRepository {
+	return d.sessionRepo
+}
+
+func (d *boltDriver) ReviewRepository() interfaces.ReviewRepository {
+	return d.reviewRepo
+}
+
+func (d *boltDriver) StudyListRepository() interfaces.StudyListRepository {
+	return d.studyListRepo
+}
+
+func (d *boltDriver) NoteRepository() interfaces.NoteRepository {
+	return d.noteRepo
+}
+
+func (d *boltDriver) AnnouncementRepository() interfaces.AnnouncementRepository {
+	return d.announcementRepo
+}
+
+func (d *boltDriver) AuditRepository() interfaces.AuditRepository {
+	return d.auditRepo
+}
+
+func (d *boltDriver) BanRepository() interfaces.BanRepository {
+	return d.banRepo
+}
+
+func (d *boltDriver) Close() error {
+	return d.db.Close()
+}
+
+// Documents are stored as BSON so both drivers share the bson tags on the models.
+
+func boltPut(tx *bolt.Tx, bucket, key string, value interface{}) error {
+	data, err := bson.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("could not encode document: %v", err)
+	}
+	return tx.Bucket([]byte(bucket)).Put([]byte(key), data)
+}
+
+// boltGet decodes the document stored under key into out and reports whether it was found.
+func boltGet(tx *bolt.Tx, bucket, key string, out interface{}) (bool, error) {
+	data := tx.Bucket([]byte(bucket)).Get([]byte(key))
+	if data == nil {
+		return false, nil
+	}
+	if err := bson.Unmarshal(data, out); err != nil {
+		return false, fmt.Errorf("could not decode document: %v", err)
+	}
+	return true, nil
+}
+
+// boltEach decodes every document in bucket and passes it to fn until fn returns false.
+func boltEach[T any](tx *bolt.Tx, bucket string, fn func(key string, doc *T) bool) error {
+	err := tx.Bucket([]byte(bucket)).ForEach(func(k, v []byte) error {
+		var doc T
+		if err := bson.Unmarshal(v, &doc); err != nil {
+			return fmt.Errorf("could not decode document: %v", err)
+		}
+		if !fn(string(k), &doc) {
+			return errBoltStop
+		}
+		return nil
+	})
+	if errors.Is(err, errBoltStop) {
+		return nil
+	}
+	return err
+}
+
+// boltFind returns every document in bucket accepted by match.
+func boltFind[T any](db *bolt.DB, bucket string, match func(*T) bool) ([]T, error) {
+	var docs []T
+	err := db.View(func(tx *bolt.Tx) error {
+		return boltEach(tx, bucket, func(_ string, doc *T) bool {
+			if match == nil || match(doc) {
+				docs = append(docs, *doc)
+			}
+			return true
+		})
+	})
+	return docs, err
+}
+
+// boltFindOne returns the first document in bucket accepted by match, or nil when none is.
+func boltFindOne[T any](db *bolt.DB, bucket string, match func(*T) bool) (*T, error) {
+	var found *T
+	err := db.View(func(tx *bolt.Tx) error {
+		return boltEach(tx, bucket, func(_ string, doc *T) bool {
+			if match(doc) {
+				found = doc
+				return false
+			}
+			return true
+		})
+	})
+	return found, err
+}
+
+// boltModify loads the document under key, lets fn change it and writes it back in one transaction.
+func boltModify[T any](db *bolt.DB, bucket, key string, fn func(*T) error) (bool, error) {
+	found := false
+	err := db.Update(func(tx *bolt.Tx) error {
+		var doc T
+		ok, err := boltGet(tx, bucket, key, &doc)
+		if err != nil || !ok {
+			return err
+		}
+		found = true
+		if err := fn(&doc); err != nil {
+			return err
+		}
+		return boltPut(tx, bucket, key, &doc)
+	})
+	return found, err
+}
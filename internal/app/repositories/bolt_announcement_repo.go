@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+)
+
+type boltAnnouncementRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltAnnouncementRepo(db *bolt.DB) interfaces.AnnouncementRepository {
+	return &boltAnnouncementRepo{db: db}
+}
+
+func (r *boltAnnouncementRepo) CreateAnnouncement(announcement *models.Announcement) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.ANNOUNCEMENT_COLLECTION, announcement.ID, announcement)
+	})
+	if err != nil {
+		return fmt.Errorf("could not create announcement: %v", err)
+	}
+	return nil
+}
+
+func (r *boltAnnouncementRepo) FetchAnnouncementByID(announcementID string) (*models.Announcement, error) {
+	var announcement models.Announcement
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.ANNOUNCEMENT_COLLECTION, announcementID, &announcement)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch announcement: %v", err)
+	}
+	if !found {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &announcement, nil
+}
+
+func (r *boltAnnouncementRepo) FetchAnnouncements() (*[]models.Announcement, error) {
+	announcements, err := boltFind[models.Announcement](r.db, config.ANNOUNCEMENT_COLLECTION, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch announcements: %v", err)
+	}
+	if announcements == nil {
+		announcements = []models.Announcement{}
+	}
+
+	// Same order as the Mongo sort, pinned first and then the newest first
+	sort.SliceStable(announcements, func(i, j int) bool {
+		if announcements[i].Pinned != announcements[j].Pinned {
+			return announcements[i].Pinned
+		}
+		return announcements[i].CreatedAt.After(announcements[j].CreatedAt)
+	})
+	return &announcements, nil
+}
+
+func (r *boltAnnouncementRepo) UpdateAnnouncement(announcement *models.Announcement) error {
+	found, err := boltModify(r.db, config.ANNOUNCEMENT_COLLECTION, announcement.ID, func(stored *models.Announcement) error {
+		reads := stored.Reads
+		*stored = *announcement
+		stored.Reads = reads
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update announcement: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *boltAnnouncementRepo) AddReadReceipt(announcementID string, receipt models.ReadReceipt) error {
+	found, err := boltModify(r.db, config.ANNOUNCEMENT_COLLECTION, announcementID, func(stored *models.Announcement) error {
+		for _, read := range stored.Reads {
+			if read.UserID == receipt.UserID {
+				return nil
+			}
+		}
+		stored.Reads = append(stored.Reads, receipt)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not record read receipt: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type boltAuditRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltAuditRepo(db *bolt.DB) interfaces.AuditRepository {
+	return &boltAuditRepo{db: db}
+}
+
+// AppendAuditEntry keys entries by a sequence number, so the bucket iterates them in the order they were recorded
+func (r *boltAuditRepo) AppendAuditEntry(entry *models.AuditEntry) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		sequence, err := tx.Bucket([]byte(config.AUDIT_COLLECTION)).NextSequence()
+		if err != nil {
+			return err
+		}
+		return boltPut(tx, config.AUDIT_COLLECTION, fmt.Sprintf("%020d", sequence), entry)
+	})
+	if err != nil {
+		return fmt.Errorf("could not append audit entry: %v", err)
+	}
+	return nil
+}
+
+// FetchAuditEntries sorts the matching entries in memory, the newest first as in the Mongo repository.
+// Entries recorded within the same millisecond keep the reverse of the order they were recorded in.
+func (r *boltAuditRepo) FetchAuditEntries(filter models.AuditFilter, page models.PageRequest) (*models.AuditPage, error) {
+	entries, err := r.findAuditEntries(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.After(entries[j].Timestamp)
+	})
+
+	start, end := pageBounds(len(entries), page)
+	return &models.AuditPage{Entries: entries[start:end], PageInfo: newPageInfo(page, int64(len(entries)))}, nil
+}
+
+func (r *boltAuditRepo) FetchAllAuditEntries(filter models.AuditFilter) (*[]models.AuditEntry, error) {
+	entries, err := r.findAuditEntries(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	return &entries, nil
+}
+
+func (r *boltAuditRepo) findAuditEntries(filter models.AuditFilter) ([]models.AuditEntry, error) {
+	entries, err := boltFind(r.db, config.AUDIT_COLLECTION, func(entry *models.AuditEntry) bool {
+		return auditEntryMatches(entry, filter)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch audit entries: %v", err)
+	}
+	if entries == nil {
+		entries = []models.AuditEntry{}
+	}
+	return entries, nil
+}
+
+// auditEntryMatches applies an audit filter the same way as the Mongo query
+func auditEntryMatches(entry *models.AuditEntry, filter models.AuditFilter) bool {
+	if filter.Actor != "" && entry.ActorID != filter.Actor && entry.ActorName != filter.Actor {
+		return false
+	}
+	if filter.Action != "" && entry.Action != filter.Action && !strings.HasPrefix(entry.Action, filter.Action+".") {
+		return false
+	}
+	if filter.Target != "" && entry.Target != filter.Target {
+		return false
+	}
+	if !filter.Since.IsZero() && entry.Timestamp.Before(filter.Since) {
+		return false
+	}
+	if !filter.Until.IsZero() && !entry.Timestamp.Before(filter.Until) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+)
+
+type boltBanRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltBanRepo(db *bolt.DB) interfaces.BanRepository {
+	return &boltBanRepo{db: db}
+}
+
+func (r *boltBanRepo) CreateBan(ban *models.Ban) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.BAN_COLLECTION, ban.ID, ban)
+	})
+	if err != nil {
+		return fmt.Errorf("could not create ban: %v", err)
+	}
+	return nil
+}
+
+func (r *boltBanRepo) FetchBanByID(banID string) (*models.Ban, error) {
+	var ban models.Ban
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.BAN_COLLECTION, banID, &ban)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch ban: %v", err)
+	}
+	if !found {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &ban, nil
+}
+
+func (r *boltBanRepo) FetchActiveBan(userID string) (*models.Ban, error) {
+	bans, err := boltFind(r.db, config.BAN_COLLECTION, func(ban *models.Ban) bool {
+		return ban.UserID == userID && ban.LiftedAt.IsZero()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch ban: %v", err)
+	}
+	if len(bans) == 0 {
+		return nil, mongo.ErrNoDocuments
+	}
+
+	// Same as the Mongo sort, the newest ban wins
+	newest := bans[0]
+	for _, ban := range bans[1:] {
+		if ban.StartedAt.After(newest.StartedAt) {
+			newest = ban
+		}
+	}
+	return &newest, nil
+}
+
+func (r *boltBanRepo) FetchBansByAppealStatus(status string) (*[]models.Ban, error) {
+	bans, err := boltFind(r.db, config.BAN_COLLECTION, func(ban *models.Ban) bool {
+		return ban.Appeal != nil && ban.Appeal.Status == status
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch bans: %v", err)
+	}
+	if bans == nil {
+		bans = []models.Ban{}
+	}
+
+	sort.SliceStable(bans, func(i, j int) bool {
+		return bans[i].Appeal.SubmittedAt.Before(bans[j].Appeal.SubmittedAt)
+	})
+	return &bans, nil
+}
+
+func (r *boltBanRepo) UpdateBan(ban *models.Ban) error {
+	found, err := boltModify(r.db, config.BAN_COLLECTION, ban.ID, func(stored *models.Ban) error {
+		stored.LiftedAt = ban.LiftedAt
+		stored.LiftedBy = ban.LiftedBy
+		stored.Appeal = ban.Appeal
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update ban: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+)
+
+type boltLeaderboardRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltLeaderboardRepo(db *bolt.DB) interfaces.LeaderboardRepository {
+	return &boltLeaderboardRepo{db: db}
+}
+
+func (r *boltLeaderboardRepo) FetchSolveCounts(organisation, country string) (*[]models.LeaderboardEntry, error) {
+	var entries []models.LeaderboardEntry
+
+	err := r.db.View(func(tx *bolt.Tx) error {
+		// Difficulty of every question in the bank, the equivalent of the Mongo $lookup
+		difficulties := make(map[string]string)
+		err := boltEach(tx, config.QUESTION_COLLECTION, func(_ string, question *models.Question) bool {
+			difficulties[question.QuestionID] = question.Difficulty
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		return boltEach(tx, config.USER_COLLECTION, func(_ string, user *models.StandardUser) bool {
+			if !roles.IsRanked(user.StandardUser.Role) || user.StandardUser.IsBanned {
+				return true
+			}
+			if organisation != "" && user.StandardUser.Organisation != organisation {
+				return true
+			}
+			if country != "" && user.StandardUser.Country != country {
+				return true
+			}
+
+			entry := models.LeaderboardEntry{
+				UserID:       user.StandardUser.ID,
+				Username:     user.StandardUser.Username,
+				Name:         user.StandardUser.Name,
+				Organisation: user.StandardUser.Organisation,
+				Country:      user.StandardUser.Country,
+			}
+			for _, questionID := range user.QuestionsSolved {
+				switch difficulties[questionID] {
+				case "easy":
+					entry.EasySolved++
+				case "medium":
+					entry.MediumSolved++
+				case "hard":
+					entry.HardSolved++
+				}
+			}
+			entries = append(entries, entry)
+			return true
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not count solved questions: %v", err)
+	}
+
+	return &entries, nil
+}
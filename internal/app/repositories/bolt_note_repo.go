@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+)
+
+type boltNoteRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltNoteRepo(db *bolt.DB) interfaces.NoteRepository {
+	return &boltNoteRepo{db: db}
+}
+
+// noteKey keeps one note per user and question, like the Mongo upsert filter
+func noteKey(userID, questionID string) string {
+	return userID + "/" + questionID
+}
+
+func (r *boltNoteRepo) UpsertNote(note *models.Note) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.NOTE_COLLECTION, noteKey(note.UserID, note.QuestionID), note)
+	})
+	if err != nil {
+		return fmt.Errorf("could not save note: %v", err)
+	}
+	return nil
+}
+
+func (r *boltNoteRepo) FetchNote(userID, questionID string) (*models.Note, error) {
+	var note models.Note
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.NOTE_COLLECTION, noteKey(userID, questionID), &note)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch note: %v", err)
+	}
+	if !found {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &note, nil
+}
+
+func (r *boltNoteRepo) FetchNotes(userID string) (*[]models.Note, error) {
+	notes, err := boltFind(r.db, config.NOTE_COLLECTION, func(note *models.Note) bool {
+		return note.UserID == userID
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch notes: %v", err)
+	}
+
+	// Most recently updated first, as the Mongo repository sorts them
+	sort.SliceStable(notes, func(i, j int) bool {
+		return notes[i].UpdatedAt.After(notes[j].UpdatedAt)
+	})
+	return &notes, nil
+}
+
+func (r *boltNoteRepo) DeleteNote(userID, questionID string) error {
+	found := false
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(config.NOTE_COLLECTION))
+		key := []byte(noteKey(userID, questionID))
+		if bucket.Get(key) == nil {
+			return nil
+		}
+		found = true
+		return bucket.Delete(key)
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete note: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,200 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/sorting"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+	"strings"
+)
+
+type boltQuestionRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltQuestionRepo(db *bolt.DB) interfaces.QuestionRepository {
+	return &boltQuestionRepo{db: db}
+}
+
+func (r *boltQuestionRepo) AddQuestionsByID(questionID *[]string) error {
+	// Placeholder implementation
+
+	return nil
+}
+
+func (r *boltQuestionRepo) AddQuestions(questions *[]models.Question) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		for _, question := range *questions {
+			if err := boltPut(tx, config.QUESTION_COLLECTION, question.QuestionID, question); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not insert questions: %v", err)
+	}
+	return nil
+}
+
+func (r *boltQuestionRepo) UpsertQuestions(questions *[]models.Question) error {
+	// Puts overwrite existing keys, so this is the same write as AddQuestions
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		for _, question := range *questions {
+			if err := boltPut(tx, config.QUESTION_COLLECTION, question.QuestionID, question); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not upsert questions: %v", err)
+	}
+	return nil
+}
+
+func (r *boltQuestionRepo) RemoveQuestionByID(questionID string) error {
+	found := false
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(config.QUESTION_COLLECTION))
+		if bucket.Get([]byte(questionID)) == nil {
+			return nil
+		}
+		found = true
+		return bucket.Delete([]byte(questionID))
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete question: %v", err)
+	}
+	if !found {
+		return fmt.Errorf("question with ID %s not found", questionID)
+	}
+	return nil
+}
+
+func (r *boltQuestionRepo) UpdateQuestion(question *models.Question) error {
+	found, err := boltModify(r.db, config.QUESTION_COLLECTION, question.QuestionID, func(stored *models.Question) error {
+		*stored = *question
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update question: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *boltQuestionRepo) FetchQuestionByID(questionID string) (*models.Question, error) {
+	var question models.Question
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.QUESTION_COLLECTION, questionID, &question)
+		return err
+	})
+	if err != nil {
+		return &models.Question{}, fmt.Errorf("could not fetch question: %v", err)
+	}
+	if !found {
+		return &models.Question{}, fmt.Errorf("question with ID %s not found", questionID)
+	}
+	return &question, nil
+}
+
+func (r *boltQuestionRepo) FetchAllQuestions() (*[]models.Question, error) {
+	questions, err := boltFind[models.Question](r.db, config.QUESTION_COLLECTION, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+	return &questions, nil
+}
+
+// FetchQuestionsPage sorts the whole bucket in memory, ordering it the same way as the Mongo repository
+func (r *boltQuestionRepo) FetchQuestionsPage(page models.PageRequest, excludeIDs []string) (*models.QuestionPage, error) {
+	questions, err := boltFind(r.db, config.QUESTION_COLLECTION, func(question *models.Question) bool {
+		return !containsString(excludeIDs, question.QuestionID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+
+	sort.SliceStable(questions, func(i, j int) bool {
+		a, b := questions[i], questions[j]
+		var order int
+		switch page.SortBy {
+		case models.SortByTitle:
+			order = strings.Compare(a.QuestionTitle, b.QuestionTitle)
+		case models.SortByDifficulty:
+			order = sorting.DifficultyRank(a.Difficulty) - sorting.DifficultyRank(b.Difficulty)
+		default:
+			order = sorting.CompareQuestionIDs(a.QuestionID, b.QuestionID)
+		}
+		if order != 0 {
+			return (order < 0) != page.Descending
+		}
+		return sorting.LessQuestionID(a.QuestionID, b.QuestionID)
+	})
+
+	start, end := pageBounds(len(questions), page)
+	return &models.QuestionPage{Questions: questions[start:end], PageInfo: newPageInfo(page, int64(len(questions)))}, nil
+}
+
+func (r *boltQuestionRepo) FetchQuestionsByFilters(difficulty, company, topic string) (*[]models.Question, error) {
+	// Apply filters only if parameters are not "any", same as the Mongo repository
+	isSet := func(value string) bool {
+		return value != "" && strings.ToLower(value) != "any"
+	}
+
+	questions, err := boltFind(r.db, config.QUESTION_COLLECTION, func(question *models.Question) bool {
+		if isSet(difficulty) && question.Difficulty != difficulty {
+			return false
+		}
+		if isSet(company) && !containsString(question.CompanyTags, company) {
+			return false
+		}
+		if isSet(topic) && !containsString(question.TopicTags, topic) {
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions by filters: %v", err)
+	}
+	return &questions, nil
+}
+
+func (r *boltQuestionRepo) QuestionExists(questionID string) (bool, error) {
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		found = tx.Bucket([]byte(config.QUESTION_COLLECTION)).Get([]byte(questionID)) != nil
+		return nil
+	})
+	return found, err
+}
+
+func (r *boltQuestionRepo) CountQuestions() (int64, error) {
+	var count int64
+	err := r.db.View(func(tx *bolt.Tx) error {
+		count = int64(tx.Bucket([]byte(config.QUESTION_COLLECTION)).Stats().KeyN)
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not count questions: %v", err)
+	}
+	return count, nil
+}
+
+func containsString(values []string, target string) bool {
+	for _, value := range values {
+		if value == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+	"time"
+)
+
+type boltReviewRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltReviewRepo(db *bolt.DB) interfaces.ReviewRepository {
+	return &boltReviewRepo{db: db}
+}
+
+// reviewKey keeps one card per user and question, like the Mongo upsert filter
+func reviewKey(userID, questionID string) string {
+	return userID + "/" + questionID
+}
+
+func (r *boltReviewRepo) UpsertReviewCard(card *models.ReviewCard) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.REVIEW_COLLECTION, reviewKey(card.UserID, card.QuestionID), card)
+	})
+	if err != nil {
+		return fmt.Errorf("could not save review card: %v", err)
+	}
+	return nil
+}
+
+func (r *boltReviewRepo) FetchReviewCard(userID, questionID string) (*models.ReviewCard, error) {
+	var card models.ReviewCard
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.REVIEW_COLLECTION, reviewKey(userID, questionID), &card)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch review card: %v", err)
+	}
+	if !found {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &card, nil
+}
+
+func (r *boltReviewRepo) FetchDueReviewCards(userID string, dueBefore time.Time) (*[]models.ReviewCard, error) {
+	cards, err := boltFind(r.db, config.REVIEW_COLLECTION, func(card *models.ReviewCard) bool {
+		return card.UserID == userID && card.DueAt.Before(dueBefore)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch due reviews: %v", err)
+	}
+	if cards == nil {
+		cards = []models.ReviewCard{}
+	}
+
+	sort.SliceStable(cards, func(i, j int) bool {
+		return cards[i].DueAt.Before(cards[j].DueAt)
+	})
+	return &cards, nil
+}
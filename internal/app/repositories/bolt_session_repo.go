@@ -0,0 +1,59 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type boltSessionRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltSessionRepo(db *bolt.DB) interfaces.SessionRepository {
+	return &boltSessionRepo{db: db}
+}
+
+func (r *boltSessionRepo) CreateSession(session *models.Session) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.SESSION_COLLECTION, session.ID, session)
+	})
+	if err != nil {
+		return fmt.Errorf("could not insert session: %v", err)
+	}
+	return nil
+}
+
+func (r *boltSessionRepo) FetchSessionByID(sessionID string) (*models.Session, error) {
+	var session models.Session
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.SESSION_COLLECTION, sessionID, &session)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch session: %v", err)
+	}
+	if !found {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &session, nil
+}
+
+func (r *boltSessionRepo) RevokeSession(sessionID string) error {
+	found, err := boltModify(r.db, config.SESSION_COLLECTION, sessionID, func(session *models.Session) error {
+		session.Revoked = true
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not revoke session: %v", err)
+	}
+	if !found {
+		return fmt.Errorf("session with ID %s not found", sessionID)
+	}
+	return nil
+}
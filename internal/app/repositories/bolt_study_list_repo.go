@@ -0,0 +1,100 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+)
+
+type boltStudyListRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltStudyListRepo(db *bolt.DB) interfaces.StudyListRepository {
+	return &boltStudyListRepo{db: db}
+}
+
+func (r *boltStudyListRepo) CreateStudyList(list *models.StudyList) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.STUDY_LIST_COLLECTION, list.ID, list)
+	})
+	if err != nil {
+		return fmt.Errorf("could not create study list: %v", err)
+	}
+	return nil
+}
+
+func (r *boltStudyListRepo) FetchStudyListByID(listID string) (*models.StudyList, error) {
+	var list models.StudyList
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.STUDY_LIST_COLLECTION, listID, &list)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch study list: %v", err)
+	}
+	if !found {
+		return nil, mongo.ErrNoDocuments
+	}
+	return &list, nil
+}
+
+func (r *boltStudyListRepo) FetchVisibleStudyLists(userID string) (*[]models.StudyList, error) {
+	lists, err := boltFind(r.db, config.STUDY_LIST_COLLECTION, func(list *models.StudyList) bool {
+		return list.OwnerID == userID || list.Shared
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch study lists: %v", err)
+	}
+	if lists == nil {
+		lists = []models.StudyList{}
+	}
+
+	// Same order as the Mongo sort on name then creation time
+	sort.SliceStable(lists, func(i, j int) bool {
+		if lists[i].Name != lists[j].Name {
+			return lists[i].Name < lists[j].Name
+		}
+		return lists[i].CreatedAt.Before(lists[j].CreatedAt)
+	})
+	return &lists, nil
+}
+
+func (r *boltStudyListRepo) UpdateStudyList(list *models.StudyList) error {
+	found, err := boltModify(r.db, config.STUDY_LIST_COLLECTION, list.ID, func(stored *models.StudyList) error {
+		*stored = *list
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update study list: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *boltStudyListRepo) DeleteStudyList(listID string) error {
+	found := false
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(config.STUDY_LIST_COLLECTION))
+		if bucket.Get([]byte(listID)) == nil {
+			return nil
+		}
+		found = true
+		return bucket.Delete([]byte(listID))
+	})
+	if err != nil {
+		return fmt.Errorf("could not delete study list: %v", err)
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	bolt "go.etcd.io/bbolt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"sort"
+	"strings"
+	"time"
+)
+
+type boltUserRepo struct {
+	db *bolt.DB
+}
+
+func NewBoltUserRepo(db *bolt.DB) interfaces.UserRepository {
+	return &boltUserRepo{db: db}
+}
+
+func (r *boltUserRepo) CreateUser(user *models.StandardUser) error {
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		return boltPut(tx, config.USER_COLLECTION, user.StandardUser.ID, user)
+	})
+	if err != nil {
+		return fmt.Errorf("could not insert user: %v", err)
+	}
+	return nil
+}
+
+func (r *boltUserRepo) UpdateUserProgress(userID string, event *models.SolveEvent) error {
+	_, err := boltModify(r.db, config.USER_COLLECTION, userID, func(user *models.StandardUser) error {
+		user.SolveHistory = append(user.SolveHistory, *event)
+
+		// Mirror $addToSet so a question is only recorded once
+		for _, id := range user.QuestionsSolved {
+			if id == event.QuestionID {
+				return nil
+			}
+		}
+		user.QuestionsSolved = append(user.QuestionsSolved, event.QuestionID)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update progress: %v", err)
+	}
+	return nil
+}
+
+func (r *boltUserRepo) MigrateSolveHistory() (int64, error) {
+	var migrated int64
+	err := r.db.Update(func(tx *bolt.Tx) error {
+		var pending []models.StandardUser
+		err := boltEach(tx, config.USER_COLLECTION, func(_ string, user *models.StandardUser) bool {
+			if user.SolveHistory == nil {
+				pending = append(pending, *user)
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, user := range pending {
+			user.SolveHistory = make([]models.SolveEvent, 0, len(user.QuestionsSolved))
+			for _, questionID := range user.QuestionsSolved {
+				user.SolveHistory = append(user.SolveHistory, models.SolveEvent{QuestionID: questionID})
+			}
+			if err := boltPut(tx, config.USER_COLLECTION, user.StandardUser.ID, &user); err != nil {
+				return err
+			}
+			migrated++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to migrate solve history: %v", err)
+	}
+	return migrated, nil
+}
+
+func (r *boltUserRepo) FetchAllUsers() (*[]models.StandardUser, error) {
+	users, err := boltFind[models.StandardUser](r.db, config.USER_COLLECTION, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &users, nil
+}
+
+// FetchUsersPage sorts the matching users in memory, ordering them the same way as the Mongo repository
+func (r *boltUserRepo) FetchUsersPage(role string, page models.PageRequest) (*models.UserPage, error) {
+	users, err := boltFind(r.db, config.USER_COLLECTION, func(user *models.StandardUser) bool {
+		return role == "" || user.StandardUser.Role == role
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch users: %v", err)
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		a, b := users[i], users[j]
+		order := strings.Compare(a.StandardUser.Username, b.StandardUser.Username)
+		if page.SortBy != models.SortByLastSeen {
+			return (order < 0) != page.Descending
+		}
+		if !a.LastSeen.Equal(b.LastSeen) {
+			return a.LastSeen.Before(b.LastSeen) != page.Descending
+		}
+		return order < 0
+	})
+
+	start, end := pageBounds(len(users), page)
+	return &models.UserPage{Users: users[start:end], PageInfo: newPageInfo(page, int64(len(users)))}, nil
+}
+
+func (r *boltUserRepo) FetchUserByID(userID string) (*models.StandardUser, error) {
+	var user models.StandardUser
+	found := false
+	err := r.db.View(func(tx *bolt.Tx) error {
+		var err error
+		found, err = boltGet(tx, config.USER_COLLECTION, userID, &user)
+		return err
+	})
+	if err != nil {
+		return &user, err
+	}
+	if !found {
+		return &user, errors.New("user not found")
+	}
+	return &user, nil
+}
+
+func (r *boltUserRepo) FetchUserByUsername(username string) (*models.StandardUser, error) {
+	return r.findOne(func(user *models.StandardUser) bool {
+		return user.StandardUser.Username == username
+	})
+}
+
+func (r *boltUserRepo) UpdateUserDetails(user *models.StandardUser) error {
+	if user.StandardUser.ID == "" {
+		return errors.New("user ID is required")
+	}
+
+	found, err := boltModify(r.db, config.USER_COLLECTION, user.StandardUser.ID, func(stored *models.StandardUser) error {
+		// Only the fields the Mongo repository sets are updated
+		stored.StandardUser.Username = user.StandardUser.Username
+		stored.StandardUser.Email = user.StandardUser.Email
+		stored.StandardUser.Password = user.StandardUser.Password
+		stored.StandardUser.Name = user.StandardUser.Name
+		stored.StandardUser.Organisation = user.StandardUser.Organisation
+		stored.StandardUser.Country = user.StandardUser.Country
+		stored.LeetcodeID = user.LeetcodeID
+		stored.LastSeen = user.LastSeen
+		stored.QuestionsSolved = user.QuestionsSolved
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *boltUserRepo) BanUser(userID string) error {
+	return r.setBanned(userID, true)
+}
+
+func (r *boltUserRepo) UnbanUser(userID string) error {
+	return r.setBanned(userID, false)
+}
+
+func (r *boltUserRepo) UpdateUserRole(userID, role string) error {
+	found, err := boltModify(r.db, config.USER_COLLECTION, userID, func(user *models.StandardUser) error {
+		user.StandardUser.Role = role
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update user role: %v", err)
+	}
+	if !found {
+		return fmt.Errorf("user with ID %s not found", userID)
+	}
+	return nil
+}
+
+func (r *boltUserRepo) CountUsersByRole(role string) (int64, error) {
+	users, err := boltFind(r.db, config.USER_COLLECTION, func(user *models.StandardUser) bool {
+		return user.StandardUser.Role == role
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not count users: %v", err)
+	}
+	return int64(len(users)), nil
+}
+
+func (r *boltUserRepo) CountActiveUsersInLast24Hours() (int64, error) {
+	twentyFourHoursAgo := time.Now().UTC().Add(-24 * time.Hour)
+
+	users, err := boltFind(r.db, config.USER_COLLECTION, func(user *models.StandardUser) bool {
+		return !user.LastSeen.Before(twentyFourHoursAgo)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not count active users: %v", err)
+	}
+	return int64(len(users)), nil
+}
+
+func (r *boltUserRepo) IsUsernameUnique(username string) (bool, error) {
+	return r.isUnique(func(user *models.StandardUser) bool {
+		return user.StandardUser.Username == username
+	})
+}
+
+func (r *boltUserRepo) IsEmailUnique(email string) (bool, error) {
+	return r.isUnique(func(user *models.StandardUser) bool {
+		return user.StandardUser.Email == email
+	})
+}
+
+func (r *boltUserRepo) IsLeetcodeIDUnique(LeetcodeID string) (bool, error) {
+	return r.isUnique(func(user *models.StandardUser) bool {
+		return user.LeetcodeID == LeetcodeID
+	})
+}
+
+// findOne returns mongo.ErrNoDocuments when nothing matches so services can treat both drivers alike.
+func (r *boltUserRepo) findOne(match func(*models.StandardUser) bool) (*models.StandardUser, error) {
+	user, err := boltFindOne(r.db, config.USER_COLLECTION, match)
+	if err != nil {
+		return &models.StandardUser{}, err
+	}
+	if user == nil {
+		return &models.StandardUser{}, mongo.ErrNoDocuments
+	}
+	return user, nil
+}
+
+func (r *boltUserRepo) isUnique(match func(*models.StandardUser) bool) (bool, error) {
+	user, err := boltFindOne(r.db, config.USER_COLLECTION, match)
+	if err != nil {
+		return false, err
+	}
+	return user == nil, nil
+}
+
+func (r *boltUserRepo) setBanned(userID string, banned bool) error {
+	found, err := boltModify(r.db, config.USER_COLLECTION, userID, func(user *models.StandardUser) error {
+		user.StandardUser.IsBanned = banned
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update ban status: %v", err)
+	}
+	if !found {
+		return fmt.Errorf("user with ID %s not found", userID)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import (
+	"cli-project/internal/config/drivers"
+	"cli-project/internal/domain/interfaces"
+	"fmt"
+	"strings"
+)
+
+// NewStorageDriver opens the storage driver selected at startup.
+// dsn is the MongoDB URI or the Bolt database file, an empty dsn falls back to the default.
+func NewStorageDriver(driver, dsn string) (interfaces.StorageDriver, error) {
+	switch strings.ToLower(strings.TrimSpace(driver)) {
+	case "", drivers.MONGO:
+		return NewMongoDriver(dsn)
+	case drivers.BOLT:
+		return NewBoltDriver(dsn)
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q: must be '%s' or '%s'", driver, drivers.MONGO, drivers.BOLT)
+	}
+}
@@ -0,0 +1,98 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"context"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type leaderboardRepo struct {
+	driver *mongoDriver
+}
+
+func NewLeaderboardRepo(driver *mongoDriver) interfaces.LeaderboardRepository {
+	return &leaderboardRepo{driver: driver}
+}
+
+func (r *leaderboardRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.USER_COLLECTION), nil
+}
+
+// FetchSolveCounts counts the solved questions of every active user per difficulty.
+// Empty organisation or country values are not used as filters.
+func (r *leaderboardRepo) FetchSolveCounts(organisation, country string) (*[]models.LeaderboardEntry, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	// Only ranked roles that are not banned take part in the ranking
+	match := bson.M{"role": bson.M{"$in": roles.Ranked}, "isBanned": false}
+	if organisation != "" {
+		match["organisation"] = organisation
+	}
+	if country != "" {
+		match["country"] = country
+	}
+
+	countSolved := func(difficulty string) bson.M {
+		return bson.M{"$size": bson.M{"$filter": bson.M{
+			"input": "$solved",
+			"as":    "question",
+			"cond":  bson.M{"$eq": bson.A{"$$question.difficulty", difficulty}},
+		}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         config.QUESTION_COLLECTION,
+			"localField":   "questions_solved",
+			"foreignField": "question_id",
+			"as":           "solved",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":           0,
+			"user_id":       "$id",
+			"username":      1,
+			"name":          1,
+			"organisation":  1,
+			"country":       1,
+			"easy_solved":   countSolved("easy"),
+			"medium_solved": countSolved("medium"),
+			"hard_solved":   countSolved("hard"),
+		}}},
+	}
+
+	cursor, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate solve counts: %v", err)
+	}
+
+	defer func(cursor *mongo.Cursor, ctx context.Context) {
+		err := cursor.Close(ctx)
+		if err != nil {
+			fmt.Println("could not close cursor")
+		}
+	}(cursor, ctx)
+
+	var entries []models.LeaderboardEntry
+	if err := cursor.All(ctx, &entries); err != nil {
+		return nil, fmt.Errorf("could not decode solve counts: %v", err)
+	}
+
+	return &entries, nil
+}
@@ -1,66 +1,173 @@
 package repositories
 
 import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/drivers"
+	"cli-project/internal/domain/interfaces"
 	"context"
 	"fmt"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/x/mongo/driver/connstring"
 	"log"
 	"sync"
 	"time"
 )
 
-var (
+// clientTTL is how long a Mongo client is reused before the driver reconnects
+const clientTTL = 1 * time.Hour
+
+type mongoDriver struct {
+	uri          string
+	databaseName string
+
+	mu          sync.Mutex // Guards the client and its connection time
 	client      *mongo.Client
-	clientErr   error
-	mongoMutex  sync.Mutex // Mutex to handle connection expiration logic
 	connectedAt time.Time
-	clientTTL   = 1 * time.Hour
-)
+
+	userRepo         interfaces.UserRepository
+	questionRepo     interfaces.QuestionRepository
+	leaderboardRepo  interfaces.LeaderboardRepository
+	sessionRepo      interfaces.SessionRepository
+	reviewRepo       interfaces.ReviewRepository
+	studyListRepo    interfaces.StudyListRepository
+	noteRepo         interfaces.NoteRepository
+	announcementRepo interfaces.AnnouncementRepository
+	auditRepo        interfaces.AuditRepository
+	banRepo          interfaces.BanRepository
+}
+
+// NewMongoDriver returns the Mongo backed repositories for uri, the client connects on first use.
+// A database name in the uri path overrides the default database.
+func NewMongoDriver(uri string) (interfaces.StorageDriver, error) {
+	if uri == "" {
+		uri = config.MONGO_URI
+	}
+
+	connString, err := connstring.ParseAndValidate(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MongoDB URI: %v", err)
+	}
+
+	d := &mongoDriver{uri: uri, databaseName: config.DB_NAME}
+	if connString.Database != "" {
+		d.databaseName = connString.Database
+	}
+
+	d.userRepo = NewUserRepo(d)
+	d.questionRepo = NewQuestionRepo(d)
+	d.leaderboardRepo = NewLeaderboardRepo(d)
+	d.sessionRepo = NewSessionRepo(d)
+	d.reviewRepo = NewReviewRepo(d)
+	d.studyListRepo = NewStudyListRepo(d)
+	d.noteRepo = NewNoteRepo(d)
+	d.announcementRepo = NewAnnouncementRepo(d)
+	d.auditRepo = NewAuditRepo(d)
+	d.banRepo = NewBanRepo(d)
+	return d, nil
+}
+
+func (d *mongoDriver) Name() string {
+	return drivers.MONGO
+}
+
+func (d *mongoDriver) UserRepository() interfaces.UserRepository {
+	return d.userRepo
+}
+
+func (d *mongoDriver) QuestionRepository() interfaces.QuestionRepository {
+	return d.questionRepo
+}
+
+func (d *mongoDriver) LeaderboardRepository() interfaces.LeaderboardRepository {
+	return d.leaderboardRepo
+}
+
+func (d *mongoDriver) SessionRepository() interfaces.SessionRepository {
+	return d.sessionRepo
+}
+
+func (d *mongoDriver) ReviewRepository() interfaces.ReviewRepository {
+	return d.reviewRepo
+}
+
+func (d *mongoDriver) StudyListRepository() interfaces.StudyListRepository {
+	return d.studyListRepo
+}
+
+func (d *mongoDriver) NoteRepository() interfaces.NoteRepository {
+	return d.noteRepo
+}
+
+func (d *mongoDriver) AnnouncementRepository() interfaces.AnnouncementRepository {
+	return d.announcementRepo
+}
+
+func (d *mongoDriver) AuditRepository() interfaces.AuditRepository {
+	return d.auditRepo
+}
+
+func (d *mongoDriver) BanRepository() interfaces.BanRepository {
+	return d.banRepo
+}
+
+func (d *mongoDriver) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.client == nil {
+		return nil
+	}
+	err := d.client.Disconnect(context.TODO())
+	d.client = nil
+	if err != nil {
+		return fmt.Errorf("failed to disconnect MongoDB: %v", err)
+	}
+	return nil
+}
 
 // CreateContext creates a context with a timeout for database operations.
 func CreateContext() (context.Context, context.CancelFunc) {
 	return context.WithTimeout(context.Background(), 10*time.Second)
 }
 
-func GetMongoClient() (*mongo.Client, error) {
-	mongoMutex.Lock()
-	defer mongoMutex.Unlock()
+// Client returns the driver's Mongo client, connecting again when there is none or it has expired.
+func (d *mongoDriver) Client() (*mongo.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 
-	// If the connection has expired or does not exist, create a new one.
-	if client == nil || time.Since(connectedAt) > clientTTL { // Example: 1-hour expiration
-		// Close the old client if it exists
-		if client != nil {
-			if err := client.Disconnect(context.TODO()); err != nil {
-				log.Printf("Failed to disconnect old MongoDB client: %v", err)
-			}
-		}
+	if d.client != nil && time.Since(d.connectedAt) <= clientTTL {
+		return d.client, nil
+	}
 
-		clientOptions := options.Client().ApplyURI("mongodb://localhost:27017")
-		client, clientErr = mongo.Connect(context.TODO(), clientOptions)
-		if clientErr != nil {
-			log.Fatalf("Failed to connect to MongoDB: %v", clientErr)
+	// Close the old client if it exists
+	if d.client != nil {
+		if err := d.client.Disconnect(context.TODO()); err != nil {
+			log.Printf("Failed to disconnect old MongoDB client: %v", err)
 		}
+		d.client = nil
+	}
 
-		if err := client.Ping(context.TODO(), nil); err != nil {
-			return nil, fmt.Errorf("failed to ping MongoDB: %v", err)
-		}
+	client, err := mongo.Connect(context.TODO(), options.Client().ApplyURI(d.uri))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to MongoDB: %v", err)
+	}
 
-		connectedAt = time.Now() // Update the connection time
+	if err := client.Ping(context.TODO(), nil); err != nil {
+		_ = client.Disconnect(context.TODO())
+		return nil, fmt.Errorf("failed to ping MongoDB: %v", err)
 	}
-	return client, clientErr
-}
 
-// CloseMongoClient closes the MongoDB client connection gracefully.
-func CloseMongoClient() {
-	mongoMutex.Lock()
-	defer mongoMutex.Unlock()
+	d.client = client
+	d.connectedAt = time.Now()
+	return client, nil
+}
 
-	if client != nil {
-		if err := client.Disconnect(context.TODO()); err != nil {
-			log.Printf("Failed to disconnect MongoDB: %v", err)
-		}
-		client = nil
-		log.Println("MongoDB connection closed.")
+// Database returns the database the repositories read from and write to.
+func (d *mongoDriver) Database() (*mongo.Database, error) {
+	client, err := d.Client()
+	if err != nil {
+		return nil, err
 	}
+	return client.Database(d.databaseName), nil
 }
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type noteRepo struct {
+	driver *mongoDriver
+}
+
+func NewNoteRepo(driver *mongoDriver) interfaces.NoteRepository {
+	return &noteRepo{driver: driver}
+}
+
+func (r *noteRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.NOTE_COLLECTION), nil
+}
+
+func (r *noteRepo) UpsertNote(note *models.Note) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{"user_id": note.UserID, "question_id": note.QuestionID}
+	_, err = collection.ReplaceOne(ctx, filter, note, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("could not save note: %v", err)
+	}
+
+	return nil
+}
+
+func (r *noteRepo) FetchNote(userID, questionID string) (*models.Note, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	var note models.Note
+	err = collection.FindOne(ctx, bson.M{"user_id": userID, "question_id": questionID}).Decode(&note)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongo.ErrNoDocuments
+		}
+		return nil, fmt.Errorf("could not fetch note: %v", err)
+	}
+
+	return &note, nil
+}
+
+func (r *noteRepo) FetchNotes(userID string) (*[]models.Note, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	opts := options.Find().SetSort(bson.D{{Key: "updated_at", Value: -1}})
+	cursor, err := collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch notes: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	notes := []models.Note{}
+	if err := cursor.All(ctx, &notes); err != nil {
+		return nil, fmt.Errorf("could not decode notes: %v", err)
+	}
+
+	return &notes, nil
+}
+
+func (r *noteRepo) DeleteNote(userID, questionID string) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	result, err := collection.DeleteOne(ctx, bson.M{"user_id": userID, "question_id": questionID})
+	if err != nil {
+		return fmt.Errorf("could not delete note: %v", err)
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/sorting"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// newPageInfo describes the requested page of a listing with total items
+func newPageInfo(page models.PageRequest, total int64) models.PageInfo {
+	return models.PageInfo{
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		Total:      total,
+		TotalPages: int((total + int64(page.PageSize) - 1) / int64(page.PageSize)),
+	}
+}
+
+// pageBounds returns the slice bounds of the requested page within count sorted items, for the Bolt repositories
+func pageBounds(count int, page models.PageRequest) (int, int) {
+	start := min((page.Page-1)*page.PageSize, count)
+	return start, min(start+page.PageSize, count)
+}
+
+// mongoPageStages skips to the requested page of an aggregation and limits it to one page
+func mongoPageStages(page models.PageRequest) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$skip", Value: int64((page.Page - 1) * page.PageSize)}},
+		{{Key: "$limit", Value: int64(page.PageSize)}},
+	}
+}
+
+// sortDirection is the Mongo sort order of a page request
+func sortDirection(page models.PageRequest) int {
+	if page.Descending {
+		return -1
+	}
+	return 1
+}
+
+// difficultyRankStage adds a difficulty_rank field matching sorting.DifficultyRank, so unknown difficulties come last
+var difficultyRankStage = func() bson.D {
+	branches := bson.A{}
+	for _, difficulty := range sorting.DifficultyOrder {
+		branches = append(branches, bson.M{
+			"case": bson.M{"$eq": bson.A{"$difficulty", difficulty}},
+			"then": sorting.DifficultyRank(difficulty),
+		})
+	}
+	return bson.D{{Key: "$addFields", Value: bson.M{
+		"difficulty_rank": bson.M{"$switch": bson.M{
+			"branches": branches,
+			"default":  len(sorting.DifficultyOrder),
+		}},
+	}}}
+}()
@@ -9,23 +9,25 @@ import (
 	"fmt"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 	"strings"
 	"time"
 )
 
 type questionRepo struct {
+	driver *mongoDriver
 }
 
-func NewQuestionRepo() interfaces.QuestionRepository {
-	return &questionRepo{}
+func NewQuestionRepo(driver *mongoDriver) interfaces.QuestionRepository {
+	return &questionRepo{driver: driver}
 }
 
 func (r *questionRepo) getCollection() (*mongo.Collection, error) {
-	client, err := GetMongoClient()
+	database, err := r.driver.Database()
 	if err != nil {
 		return nil, err
 	}
-	return client.Database(config.DB_NAME).Collection(config.QUESTION_COLLECTION), nil
+	return database.Collection(config.QUESTION_COLLECTION), nil
 }
 
 func (r *questionRepo) AddQuestionsByID(questionID *[]string) error {
@@ -57,6 +59,32 @@ func (r *questionRepo) AddQuestions(questions *[]models.Question) error {
 	return nil
 }
 
+func (r *questionRepo) UpsertQuestions(questions *[]models.Question) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	writes := make([]mongo.WriteModel, len(*questions))
+	for i, question := range *questions {
+		writes[i] = mongo.NewReplaceOneModel().
+			SetFilter(bson.M{"question_id": question.QuestionID}).
+			SetReplacement(question).
+			SetUpsert(true)
+	}
+
+	_, err = collection.BulkWrite(ctx, writes)
+	if err != nil {
+		return fmt.Errorf("could not upsert questions: %v", err)
+	}
+
+	return nil
+}
+
 func (r *questionRepo) RemoveQuestionByID(questionID string) error {
 
 	collection, err := r.getCollection()
@@ -80,6 +108,27 @@ func (r *questionRepo) RemoveQuestionByID(questionID string) error {
 	return nil
 }
 
+func (r *questionRepo) UpdateQuestion(question *models.Question) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	result, err := collection.ReplaceOne(ctx, bson.M{"question_id": question.QuestionID}, question)
+	if err != nil {
+		return fmt.Errorf("could not update question: %v", err)
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
 func (r *questionRepo) FetchQuestionByID(questionID string) (*models.Question, error) {
 
 	collection, err := r.getCollection()
@@ -90,7 +139,7 @@ func (r *questionRepo) FetchQuestionByID(questionID string) (*models.Question, e
 	ctx, cancel := CreateContext()
 	defer cancel()
 
-	filter := bson.M{"questions_id": questionID}
+	filter := bson.M{"question_id": questionID}
 
 	var question models.Question
 	err = collection.FindOne(ctx, filter).Decode(&question)
@@ -142,6 +191,59 @@ func (r *questionRepo) FetchAllQuestions() (*[]models.Question, error) {
 	return &questions, nil
 }
 
+// FetchQuestionsPage returns one page of the questions not in excludeIDs. Numeric IDs sort by value,
+// and ties on title or difficulty are broken by ID.
+func (r *questionRepo) FetchQuestionsPage(page models.PageRequest, excludeIDs []string) (*models.QuestionPage, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if len(excludeIDs) > 0 {
+		filter["question_id"] = bson.M{"$nin": excludeIDs}
+	}
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not count questions: %v", err)
+	}
+
+	direction := sortDirection(page)
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: filter}}}
+	var sort bson.D
+	switch page.SortBy {
+	case models.SortByTitle:
+		sort = bson.D{{Key: "question_title", Value: direction}, {Key: "question_id", Value: 1}}
+	case models.SortByDifficulty:
+		pipeline = append(pipeline, difficultyRankStage)
+		sort = bson.D{{Key: "difficulty_rank", Value: direction}, {Key: "question_id", Value: 1}}
+	default:
+		sort = bson.D{{Key: "question_id", Value: direction}}
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$sort", Value: sort}})
+	pipeline = append(pipeline, mongoPageStages(page)...)
+
+	// Numeric ordering sorts the string IDs "2" before "10"
+	opts := options.Aggregate().SetCollation(&options.Collation{Locale: "en", NumericOrdering: true})
+	cursor, err := collection.Aggregate(ctx, pipeline, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	questions := []models.Question{}
+	if err := cursor.All(ctx, &questions); err != nil {
+		return nil, fmt.Errorf("could not decode questions: %v", err)
+	}
+
+	return &models.QuestionPage{Questions: questions, PageInfo: newPageInfo(page, total)}, nil
+}
+
 func (r *questionRepo) FetchQuestionsByFilters(difficulty, company, topic string) (*[]models.Question, error) {
 
 	collection, err := r.getCollection()
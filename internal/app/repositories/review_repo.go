@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type reviewRepo struct {
+	driver *mongoDriver
+}
+
+func NewReviewRepo(driver *mongoDriver) interfaces.ReviewRepository {
+	return &reviewRepo{driver: driver}
+}
+
+func (r *reviewRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.REVIEW_COLLECTION), nil
+}
+
+func (r *reviewRepo) UpsertReviewCard(card *models.ReviewCard) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{"user_id": card.UserID, "question_id": card.QuestionID}
+	_, err = collection.ReplaceOne(ctx, filter, card, options.Replace().SetUpsert(true))
+	if err != nil {
+		return fmt.Errorf("could not save review card: %v", err)
+	}
+
+	return nil
+}
+
+func (r *reviewRepo) FetchReviewCard(userID, questionID string) (*models.ReviewCard, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	var card models.ReviewCard
+	err = collection.FindOne(ctx, bson.M{"user_id": userID, "question_id": questionID}).Decode(&card)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongo.ErrNoDocuments
+		}
+		return nil, fmt.Errorf("could not fetch review card: %v", err)
+	}
+
+	return &card, nil
+}
+
+func (r *reviewRepo) FetchDueReviewCards(userID string, dueBefore time.Time) (*[]models.ReviewCard, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{"user_id": userID, "due_at": bson.M{"$lt": dueBefore}}
+	opts := options.Find().SetSort(bson.D{{Key: "due_at", Value: 1}})
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch due reviews: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	cards := []models.ReviewCard{}
+	if err := cursor.All(ctx, &cards); err != nil {
+		return nil, fmt.Errorf("could not decode due reviews: %v", err)
+	}
+
+	return &cards, nil
+}
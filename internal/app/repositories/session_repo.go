@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type sessionRepo struct {
+	driver *mongoDriver
+}
+
+func NewSessionRepo(driver *mongoDriver) interfaces.SessionRepository {
+	return &sessionRepo{driver: driver}
+}
+
+func (r *sessionRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.SESSION_COLLECTION), nil
+}
+
+func (r *sessionRepo) CreateSession(session *models.Session) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	_, err = collection.InsertOne(ctx, session)
+	if err != nil {
+		return fmt.Errorf("could not insert session: %v", err)
+	}
+
+	return nil
+}
+
+func (r *sessionRepo) FetchSessionByID(sessionID string) (*models.Session, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	var session models.Session
+	err = collection.FindOne(ctx, bson.M{"id": sessionID}).Decode(&session)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongo.ErrNoDocuments
+		}
+		return nil, fmt.Errorf("could not fetch session: %v", err)
+	}
+
+	return &session, nil
+}
+
+func (r *sessionRepo) RevokeSession(sessionID string) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	result, err := collection.UpdateOne(ctx, bson.M{"id": sessionID}, bson.M{"$set": bson.M{"revoked": true}})
+	if err != nil {
+		return fmt.Errorf("could not revoke session: %v", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("session with ID %s not found", sessionID)
+	}
+
+	return nil
+}
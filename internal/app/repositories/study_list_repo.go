@@ -0,0 +1,137 @@
+package repositories
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type studyListRepo struct {
+	driver *mongoDriver
+}
+
+func NewStudyListRepo(driver *mongoDriver) interfaces.StudyListRepository {
+	return &studyListRepo{driver: driver}
+}
+
+func (r *studyListRepo) getCollection() (*mongo.Collection, error) {
+	database, err := r.driver.Database()
+	if err != nil {
+		return nil, err
+	}
+	return database.Collection(config.STUDY_LIST_COLLECTION), nil
+}
+
+func (r *studyListRepo) CreateStudyList(list *models.StudyList) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	if _, err := collection.InsertOne(ctx, list); err != nil {
+		return fmt.Errorf("could not create study list: %v", err)
+	}
+
+	return nil
+}
+
+func (r *studyListRepo) FetchStudyListByID(listID string) (*models.StudyList, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	var list models.StudyList
+	err = collection.FindOne(ctx, bson.M{"id": listID}).Decode(&list)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongo.ErrNoDocuments
+		}
+		return nil, fmt.Errorf("could not fetch study list: %v", err)
+	}
+
+	return &list, nil
+}
+
+// FetchVisibleStudyLists returns the lists owned by the user and every shared list, sorted by name
+func (r *studyListRepo) FetchVisibleStudyLists(userID string) (*[]models.StudyList, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{"$or": bson.A{bson.M{"owner_id": userID}, bson.M{"shared": true}}}
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "created_at", Value: 1}})
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch study lists: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	lists := []models.StudyList{}
+	if err := cursor.All(ctx, &lists); err != nil {
+		return nil, fmt.Errorf("could not decode study lists: %v", err)
+	}
+
+	return &lists, nil
+}
+
+func (r *studyListRepo) UpdateStudyList(list *models.StudyList) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	result, err := collection.ReplaceOne(ctx, bson.M{"id": list.ID}, list)
+	if err != nil {
+		return fmt.Errorf("could not update study list: %v", err)
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
+func (r *studyListRepo) DeleteStudyList(listID string) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	result, err := collection.DeleteOne(ctx, bson.M{"id": listID})
+	if err != nil {
+		return fmt.Errorf("could not delete study list: %v", err)
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
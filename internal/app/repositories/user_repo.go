@@ -4,7 +4,6 @@ import (
 	"cli-project/internal/config"
 	"cli-project/internal/domain/interfaces"
 	"cli-project/internal/domain/models"
-	"cli-project/pkg/globals"
 	"context"
 	"errors"
 	"fmt"
@@ -15,18 +14,19 @@ import (
 )
 
 type userRepo struct {
+	driver *mongoDriver
 }
 
-func NewUserRepo() interfaces.UserRepository {
-	return &userRepo{}
+func NewUserRepo(driver *mongoDriver) interfaces.UserRepository {
+	return &userRepo{driver: driver}
 }
 
 func (r *userRepo) getCollection() (*mongo.Collection, error) {
-	client, err := GetMongoClient()
+	database, err := r.driver.Database()
 	if err != nil {
 		return nil, err
 	}
-	return client.Database(config.DB_NAME).Collection(config.USER_COLLECTION), nil
+	return database.Collection(config.USER_COLLECTION), nil
 }
 
 func (r *userRepo) CreateUser(user *models.StandardUser) error {
@@ -49,6 +49,7 @@ func (r *userRepo) CreateUser(user *models.StandardUser) error {
 		"isBanned":         user.StandardUser.IsBanned,
 		"Leetcode_id":      user.LeetcodeID,
 		"questions_solved": user.QuestionsSolved,
+		"solve_history":    user.SolveHistory,
 		"last_seen":        user.LastSeen,
 	}
 
@@ -61,7 +62,7 @@ func (r *userRepo) CreateUser(user *models.StandardUser) error {
 	return nil
 }
 
-func (r *userRepo) UpdateUserProgress(solvedQuestionID string) error {
+func (r *userRepo) UpdateUserProgress(userID string, event *models.SolveEvent) error {
 
 	collection, err := r.getCollection()
 	if err != nil {
@@ -71,13 +72,16 @@ func (r *userRepo) UpdateUserProgress(solvedQuestionID string) error {
 	ctx, cancel := CreateContext()
 	defer cancel()
 
-	// Find the current user
-	filter := bson.M{"id": globals.ActiveUserID}
+	// Find the user
+	filter := bson.M{"id": userID}
 
-	// Add the solved question ID to the QuestionsSolved slice
+	// Record the solve and keep the set of solved question IDs in step with it
 	update := bson.M{
+		"$push": bson.M{
+			"solve_history": event,
+		},
 		"$addToSet": bson.M{
-			"questions_solved": solvedQuestionID,
+			"questions_solved": event.QuestionID,
 		},
 	}
 
@@ -90,6 +94,43 @@ func (r *userRepo) UpdateUserProgress(solvedQuestionID string) error {
 	return nil
 }
 
+// MigrateSolveHistory gives users created before solve events were recorded one untimed event per solved question.
+func (r *userRepo) MigrateSolveHistory() (int64, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get collection: %v", err)
+	}
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	// Matches documents where the field is missing or null
+	filter := bson.M{"solve_history": nil}
+
+	// Build the events from the existing questions_solved array inside the database
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"solve_history": bson.M{
+				"$map": bson.M{
+					"input": bson.M{"$ifNull": bson.A{"$questions_solved", bson.A{}}},
+					"as":    "questionID",
+					"in": bson.M{
+						"question_id": "$$questionID",
+						"solved_at":   time.Time{},
+					},
+				},
+			},
+		}}},
+	}
+
+	result, err := collection.UpdateMany(ctx, filter, update)
+	if err != nil {
+		return 0, fmt.Errorf("failed to migrate solve history: %v", err)
+	}
+
+	return result.ModifiedCount, nil
+}
+
 func (r *userRepo) FetchAllUsers() (*[]models.StandardUser, error) {
 
 	collection, err := r.getCollection()
@@ -135,6 +176,50 @@ func (r *userRepo) FetchAllUsers() (*[]models.StandardUser, error) {
 	return &users, nil
 }
 
+// FetchUsersPage returns one page of the users with the role, or of every user when role is empty
+func (r *userRepo) FetchUsersPage(role string, page models.PageRequest) (*models.UserPage, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get collection: %v", err)
+	}
+
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	filter := bson.M{}
+	if role != "" {
+		filter["role"] = role
+	}
+
+	total, err := collection.CountDocuments(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("could not count users: %v", err)
+	}
+
+	sort := bson.D{{Key: "username", Value: sortDirection(page)}}
+	if page.SortBy == models.SortByLastSeen {
+		sort = bson.D{{Key: "last_seen", Value: sortDirection(page)}, {Key: "username", Value: 1}}
+	}
+	opts := options.Find().
+		SetSort(sort).
+		SetSkip(int64((page.Page - 1) * page.PageSize)).
+		SetLimit(int64(page.PageSize))
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch users: %v", err)
+	}
+	defer cursor.Close(ctx)
+
+	users := []models.StandardUser{}
+	if err := cursor.All(ctx, &users); err != nil {
+		return nil, fmt.Errorf("could not decode users: %v", err)
+	}
+
+	return &models.UserPage{Users: users, PageInfo: newPageInfo(page, total)}, nil
+}
+
 func (r *userRepo) FetchUserByID(userID string) (*models.StandardUser, error) {
 
 	collection, err := r.getCollection()
@@ -291,6 +376,45 @@ func (r *userRepo) UnbanUser(userID string) error {
 	return nil
 }
 
+func (r *userRepo) UpdateUserRole(userID, role string) error {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return fmt.Errorf("failed to get collection: %v", err)
+	}
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	result, err := collection.UpdateOne(ctx, bson.M{"id": userID}, bson.M{"$set": bson.M{"role": role}})
+	if err != nil {
+		return fmt.Errorf("could not update user role: %v", err)
+	}
+
+	// Check if the user was found and updated
+	if result.MatchedCount == 0 {
+		return fmt.Errorf("user with ID %s not found", userID)
+	}
+
+	return nil
+}
+
+func (r *userRepo) CountUsersByRole(role string) (int64, error) {
+
+	collection, err := r.getCollection()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get collection: %v", err)
+	}
+	ctx, cancel := CreateContext()
+	defer cancel()
+
+	count, err := collection.CountDocuments(ctx, bson.M{"role": role})
+	if err != nil {
+		return 0, fmt.Errorf("could not count users: %v", err)
+	}
+
+	return count, nil
+}
+
 func (r *userRepo) CountActiveUsersInLast24Hours() (int64, error) {
 
 	collection, err := r.getCollection()
@@ -0,0 +1,43 @@
+package services
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"errors"
+	"fmt"
+)
+
+var ErrPermissionDenied = errors.New("permission denied")
+
+// AccessService checks what a user may do against the permissions of their role
+type AccessService struct {
+	userRepo interfaces.UserRepository
+	banRepo  interfaces.BanRepository
+}
+
+func NewAccessService(userRepo interfaces.UserRepository, banRepo interfaces.BanRepository) interfaces.AccessService {
+	return &AccessService{
+		userRepo: userRepo,
+		banRepo:  banRepo,
+	}
+}
+
+// Authorize returns ErrPermissionDenied unless the user's role grants the permission. Banned users are
+// denied everything, so a ban takes effect even where no login check runs. A ban that has run out is
+// lifted first, so it does not lock staff out until they next log in.
+func (s *AccessService) Authorize(userID, permission string) error {
+	user, err := s.userRepo.FetchUserByID(userID)
+	if err != nil {
+		return fmt.Errorf("%w: could not find user %s", ErrPermissionDenied, userID)
+	}
+	if err := liftExpiredBan(s.userRepo, s.banRepo, user); err != nil {
+		return err
+	}
+	if user.StandardUser.IsBanned {
+		return fmt.Errorf("%w: user is banned", ErrPermissionDenied)
+	}
+	if !roles.Has(user.StandardUser.Role, permission) {
+		return fmt.Errorf("%w: the %s role does not have %s", ErrPermissionDenied, user.StandardUser.Role, permission)
+	}
+	return nil
+}
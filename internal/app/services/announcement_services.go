@@ -0,0 +1,310 @@
+package services
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrAnnouncementNotFound  = errors.New("announcement not found")
+	ErrEmptyAnnouncement     = errors.New("announcement needs a title and a message")
+	ErrAnnouncementTooLong   = fmt.Errorf("announcement title cannot be longer than %d characters, or its message than %d", config.ANNOUNCEMENT_TITLE_MAX_LENGTH, config.ANNOUNCEMENT_BODY_MAX_LENGTH)
+	ErrMissingAudienceTarget = errors.New("announcements for an organisation or a country need its name")
+	ErrExpiryInPast          = errors.New("announcement expiry must be in the future")
+	ErrInvalidAudience       = errors.New("invalid announcement audience")
+)
+
+type AnnouncementService struct {
+	announcementRepo interfaces.AnnouncementRepository
+	userService      interfaces.UserService
+	accessService    interfaces.AccessService
+}
+
+func NewAnnouncementService(announcementRepo interfaces.AnnouncementRepository, userService interfaces.UserService, accessService interfaces.AccessService) interfaces.AnnouncementService {
+	return &AnnouncementService{
+		announcementRepo: announcementRepo,
+		userService:      userService,
+		accessService:    accessService,
+	}
+}
+
+// CreateAnnouncement publishes an announcement written by a staff member with the announcements:write permission
+func (s *AnnouncementService) CreateAnnouncement(authorID string, draft models.AnnouncementDraft) (*models.Announcement, error) {
+	if err := s.accessService.Authorize(authorID, roles.ANNOUNCEMENTS_WRITE); err != nil {
+		return nil, err
+	}
+
+	draft, err := cleanAnnouncementDraft(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	author, err := s.userService.GetUserByID(authorID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	announcement := &models.Announcement{
+		ID:         utils.GenerateUUID(),
+		Title:      draft.Title,
+		Body:       draft.Body,
+		Audience:   draft.Audience,
+		Target:     draft.Target,
+		Pinned:     draft.Pinned,
+		AuthorID:   authorID,
+		AuthorName: author.StandardUser.Username,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+		ExpiresAt:  draft.ExpiresAt,
+		Reads:      []models.ReadReceipt{},
+	}
+
+	if err := s.announcementRepo.CreateAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+	return announcement, nil
+}
+
+// UpdateAnnouncement replaces what the announcement says and who it is for, keeping its read receipts
+func (s *AnnouncementService) UpdateAnnouncement(actorID, announcementID string, draft models.AnnouncementDraft) (*models.Announcement, error) {
+	if err := s.accessService.Authorize(actorID, roles.ANNOUNCEMENTS_WRITE); err != nil {
+		return nil, err
+	}
+
+	draft, err := cleanAnnouncementDraft(draft)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement, err := s.GetAnnouncement(announcementID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcement.Title = draft.Title
+	announcement.Body = draft.Body
+	announcement.Audience = draft.Audience
+	announcement.Target = draft.Target
+	announcement.Pinned = draft.Pinned
+	announcement.ExpiresAt = draft.ExpiresAt
+	announcement.UpdatedAt = time.Now().UTC()
+
+	if err := s.saveAnnouncement(announcement); err != nil {
+		return nil, err
+	}
+	return announcement, nil
+}
+
+// ExpireAnnouncement stops showing the announcement to users from now on
+func (s *AnnouncementService) ExpireAnnouncement(actorID, announcementID string) error {
+	if err := s.accessService.Authorize(actorID, roles.ANNOUNCEMENTS_WRITE); err != nil {
+		return err
+	}
+
+	announcement, err := s.GetAnnouncement(announcementID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	if announcementExpired(announcement, now) {
+		return nil
+	}
+
+	announcement.ExpiresAt = now
+	announcement.UpdatedAt = now
+	return s.saveAnnouncement(announcement)
+}
+
+// SetAnnouncementPinned pins the announcement above the others, or unpins it
+func (s *AnnouncementService) SetAnnouncementPinned(actorID, announcementID string, pinned bool) error {
+	if err := s.accessService.Authorize(actorID, roles.ANNOUNCEMENTS_WRITE); err != nil {
+		return err
+	}
+
+	announcement, err := s.GetAnnouncement(announcementID)
+	if err != nil {
+		return err
+	}
+
+	announcement.Pinned = pinned
+	announcement.UpdatedAt = time.Now().UTC()
+	return s.saveAnnouncement(announcement)
+}
+
+func (s *AnnouncementService) GetAnnouncement(announcementID string) (*models.Announcement, error) {
+	announcement, err := s.announcementRepo.FetchAnnouncementByID(strings.TrimSpace(announcementID))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrAnnouncementNotFound
+	}
+	return announcement, err
+}
+
+// GetAllAnnouncements returns every announcement including expired ones, pinned first and then the newest first
+func (s *AnnouncementService) GetAllAnnouncements() (*[]models.Announcement, error) {
+	return s.announcementRepo.FetchAnnouncements()
+}
+
+// GetUserAnnouncements returns the announcements addressed to the user that have not expired, and
+// whether the user has read each of them
+func (s *AnnouncementService) GetUserAnnouncements(userID string) (*[]models.UserAnnouncement, error) {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	announcements, err := s.announcementRepo.FetchAnnouncements()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	visible := []models.UserAnnouncement{}
+	for _, announcement := range *announcements {
+		if announcementExpired(&announcement, now) || !announcementAddressedTo(&announcement, user) {
+			continue
+		}
+		visible = append(visible, models.UserAnnouncement{
+			Announcement: announcement,
+			Read:         announcementReadBy(&announcement, userID),
+		})
+	}
+	return &visible, nil
+}
+
+// GetUnreadAnnouncements returns the announcements addressed to the user that they have not read yet
+func (s *AnnouncementService) GetUnreadAnnouncements(userID string) (*[]models.Announcement, error) {
+	announcements, err := s.GetUserAnnouncements(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unread := []models.Announcement{}
+	for _, announcement := range *announcements {
+		if !announcement.Read {
+			unread = append(unread, announcement.Announcement)
+		}
+	}
+	return &unread, nil
+}
+
+// MarkAnnouncementRead leaves a read receipt from the user, reading it again keeps the first receipt.
+// Announcements the user cannot see, because they are expired or addressed to others, are not found.
+func (s *AnnouncementService) MarkAnnouncementRead(userID, announcementID string) error {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+
+	announcement, err := s.GetAnnouncement(announcementID)
+	if err != nil {
+		return err
+	}
+	if announcementExpired(announcement, time.Now().UTC()) || !announcementAddressedTo(announcement, user) {
+		return ErrAnnouncementNotFound
+	}
+
+	receipt := models.ReadReceipt{
+		UserID:   userID,
+		Username: user.StandardUser.Username,
+		ReadAt:   time.Now().UTC(),
+	}
+	err = s.announcementRepo.AddReadReceipt(announcement.ID, receipt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrAnnouncementNotFound
+	}
+	return err
+}
+
+func (s *AnnouncementService) saveAnnouncement(announcement *models.Announcement) error {
+	err := s.announcementRepo.UpdateAnnouncement(announcement)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrAnnouncementNotFound
+	}
+	return err
+}
+
+// cleanAnnouncementDraft trims the draft and checks it can be published
+func cleanAnnouncementDraft(draft models.AnnouncementDraft) (models.AnnouncementDraft, error) {
+	draft.Title = strings.Join(strings.Fields(draft.Title), " ")
+	draft.Body = strings.TrimSpace(draft.Body)
+	if draft.Title == "" || draft.Body == "" {
+		return draft, ErrEmptyAnnouncement
+	}
+	if utf8.RuneCountInString(draft.Title) > config.ANNOUNCEMENT_TITLE_MAX_LENGTH || utf8.RuneCountInString(draft.Body) > config.ANNOUNCEMENT_BODY_MAX_LENGTH {
+		return draft, ErrAnnouncementTooLong
+	}
+
+	audience, err := validation.ValidateAnnouncementAudience(draft.Audience)
+	if err != nil {
+		return draft, fmt.Errorf("%w: %v", ErrInvalidAudience, err)
+	}
+	draft.Audience = audience
+
+	// Organisations and countries are stored cleaned at signup, targets are cleaned the same way
+	draft.Target = data_cleaning.CleanString(draft.Target)
+	switch audience {
+	case models.AudienceEveryone:
+		draft.Target = ""
+	case models.AudienceOrganisation:
+		if draft.Target == "" {
+			return draft, ErrMissingAudienceTarget
+		}
+		if _, err := validation.ValidateOrganizationName(draft.Target); err != nil {
+			return draft, fmt.Errorf("%w: %v", ErrInvalidAudience, err)
+		}
+	case models.AudienceCountry:
+		if draft.Target == "" {
+			return draft, ErrMissingAudienceTarget
+		}
+		if _, err := validation.ValidateCountryName(draft.Target); err != nil {
+			return draft, fmt.Errorf("%w: %v", ErrInvalidAudience, err)
+		}
+	}
+
+	if !draft.ExpiresAt.IsZero() {
+		if !draft.ExpiresAt.After(time.Now()) {
+			return draft, ErrExpiryInPast
+		}
+		draft.ExpiresAt = draft.ExpiresAt.UTC()
+	}
+	return draft, nil
+}
+
+// announcementExpired reports whether the announcement has expired by now, a zero expiry never does
+func announcementExpired(announcement *models.Announcement, now time.Time) bool {
+	return !announcement.ExpiresAt.IsZero() && !announcement.ExpiresAt.After(now)
+}
+
+// announcementAddressedTo reports whether the user belongs to the audience of the announcement
+func announcementAddressedTo(announcement *models.Announcement, user *models.StandardUser) bool {
+	switch announcement.Audience {
+	case models.AudienceOrganisation:
+		return strings.EqualFold(strings.TrimSpace(user.StandardUser.Organisation), announcement.Target)
+	case models.AudienceCountry:
+		return strings.EqualFold(strings.TrimSpace(user.StandardUser.Country), announcement.Target)
+	default:
+		return true
+	}
+}
+
+func announcementReadBy(announcement *models.Announcement, userID string) bool {
+	for _, read := range announcement.Reads {
+		if read.UserID == userID {
+			return true
+		}
+	}
+	return false
+}
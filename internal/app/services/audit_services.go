@@ -0,0 +1,212 @@
+package services
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnsupportedAuditFormat = errors.New("the audit log can only be exported as 'csv' or 'json'")
+	ErrInvalidAuditPeriod     = errors.New("audit log filter must start before it ends")
+	// ErrAuditFailed means the audit entry of a privileged action could not be written. Entries are
+	// written before the change is made, so the action was not carried out and nothing is left unrecorded.
+	ErrAuditFailed = errors.New("could not record audit entry")
+)
+
+var auditCSVHeader = []string{"Timestamp", "Actor ID", "Actor", "Action", "Target", "Before", "After"}
+
+type AuditService struct {
+	auditRepo     interfaces.AuditRepository
+	userRepo      interfaces.UserRepository
+	accessService interfaces.AccessService
+}
+
+func NewAuditService(auditRepo interfaces.AuditRepository, userRepo interfaces.UserRepository, accessService interfaces.AccessService) interfaces.AuditService {
+	return &AuditService{
+		auditRepo:     auditRepo,
+		userRepo:      userRepo,
+		accessService: accessService,
+	}
+}
+
+// Record appends an entry for an action the actor took on the target. Before and after are stored
+// as JSON, and a nil value stores nothing for that side, e.g. before a question is added.
+func (s *AuditService) Record(actorID, action, target string, before, after interface{}) error {
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return err
+	}
+
+	// The username is kept with the entry so it still reads correctly if the account changes later.
+	// A bootstrapped admin is recorded before their account is stored, so they are named by the target.
+	actorName := ""
+	if actor, err := s.userRepo.FetchUserByID(actorID); err == nil {
+		actorName = actor.StandardUser.Username
+	} else if action == models.AuditUserBootstrap {
+		actorName = target
+	}
+
+	entry := &models.AuditEntry{
+		ID:        utils.GenerateUUID(),
+		ActorID:   actorID,
+		ActorName: actorName,
+		Action:    action,
+		Target:    target,
+		Before:    beforeJSON,
+		After:     afterJSON,
+		Timestamp: time.Now().UTC(),
+	}
+	return s.auditRepo.AppendAuditEntry(entry)
+}
+
+// GetAuditLog returns one page of the matching entries, the newest first
+func (s *AuditService) GetAuditLog(actorID string, filter models.AuditFilter, request models.PageRequest) (*models.AuditPage, error) {
+	if err := s.accessService.Authorize(actorID, roles.AUDIT_VIEW); err != nil {
+		return nil, err
+	}
+
+	filter, err := cleanAuditFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	request, err = normalizePageRequest(request, "")
+	if err != nil {
+		return nil, err
+	}
+	return s.auditRepo.FetchAuditEntries(filter, request)
+}
+
+// ExportAuditLog writes every matching entry in the order they were recorded and returns how many were written
+func (s *AuditService) ExportAuditLog(actorID string, writer io.Writer, format string, filter models.AuditFilter) (int, error) {
+	if err := s.accessService.Authorize(actorID, roles.AUDIT_VIEW); err != nil {
+		return 0, err
+	}
+
+	format, err := validation.ValidateExportFormat(format)
+	if err != nil {
+		return 0, err
+	}
+	if format == models.ExportFormatMarkdown {
+		return 0, ErrUnsupportedAuditFormat
+	}
+
+	filter, err = cleanAuditFilter(filter)
+	if err != nil {
+		return 0, err
+	}
+	entries, err := s.auditRepo.FetchAllAuditEntries(filter)
+	if err != nil {
+		return 0, err
+	}
+
+	switch format {
+	case models.ExportFormatCSV:
+		err = writeAuditCSV(writer, *entries)
+	case models.ExportFormatJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(auditJSONEntries(*entries))
+	}
+	if err != nil {
+		return 0, fmt.Errorf("could not write audit log: %v", err)
+	}
+
+	return len(*entries), nil
+}
+
+// auditSnapshot is the JSON stored for one side of an action
+func auditSnapshot(value interface{}) (string, error) {
+	if value == nil {
+		return "", nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	return string(data), nil
+}
+
+func cleanAuditFilter(filter models.AuditFilter) (models.AuditFilter, error) {
+	filter.Actor = strings.TrimSpace(filter.Actor)
+	filter.Action = data_cleaning.CleanString(filter.Action)
+	filter.Target = strings.TrimSpace(filter.Target)
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return filter, ErrInvalidAuditPeriod
+	}
+	return filter, nil
+}
+
+func writeAuditCSV(writer io.Writer, entries []models.AuditEntry) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(auditCSVHeader); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		record := []string{
+			entry.Timestamp.Format(time.RFC3339),
+			entry.ActorID,
+			entry.ActorName,
+			entry.Action,
+			entry.Target,
+			entry.Before,
+			entry.After,
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// auditJSONEntry embeds the stored snapshots as JSON rather than as strings holding JSON
+type auditJSONEntry struct {
+	ID        string          `json:"id"`
+	Timestamp time.Time       `json:"timestamp"`
+	ActorID   string          `json:"actor_id"`
+	ActorName string          `json:"actor"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+}
+
+func auditJSONEntries(entries []models.AuditEntry) []auditJSONEntry {
+	exported := make([]auditJSONEntry, 0, len(entries))
+	for _, entry := range entries {
+		exported = append(exported, auditJSONEntry{
+			ID:        entry.ID,
+			Timestamp: entry.Timestamp,
+			ActorID:   entry.ActorID,
+			ActorName: entry.ActorName,
+			Action:    entry.Action,
+			Target:    entry.Target,
+			Before:    auditRawJSON(entry.Before),
+			After:     auditRawJSON(entry.After),
+		})
+	}
+	return exported
+}
+
+// auditRawJSON turns an empty snapshot into null
+func auditRawJSON(snapshot string) json.RawMessage {
+	if snapshot == "" {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(snapshot)
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var (
+	ErrInvalidScope      = errors.New("invalid leaderboard scope: must be 'global', 'organisation' or 'country'")
+	ErrUserNotOnBoard    = errors.New("user is not on the leaderboard")
+	ErrMissingScopeValue = errors.New("leaderboard scope value is empty")
+)
+
+type LeaderboardService struct {
+	leaderboardRepo interfaces.LeaderboardRepository
+}
+
+func NewLeaderboardService(leaderboardRepo interfaces.LeaderboardRepository) interfaces.LeaderboardService {
+	return &LeaderboardService{
+		leaderboardRepo: leaderboardRepo,
+	}
+}
+
+// GetLeaderboard ranks users by their solved questions weighted by difficulty.
+// scopeValue is the organisation or country name and is ignored for the global scope.
+func (s *LeaderboardService) GetLeaderboard(scope, scopeValue string) (*models.Leaderboard, error) {
+
+	scope = data_cleaning.CleanString(scope)
+
+	// Organisation and country are stored capitalized, see Signup
+	scopeValue = data_cleaning.CapitalizeWords(data_cleaning.CleanString(scopeValue))
+
+	var organisation, country string
+	switch scope {
+	case models.GlobalScope:
+		scopeValue = ""
+	case models.OrganisationScope:
+		organisation = scopeValue
+	case models.CountryScope:
+		country = scopeValue
+	default:
+		return nil, ErrInvalidScope
+	}
+
+	if scope != models.GlobalScope && scopeValue == "" {
+		return nil, ErrMissingScopeValue
+	}
+
+	entries, err := s.leaderboardRepo.FetchSolveCounts(organisation, country)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch solve counts: %v", err)
+	}
+
+	ranked := rankEntries(*entries)
+
+	return &models.Leaderboard{
+		Scope:       scope,
+		ScopeValue:  scopeValue,
+		Entries:     ranked,
+		GeneratedAt: time.Now().UTC(),
+	}, nil
+}
+
+// GetUserStanding returns the entry of the given user on an already computed leaderboard.
+func (s *LeaderboardService) GetUserStanding(board *models.Leaderboard, userID string) (*models.LeaderboardEntry, error) {
+	for i := range board.Entries {
+		if board.Entries[i].UserID == userID {
+			return &board.Entries[i], nil
+		}
+	}
+	return nil, ErrUserNotOnBoard
+}
+
+// rankEntries scores and sorts the entries, users with equal scores share the same rank.
+func rankEntries(entries []models.LeaderboardEntry) []models.LeaderboardEntry {
+	for i := range entries {
+		entries[i].Score = entries[i].EasySolved*config.EASY_QUESTION_SCORE +
+			entries[i].MediumSolved*config.MEDIUM_QUESTION_SCORE +
+			entries[i].HardSolved*config.HARD_QUESTION_SCORE
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Score != entries[j].Score {
+			return entries[i].Score > entries[j].Score
+		}
+		if entries[i].HardSolved != entries[j].HardSolved {
+			return entries[i].HardSolved > entries[j].HardSolved
+		}
+		return entries[i].Username < entries[j].Username
+	})
+
+	for i := range entries {
+		if i > 0 && entries[i].Score == entries[i-1].Score {
+			entries[i].Rank = entries[i-1].Rank
+		} else {
+			entries[i].Rank = i + 1
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,199 @@
+package services
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/fuzzy"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	ErrNoteNotFound = errors.New("you have no notes on that question")
+	ErrEmptyNote    = errors.New("note cannot be empty")
+	ErrNoteTooLong  = fmt.Errorf("note cannot be longer than %d characters", config.NOTE_MAX_LENGTH)
+)
+
+// noteExcerptLength is how many characters of the matching line a search result shows
+const noteExcerptLength = 80
+
+type NoteService struct {
+	noteRepo        interfaces.NoteRepository
+	questionService interfaces.QuestionService
+}
+
+func NewNoteService(noteRepo interfaces.NoteRepository, questionService interfaces.QuestionService) interfaces.NoteService {
+	return &NoteService{
+		noteRepo:        noteRepo,
+		questionService: questionService,
+	}
+}
+
+// GetNote returns the user's note on the question
+func (s *NoteService) GetNote(userID, questionID string) (*models.Note, error) {
+	note, err := s.noteRepo.FetchNote(userID, data_cleaning.CleanString(questionID))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrNoteNotFound
+	}
+	return note, err
+}
+
+// GetNotes returns every note of the user, most recently updated first
+func (s *NoteService) GetNotes(userID string) (*[]models.Note, error) {
+	return s.noteRepo.FetchNotes(userID)
+}
+
+// SaveNote creates or replaces the user's note on the question. The body is Markdown, and its fenced
+// code blocks are kept as snippets with their language tag.
+func (s *NoteService) SaveNote(userID, questionID, body string) (*models.Note, error) {
+	questionID = data_cleaning.CleanString(questionID)
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, ErrEmptyNote
+	}
+	if utf8.RuneCountInString(body) > config.NOTE_MAX_LENGTH {
+		return nil, ErrNoteTooLong
+	}
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		return nil, fmt.Errorf("could not check if question exists: %v", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("question with ID %s does not exist", questionID)
+	}
+
+	now := time.Now().UTC()
+	note, err := s.noteRepo.FetchNote(userID, questionID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		note = &models.Note{UserID: userID, QuestionID: questionID, CreatedAt: now}
+	} else if err != nil {
+		return nil, err
+	}
+
+	note.Body = body
+	note.Snippets = extractSnippets(body)
+	note.UpdatedAt = now
+	if err := s.noteRepo.UpsertNote(note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+// DeleteNote removes the user's note on the question
+func (s *NoteService) DeleteNote(userID, questionID string) error {
+	err := s.noteRepo.DeleteNote(userID, data_cleaning.CleanString(questionID))
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return ErrNoteNotFound
+	}
+	return err
+}
+
+// SearchNotes returns the user's notes containing every word of the query, ignoring case and
+// punctuation, most recently updated first
+func (s *NoteService) SearchNotes(userID, query string) (*[]models.NoteMatch, error) {
+	words := strings.Fields(fuzzy.Normalize(query))
+	if len(words) == 0 {
+		return nil, ErrEmptySearchQuery
+	}
+
+	notes, err := s.noteRepo.FetchNotes(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []models.NoteMatch{}
+	for _, note := range *notes {
+		text := fuzzy.Normalize(note.Body)
+		found := true
+		for _, word := range words {
+			if !strings.Contains(text, word) {
+				found = false
+				break
+			}
+		}
+		if found {
+			matches = append(matches, models.NoteMatch{Note: note, Excerpt: noteExcerpt(note.Body, words)})
+		}
+	}
+
+	return &matches, nil
+}
+
+// noteExcerpt returns the first line of the body containing one of the words, shortened if needed
+func noteExcerpt(body string, words []string) string {
+	for _, line := range strings.Split(body, "\n") {
+		normalized := fuzzy.Normalize(line)
+		for _, word := range words {
+			if !strings.Contains(normalized, word) {
+				continue
+			}
+			line = strings.TrimSpace(line)
+			if utf8.RuneCountInString(line) > noteExcerptLength {
+				line = string([]rune(line)[:noteExcerptLength]) + "..."
+			}
+			return line
+		}
+	}
+	return ""
+}
+
+// extractSnippets collects the fenced code blocks of a Markdown body. A block opens with three or more
+// backticks or tildes followed by an optional language, and runs until a fence at least as long, or
+// to the end of the note when it is never closed.
+func extractSnippets(body string) []models.CodeSnippet {
+	snippets := []models.CodeSnippet{}
+
+	var current *models.CodeSnippet
+	var fence string
+	var code []string
+	for _, line := range strings.Split(body, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		if current == nil {
+			if marker := fenceMarker(trimmed); marker != "" {
+				current = &models.CodeSnippet{Language: data_cleaning.CleanString(firstWord(trimmed[len(marker):]))}
+				fence, code = marker, nil
+			}
+			continue
+		}
+
+		if marker := fenceMarker(trimmed); marker != "" && marker == trimmed && strings.HasPrefix(marker, fence) {
+			current.Code = strings.Join(code, "\n")
+			snippets = append(snippets, *current)
+			current = nil
+			continue
+		}
+		code = append(code, line)
+	}
+
+	if current != nil {
+		current.Code = strings.Join(code, "\n")
+		snippets = append(snippets, *current)
+	}
+	return snippets
+}
+
+// fenceMarker returns the run of backticks or tildes a code fence line starts with, or "" for other lines
+func fenceMarker(line string) string {
+	if !strings.HasPrefix(line, "```") && !strings.HasPrefix(line, "~~~") {
+		return ""
+	}
+	marker := line[:1]
+	end := len(line) - len(strings.TrimLeft(line, marker))
+	return line[:end]
+}
+
+func firstWord(text string) string {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
@@ -1,97 +1,269 @@
 package services
 
 import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
 	"cli-project/internal/domain/interfaces"
 	"cli-project/internal/domain/models"
 	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/fuzzy"
 	"cli-project/pkg/utils/readers"
+	"cli-project/pkg/utils/sorting"
 	"cli-project/pkg/validation"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
 )
 
+var (
+	ErrEmptyQuestionTitle = errors.New("invalid question title: cannot be empty")
+	ErrNoQuestionChanges  = errors.New("no changes to the question were given")
+	ErrEmptyCSV           = errors.New("the CSV file is empty")
+	ErrEmptySearchQuery   = errors.New("search query cannot be empty")
+	ErrInvalidPage        = errors.New("page must be a positive number")
+)
+
+// Columns of a question CSV file
+const (
+	columnID          = "id"
+	columnTitle       = "title"
+	columnDifficulty  = "difficulty"
+	columnLink        = "link"
+	columnTopicTags   = "topic tags"
+	columnCompanyTags = "company tags"
+)
+
+// questionColumnNames maps accepted header names, compared without case, spaces, dashes or underscores, to a column
+var questionColumnNames = map[string]string{
+	"id":                   columnID,
+	"questionid":           columnID,
+	"title":                columnTitle,
+	"questiontitle":        columnTitle,
+	"difficulty":           columnDifficulty,
+	"link":                 columnLink,
+	"questionlink":         columnLink,
+	"leetcodelink":         columnLink,
+	"leetcodequestionlink": columnLink,
+	"topics":               columnTopicTags,
+	"topictags":            columnTopicTags,
+	"companies":            columnCompanyTags,
+	"companytags":          columnCompanyTags,
+}
+
+// questionCSVHeader is the header written by exports, using the names of the bundled question files
+var questionCSVHeader = []string{"ID", "Title", "Difficulty", "Leetcode Question Link", "Topic Tags", "Company Tags"}
+
+// requiredQuestionColumns must be in the header, tag columns may be left out
+var requiredQuestionColumns = []string{columnID, columnTitle, columnDifficulty, columnLink}
+
 type QuestionService struct {
-	questionRepo interfaces.QuestionRepository
+	questionRepo  interfaces.QuestionRepository
+	auditService  interfaces.AuditService
+	accessService interfaces.AccessService
 }
 
-func NewQuestionService(questionRepo interfaces.QuestionRepository) interfaces.QuestionService {
+func NewQuestionService(questionRepo interfaces.QuestionRepository, auditService interfaces.AuditService, accessService interfaces.AccessService) interfaces.QuestionService {
 	return &QuestionService{
-		questionRepo: questionRepo,
+		questionRepo:  questionRepo,
+		auditService:  auditService,
+		accessService: accessService,
 	}
 }
 
-func (s *QuestionService) AddQuestionsFromFile(questionFilePath string) (bool, error) {
+// AddQuestionsFromFile adds the questions of a CSV file that are not in the bank yet and leaves existing ones alone.
+// Unlike ImportQuestionsFromFile it rejects the whole file when any row is invalid.
+func (s *QuestionService) AddQuestionsFromFile(actorID, questionFilePath string) (bool, error) {
+	if err := s.accessService.Authorize(actorID, roles.QUESTIONS_WRITE); err != nil {
+		return false, err
+	}
 
-	records, err := readers.ReadCSV(questionFilePath)
+	report, err := s.diffQuestionFile(questionFilePath)
 	if err != nil {
 		return false, err
 	}
 
 	var questions []models.Question
-	newQuestionsAdded := false
-
-	for i, record := range records {
-		if i == 0 {
-			continue
+	for _, row := range report.Rows {
+		switch row.Status {
+		case models.ImportRowInvalid:
+			return false, fmt.Errorf("row %d: %s", row.Row, row.Reason)
+		case models.ImportRowNew:
+			questions = append(questions, row.Question)
 		}
+	}
 
-		if len(record) != 6 {
-			return false, errors.New("invalid CSV format")
-		}
+	if len(questions) == 0 {
+		return false, nil
+	}
+	if err := s.auditService.Record(actorID, models.AuditQuestionAdd, filepath.Base(questionFilePath), nil, questions); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	if err := s.questionRepo.AddQuestions(&questions); err != nil {
+		return false, err
+	}
+	return true, nil
+}
 
-		questionID := data_cleaning.CleanString(record[0])
-		valid, err := validation.ValidateQuestionID(questionID)
-		if !valid {
-			return false, err
-		}
+// ImportQuestionsFromFile compares a CSV file with the bank, then adds new questions and overwrites changed ones.
+// Invalid rows are reported and skipped, and a dry run only reports what would change.
+func (s *QuestionService) ImportQuestionsFromFile(actorID, questionFilePath string, dryRun bool) (*models.ImportReport, error) {
+	if err := s.accessService.Authorize(actorID, roles.QUESTIONS_WRITE); err != nil {
+		return nil, err
+	}
 
-		questionTitle := data_cleaning.CleanString(record[1])
-		difficulty := data_cleaning.CleanString(record[2])
-		difficulty, err = validation.ValidateDifficulty(difficulty)
-		if err != nil {
-			return false, err
-		}
+	report, err := s.diffQuestionFile(questionFilePath)
+	if err != nil {
+		return nil, err
+	}
 
-		questionLink := data_cleaning.CleanString(record[3])
-		questionLink, err = validation.ValidateQuestionLink(questionLink)
-		if err != nil {
-			return false, err
+	var questions, overwritten []models.Question
+	for _, row := range report.Rows {
+		if row.Status == models.ImportRowNew || row.Status == models.ImportRowChanged {
+			questions = append(questions, row.Question)
+		}
+		if row.Status == models.ImportRowChanged {
+			overwritten = append(overwritten, *row.Previous)
 		}
+	}
 
-		topicTags := data_cleaning.CleanTags(record[4])
-		companyTags := data_cleaning.CleanTags(record[5])
+	if dryRun || len(questions) == 0 {
+		return report, nil
+	}
 
-		question := models.Question{
-			QuestionID:    questionID,
-			QuestionTitle: questionTitle,
-			Difficulty:    difficulty,
-			QuestionLink:  questionLink,
-			TopicTags:     topicTags,
-			CompanyTags:   companyTags,
-		}
+	// One entry for the whole file, before holds the questions it overwrites
+	if err := s.auditService.Record(actorID, models.AuditQuestionImport, filepath.Base(questionFilePath), overwritten, questions); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	if err := s.questionRepo.UpsertQuestions(&questions); err != nil {
+		return nil, err
+	}
+	report.Applied = true
+	return report, nil
+}
 
-		exists, err := s.QuestionExists(questionID)
-		if err != nil {
-			return false, err
-		}
+// ExportQuestions writes the whole bank, or the questions matching the filters, ordered by ID.
+// CSV exports use the same columns the import reads, so they can be loaded back in.
+func (s *QuestionService) ExportQuestions(actorID string, writer io.Writer, format, difficulty, company, topic string) (int, error) {
+	if err := s.accessService.Authorize(actorID, roles.QUESTIONS_WRITE); err != nil {
+		return 0, err
+	}
 
-		if !exists {
-			questions = append(questions, question)
-			newQuestionsAdded = true
-		}
+	format, err := validation.ValidateExportFormat(format)
+	if err != nil {
+		return 0, err
 	}
 
-	if newQuestionsAdded {
-		err = s.questionRepo.AddQuestions(&questions)
-		if err != nil {
-			return false, err
+	var questions *[]models.Question
+	if difficulty == "" && company == "" && topic == "" {
+		questions, err = s.GetAllQuestions()
+	} else {
+		questions, err = s.GetQuestionsByFilters(difficulty, company, topic)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	sorted := append([]models.Question{}, *questions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorting.LessQuestionID(sorted[i].QuestionID, sorted[j].QuestionID)
+	})
+
+	switch format {
+	case models.ExportFormatCSV:
+		err = writeQuestionsCSV(writer, sorted)
+	case models.ExportFormatJSON:
+		encoder := json.NewEncoder(writer)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(sorted)
+	case models.ExportFormatMarkdown:
+		err = writeQuestionsMarkdown(writer, sorted)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("could not write questions: %v", err)
+	}
+
+	return len(sorted), nil
+}
+
+// diffQuestionFile classifies every row of a CSV file against the questions already in the bank
+func (s *QuestionService) diffQuestionFile(questionFilePath string) (*models.ImportReport, error) {
+	records, err := readers.ReadCSV(questionFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, ErrEmptyCSV
+	}
+
+	columns, err := parseQuestionHeader(records[0])
+	if err != nil {
+		return nil, err
+	}
+
+	// One fetch for the whole file instead of a lookup per row
+	existing, err := s.questionRepo.FetchAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+	byID := make(map[string]models.Question, len(*existing))
+	for _, question := range *existing {
+		byID[question.QuestionID] = question
+	}
+
+	report := &models.ImportReport{Rows: []models.ImportRow{}}
+	firstRow := make(map[string]int)
+
+	for i, record := range records[1:] {
+		row := models.ImportRow{Row: i + 2}
+
+		question, err := parseQuestionRecord(columns, record)
+		row.QuestionID = question.QuestionID
+		row.Question = question
+
+		switch {
+		case err != nil:
+			row.Status, row.Reason = models.ImportRowInvalid, err.Error()
+		case firstRow[question.QuestionID] != 0:
+			row.Status, row.Reason = models.ImportRowInvalid, fmt.Sprintf("duplicate question ID, already on row %d", firstRow[question.QuestionID])
+		default:
+			firstRow[question.QuestionID] = row.Row
+			if current, ok := byID[question.QuestionID]; !ok {
+				row.Status = models.ImportRowNew
+			} else if row.Changes = questionChanges(current, question); len(row.Changes) > 0 {
+				row.Status = models.ImportRowChanged
+				row.Previous = &current
+			} else {
+				row.Status = models.ImportRowUnchanged
+			}
+		}
+
+		switch row.Status {
+		case models.ImportRowNew:
+			report.New++
+		case models.ImportRowChanged:
+			report.Changed++
+		case models.ImportRowUnchanged:
+			report.Unchanged++
+		case models.ImportRowInvalid:
+			report.Invalid++
 		}
+		report.Rows = append(report.Rows, row)
 	}
 
-	return newQuestionsAdded, nil
+	return report, nil
 }
 
-func (s *QuestionService) RemoveQuestionByID(questionID string) error {
+func (s *QuestionService) RemoveQuestionByID(actorID, questionID string) error {
+	if err := s.accessService.Authorize(actorID, roles.QUESTIONS_WRITE); err != nil {
+		return err
+	}
+
 	// Check if the question exists in the database
 	exists, err := s.QuestionExists(questionID)
 	if err != nil {
@@ -102,10 +274,80 @@ func (s *QuestionService) RemoveQuestionByID(questionID string) error {
 		return fmt.Errorf("question with ID %s not found", questionID)
 	}
 
+	// Keep the question for the audit log before it is gone
+	question, err := s.questionRepo.FetchQuestionByID(questionID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.auditService.Record(actorID, models.AuditQuestionRemove, questionID, question, nil); err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+
 	// Call repository to remove the question
 	return s.questionRepo.RemoveQuestionByID(questionID)
 }
 
+// UpdateQuestion changes the given fields of a question, cleaning and validating them the same way as a CSV import
+func (s *QuestionService) UpdateQuestion(actorID, questionID string, update models.QuestionUpdate) (*models.Question, error) {
+	if err := s.accessService.Authorize(actorID, roles.QUESTIONS_WRITE); err != nil {
+		return nil, err
+	}
+
+	if update.QuestionTitle == nil && update.Difficulty == nil && update.QuestionLink == nil && update.TopicTags == nil && update.CompanyTags == nil {
+		return nil, ErrNoQuestionChanges
+	}
+
+	question, err := s.GetQuestionByID(data_cleaning.CleanString(questionID))
+	if err != nil {
+		return nil, err
+	}
+	before := *question
+
+	if update.QuestionTitle != nil {
+		title, err := cleanQuestionTitle(*update.QuestionTitle)
+		if err != nil {
+			return nil, err
+		}
+		question.QuestionTitle = title
+	}
+
+	if update.Difficulty != nil {
+		difficulty, err := validation.ValidateDifficulty(*update.Difficulty)
+		if err != nil {
+			return nil, err
+		}
+		question.Difficulty = difficulty
+	}
+
+	if update.QuestionLink != nil {
+		link, err := validation.ValidateQuestionLink(*update.QuestionLink)
+		if err != nil {
+			return nil, err
+		}
+		question.QuestionLink = link
+	}
+
+	if update.TopicTags != nil {
+		question.TopicTags = cleanTagList(*update.TopicTags)
+	}
+	if update.CompanyTags != nil {
+		question.CompanyTags = cleanTagList(*update.CompanyTags)
+	}
+
+	if err := s.auditService.Record(actorID, models.AuditQuestionUpdate, question.QuestionID, before, *question); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	if err := s.questionRepo.UpdateQuestion(question); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("question with ID %s not found", question.QuestionID)
+		}
+		return nil, err
+	}
+
+	return question, nil
+}
+
 func (s *QuestionService) GetQuestionByID(questionID string) (*models.Question, error) {
 	// Check if the question exists
 	exists, err := s.QuestionExists(questionID)
@@ -130,10 +372,16 @@ func (s *QuestionService) GetAllQuestions() (*[]models.Question, error) {
 }
 
 func (s *QuestionService) GetQuestionsByFilters(difficulty, company, topic string) (*[]models.Question, error) {
-	// Validate and clean the difficulty level
-	validDifficulty, err := validation.ValidateDifficulty(difficulty)
-	if err != nil {
-		return nil, err
+	// Validate and clean the difficulty level, an empty or "any" difficulty matches every level
+	validDifficulty := data_cleaning.CleanString(difficulty)
+	if validDifficulty == "any" {
+		validDifficulty = ""
+	}
+	if validDifficulty != "" {
+		var err error
+		if validDifficulty, err = validation.ValidateDifficulty(validDifficulty); err != nil {
+			return nil, err
+		}
 	}
 
 	// Clean company and topic strings
@@ -144,6 +392,216 @@ func (s *QuestionService) GetQuestionsByFilters(difficulty, company, topic strin
 	return s.questionRepo.FetchQuestionsByFilters(validDifficulty, cleanCompany, cleanTopic)
 }
 
+// GetQuestionsPage returns one page of the question bank leaving out excludeIDs, such as the questions
+// a user has solved. It is sorted by ID unless the request says otherwise.
+func (s *QuestionService) GetQuestionsPage(request models.PageRequest, excludeIDs []string) (*models.QuestionPage, error) {
+	request, err := normalizePageRequest(request, models.SortByID, models.SortByID, models.SortByTitle, models.SortByDifficulty)
+	if err != nil {
+		return nil, err
+	}
+	return s.questionRepo.FetchQuestionsPage(request, excludeIDs)
+}
+
+// GetCompletionByDifficulty counts how many questions of each difficulty in the bank are solved,
+// from easy to hard. Solved IDs that are no longer in the bank are not counted.
+func (s *QuestionService) GetCompletionByDifficulty(solvedQuestionIDs []string) (*[]models.DifficultyCompletion, error) {
+	questions, err := s.questionRepo.FetchAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+
+	solved := make(map[string]bool, len(solvedQuestionIDs))
+	for _, questionID := range solvedQuestionIDs {
+		solved[questionID] = true
+	}
+
+	completion := make([]models.DifficultyCompletion, len(sorting.DifficultyOrder))
+	for i, difficulty := range sorting.DifficultyOrder {
+		completion[i].Difficulty = difficulty
+	}
+	for _, question := range *questions {
+		for i := range completion {
+			if completion[i].Difficulty != question.Difficulty {
+				continue
+			}
+			completion[i].Total++
+			if solved[question.QuestionID] {
+				completion[i].Solved++
+			}
+		}
+	}
+
+	return &completion, nil
+}
+
+// normalizePageRequest validates the page number and sort field, filling in the defaults for a zero request
+func normalizePageRequest(request models.PageRequest, defaultSort string, sortFields ...string) (models.PageRequest, error) {
+	if request.Page < 0 {
+		return request, ErrInvalidPage
+	}
+	if request.Page == 0 {
+		request.Page = 1
+	}
+	if request.PageSize <= 0 {
+		request.PageSize = config.PAGE_SIZE
+	}
+	request.PageSize = min(request.PageSize, config.MAX_PAGE_SIZE)
+
+	if request.SortBy == "" {
+		request.SortBy = defaultSort
+		return request, nil
+	}
+	sortBy, err := validation.ValidateSortField(request.SortBy, sortFields...)
+	if err != nil {
+		return request, err
+	}
+	request.SortBy = sortBy
+	return request, nil
+}
+
+// FilterQuestions returns the questions matching every part of the filter, ordered by ID
+func (s *QuestionService) FilterQuestions(filter models.QuestionFilter) (*[]models.Question, error) {
+	difficulties := make(map[string]bool)
+	for _, difficulty := range cleanFilterValues(filter.Difficulties) {
+		validDifficulty, err := validation.ValidateDifficulty(difficulty)
+		if err != nil {
+			return nil, err
+		}
+		difficulties[validDifficulty] = true
+	}
+
+	status, err := validation.ValidateSolveStatus(filter.Status)
+	if err != nil {
+		return nil, err
+	}
+	solved := make(map[string]bool, len(filter.SolvedQuestionIDs))
+	for _, questionID := range filter.SolvedQuestionIDs {
+		solved[questionID] = true
+	}
+
+	topics, companies := cleanFilterValues(filter.Topics), cleanFilterValues(filter.Companies)
+	excludeTopics, excludeCompanies := cleanFilterValues(filter.ExcludeTopics), cleanFilterValues(filter.ExcludeCompanies)
+
+	questions, err := s.questionRepo.FetchAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+
+	matching := []models.Question{}
+	for _, question := range *questions {
+		switch {
+		case len(difficulties) > 0 && !difficulties[question.Difficulty]:
+		case status == models.SolveStatusSolved && !solved[question.QuestionID]:
+		case status == models.SolveStatusUnsolved && solved[question.QuestionID]:
+		case !matchesTags(question.TopicTags, topics, filter.MatchAllTopics):
+		case !matchesTags(question.CompanyTags, companies, filter.MatchAllCompanies):
+		case countTags(question.TopicTags, excludeTopics) > 0:
+		case countTags(question.CompanyTags, excludeCompanies) > 0:
+		default:
+			matching = append(matching, question)
+		}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		return sorting.LessQuestionID(matching[i].QuestionID, matching[j].QuestionID)
+	})
+	return &matching, nil
+}
+
+// cleanFilterValues cleans a list of filter values, dropping "any" which matches everything
+func cleanFilterValues(values []string) []string {
+	cleaned := cleanTagList(values)
+	for i, value := range cleaned {
+		if value == "any" {
+			return append(cleaned[:i:i], cleaned[i+1:]...)
+		}
+	}
+	return cleaned
+}
+
+// matchesTags reports whether a question has any of the wanted tags, or all of them when all is set
+func matchesTags(questionTags, wanted []string, all bool) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	found := countTags(questionTags, wanted)
+	if all {
+		return found == len(wanted)
+	}
+	return found > 0
+}
+
+// countTags counts how many of the wanted tags the question has
+func countTags(questionTags, wanted []string) int {
+	found := 0
+	for _, tag := range wanted {
+		if hasTag(questionTags, tag) {
+			found++
+		}
+	}
+	return found
+}
+
+// Tags count a little less than the title, so a question named after the query ranks above one merely tagged with it
+const (
+	topicMatchWeight   = 0.9
+	companyMatchWeight = 0.85
+)
+
+// SearchQuestions ranks questions by how closely their title or tags match the query, tolerating
+// case, punctuation and small typos. A question ID matches exactly. A limit of 0 uses the default.
+func (s *QuestionService) SearchQuestions(query string, limit int) (*[]models.SearchResult, error) {
+	if fuzzy.Normalize(query) == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	if limit <= 0 {
+		limit = config.SEARCH_LIMIT
+	}
+
+	questions, err := s.questionRepo.FetchAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+
+	questionID := data_cleaning.CleanString(query)
+	results := []models.SearchResult{}
+	for _, question := range *questions {
+		result := models.SearchResult{Question: question}
+		match := func(field, text string, weight float64) {
+			if score := weight * fuzzy.Score(query, text); score > result.Score {
+				result.Score, result.MatchedOn = score, field
+			}
+		}
+
+		if question.QuestionID == questionID {
+			result.Score, result.MatchedOn = 1, "id"
+		}
+		match("title", question.QuestionTitle, 1)
+		for _, tag := range question.TopicTags {
+			match("topic: "+tag, tag, topicMatchWeight)
+		}
+		for _, tag := range question.CompanyTags {
+			match("company: "+tag, tag, companyMatchWeight)
+		}
+
+		if result.Score >= config.SEARCH_MIN_SCORE {
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return sorting.LessQuestionID(results[i].Question.QuestionID, results[j].Question.QuestionID)
+	})
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return &results, nil
+}
+
 func (s *QuestionService) QuestionExists(questionID string) (bool, error) {
 	// Validate the question ID
 	valid, err := validation.ValidateQuestionID(questionID)
@@ -157,3 +615,160 @@ func (s *QuestionService) QuestionExists(questionID string) (bool, error) {
 func (s *QuestionService) GetTotalQuestionsCount() (int64, error) {
 	return s.questionRepo.CountQuestions()
 }
+
+// cleanTagList cleans every tag and drops empty and repeated ones, keeping the first occurrence
+func cleanTagList(tags []string) []string {
+	cleaned := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = data_cleaning.CleanString(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		cleaned = append(cleaned, tag)
+	}
+	return cleaned
+}
+
+// parseQuestionHeader finds the position of every known column, ignoring columns it does not know
+func parseQuestionHeader(header []string) (map[string]int, error) {
+	columns := make(map[string]int)
+	for i, name := range header {
+		key := strings.NewReplacer(" ", "", "_", "", "-", "").Replace(data_cleaning.CleanString(strings.TrimPrefix(name, "\ufeff")))
+		column, ok := questionColumnNames[key]
+		if !ok {
+			continue
+		}
+		if _, duplicate := columns[column]; duplicate {
+			return nil, fmt.Errorf("invalid CSV header: column %q appears more than once", column)
+		}
+		columns[column] = i
+	}
+
+	var missing []string
+	for _, column := range requiredQuestionColumns {
+		if _, ok := columns[column]; !ok {
+			missing = append(missing, column)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("invalid CSV header: missing %s column", strings.Join(missing, ", "))
+	}
+	return columns, nil
+}
+
+// parseQuestionRecord cleans and validates one CSV row, returning whatever ID it could read even on failure
+func parseQuestionRecord(columns map[string]int, record []string) (models.Question, error) {
+	field := func(column string) string {
+		index, ok := columns[column]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return record[index]
+	}
+
+	question := models.Question{QuestionID: data_cleaning.CleanString(field(columnID))}
+	for _, column := range requiredQuestionColumns {
+		if columns[column] >= len(record) {
+			return question, fmt.Errorf("missing %s column, the row has only %d columns", column, len(record))
+		}
+	}
+
+	if valid, err := validation.ValidateQuestionID(question.QuestionID); !valid {
+		return question, err
+	}
+
+	var err error
+	if question.QuestionTitle, err = cleanQuestionTitle(field(columnTitle)); err != nil {
+		return question, err
+	}
+	if question.Difficulty, err = validation.ValidateDifficulty(field(columnDifficulty)); err != nil {
+		return question, err
+	}
+	if question.QuestionLink, err = validation.ValidateQuestionLink(field(columnLink)); err != nil {
+		return question, err
+	}
+	question.TopicTags = cleanTagList(data_cleaning.CleanTags(field(columnTopicTags)))
+	question.CompanyTags = cleanTagList(data_cleaning.CleanTags(field(columnCompanyTags)))
+
+	return question, nil
+}
+
+func cleanQuestionTitle(title string) (string, error) {
+	title = data_cleaning.CleanString(title)
+	if title == "" {
+		return "", ErrEmptyQuestionTitle
+	}
+	return title, nil
+}
+
+// questionChanges describes each field that differs between the stored question and the imported one
+func questionChanges(before, after models.Question) []string {
+	var changes []string
+	describe := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, fmt.Sprintf("%s: %q -> %q", field, from, to))
+		}
+	}
+
+	describe("title", before.QuestionTitle, after.QuestionTitle)
+	describe("difficulty", before.Difficulty, after.Difficulty)
+	describe("link", before.QuestionLink, after.QuestionLink)
+	describe("topic tags", strings.Join(before.TopicTags, ","), strings.Join(after.TopicTags, ","))
+	describe("company tags", strings.Join(before.CompanyTags, ","), strings.Join(after.CompanyTags, ","))
+	return changes
+}
+
+func writeQuestionsCSV(writer io.Writer, questions []models.Question) error {
+	csvWriter := csv.NewWriter(writer)
+	if err := csvWriter.Write(questionCSVHeader); err != nil {
+		return err
+	}
+	for _, question := range questions {
+		record := []string{
+			question.QuestionID,
+			question.QuestionTitle,
+			question.Difficulty,
+			question.QuestionLink,
+			strings.Join(question.TopicTags, ","),
+			strings.Join(question.CompanyTags, ","),
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return err
+		}
+	}
+	csvWriter.Flush()
+	return csvWriter.Error()
+}
+
+// writeQuestionsMarkdown writes a checklist grouped by difficulty, from easy to hard
+func writeQuestionsMarkdown(writer io.Writer, questions []models.Question) error {
+	escape := strings.NewReplacer("[", "\\[", "]", "\\]")
+
+	var builder strings.Builder
+	builder.WriteString("# Question bank\n")
+	for _, difficulty := range sorting.DifficultyOrder {
+		var items []string
+		for _, question := range questions {
+			if question.Difficulty != difficulty {
+				continue
+			}
+			item := fmt.Sprintf("- [ ] %s. [%s](%s)", question.QuestionID, escape.Replace(data_cleaning.CapitalizeWords(question.QuestionTitle)), question.QuestionLink)
+			if len(question.TopicTags) > 0 {
+				item += " - " + strings.Join(question.TopicTags, ", ")
+			}
+			if len(question.CompanyTags) > 0 {
+				item += " (" + strings.Join(question.CompanyTags, ", ") + ")"
+			}
+			items = append(items, item)
+		}
+		if len(items) == 0 {
+			continue
+		}
+		fmt.Fprintf(&builder, "\n## %s (%d)\n\n%s\n", data_cleaning.CapitalizeWords(difficulty), len(items), strings.Join(items, "\n"))
+	}
+
+	_, err := io.WriteString(writer, builder.String())
+	return err
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/sorting"
+	"cli-project/pkg/validation"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrUnknownCompany = errors.New("no questions are tagged with that company")
+
+// difficultyFit scores a question by how many levels it is away from the user's level
+var difficultyFit = []float64{1, 0.4, 0.1}
+
+// Weights of the parts of a recommendation score
+const (
+	difficultyWeight    = 3.0
+	weakTopicWeight     = 2.0
+	targetCompanyWeight = 4.0
+	weakCompanyWeight   = 0.5
+)
+
+type RecommendationService struct {
+	userService     interfaces.UserService
+	questionService interfaces.QuestionService
+}
+
+func NewRecommendationService(userService interfaces.UserService, questionService interfaces.QuestionService) interfaces.RecommendationService {
+	return &RecommendationService{
+		userService:     userService,
+		questionService: questionService,
+	}
+}
+
+// RecommendQuestions ranks the user's unsolved questions by how well they fit the user's level,
+// how under-practised their topics are compared with the rest of the bank, and the target company.
+func (s *RecommendationService) RecommendQuestions(userID string, options models.RecommendationOptions) (*models.RecommendationReport, error) {
+	progression, err := validation.ValidateProgression(options.Progression)
+	if err != nil {
+		return nil, err
+	}
+	company := data_cleaning.CleanString(options.TargetCompany)
+	limit := options.Limit
+	if limit <= 0 {
+		limit = config.RECOMMENDATION_LIMIT
+	}
+
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questionService.GetAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+
+	solved := make(map[string]bool, len(user.QuestionsSolved))
+	for _, questionID := range user.QuestionsSolved {
+		solved[questionID] = true
+	}
+
+	topics := tagCoverage(*questions, solved, func(question models.Question) []string { return question.TopicTags })
+	companies := tagCoverage(*questions, solved, func(question models.Question) []string { return question.CompanyTags })
+
+	if company != "" && companies[company] == nil {
+		return nil, ErrUnknownCompany
+	}
+
+	level := progression
+	if progression == models.ProgressionGradual {
+		level = currentLevel(*questions, solved)
+	}
+
+	report := &models.RecommendationReport{
+		Level:           level,
+		WeakTopics:      weakestTags(topics, config.WEAK_TOPIC_LIMIT),
+		Recommendations: []models.Recommendation{},
+	}
+
+	maxTopicTotal, maxCompanyTotal := largestTotal(topics), largestTotal(companies)
+
+	for _, question := range *questions {
+		if solved[question.QuestionID] {
+			continue
+		}
+
+		recommendation := models.Recommendation{Question: question, Reasons: []string{}}
+
+		fit := difficultyFit[len(difficultyFit)-1]
+		if distance := levelDistance(question.Difficulty, level); distance >= 0 && distance < len(difficultyFit) {
+			fit = difficultyFit[distance]
+			if distance == 0 {
+				recommendation.Reasons = append(recommendation.Reasons, "matches your level ("+level+")")
+			}
+		}
+		recommendation.Score += difficultyWeight * fit
+
+		if topic, weakness := weakestTag(question.TopicTags, topics, maxTopicTotal); topic != nil && weakness > 0 {
+			recommendation.Score += weakTopicWeight * weakness
+			recommendation.Reasons = append(recommendation.Reasons, fmt.Sprintf("under-practised topic: %s (%d/%d solved)", topic.Tag, topic.Solved, topic.Total))
+		}
+
+		if company != "" {
+			if hasTag(question.CompanyTags, company) {
+				recommendation.Score += targetCompanyWeight
+				recommendation.Reasons = append(recommendation.Reasons, "asked by "+company)
+			}
+		} else if _, weakness := weakestTag(question.CompanyTags, companies, maxCompanyTotal); weakness > 0 {
+			recommendation.Score += weakCompanyWeight * weakness
+		}
+
+		report.Recommendations = append(report.Recommendations, recommendation)
+	}
+
+	sort.SliceStable(report.Recommendations, func(i, j int) bool {
+		a, b := report.Recommendations[i], report.Recommendations[j]
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return sorting.LessQuestionID(a.Question.QuestionID, b.Question.QuestionID)
+	})
+
+	if len(report.Recommendations) > limit {
+		report.Recommendations = report.Recommendations[:limit]
+	}
+
+	return report, nil
+}
+
+// tagCoverage counts, for every tag in the bank, how many questions carry it and how many of those are solved
+func tagCoverage(questions []models.Question, solved map[string]bool, tags func(models.Question) []string) map[string]*models.TagCoverage {
+	coverage := make(map[string]*models.TagCoverage)
+	for _, question := range questions {
+		for _, tag := range tags(question) {
+			if tag == "" {
+				continue
+			}
+			if coverage[tag] == nil {
+				coverage[tag] = &models.TagCoverage{Tag: tag}
+			}
+			coverage[tag].Total++
+			if solved[question.QuestionID] {
+				coverage[tag].Solved++
+			}
+		}
+	}
+	return coverage
+}
+
+// tagWeakness is the unsolved share of a tag weighted by how common the tag is in the bank,
+// so a barely touched topic that appears everywhere ranks above a rare one
+func tagWeakness(coverage *models.TagCoverage, maxTotal int) float64 {
+	if coverage.Total == 0 || maxTotal == 0 {
+		return 0
+	}
+	unsolved := float64(coverage.Total-coverage.Solved) / float64(coverage.Total)
+	return unsolved * float64(coverage.Total) / float64(maxTotal)
+}
+
+// weakestTag returns the weakest of the question's tags and its weakness
+func weakestTag(tags []string, coverage map[string]*models.TagCoverage, maxTotal int) (*models.TagCoverage, float64) {
+	var weakest *models.TagCoverage
+	weakness := 0.0
+	for _, tag := range tags {
+		if coverage[tag] == nil {
+			continue
+		}
+		if w := tagWeakness(coverage[tag], maxTotal); weakest == nil || w > weakness {
+			weakest, weakness = coverage[tag], w
+		}
+	}
+	return weakest, weakness
+}
+
+// weakestTags lists the tags the user has practised least, weakest first
+func weakestTags(coverage map[string]*models.TagCoverage, limit int) []models.TagCoverage {
+	maxTotal := largestTotal(coverage)
+
+	weak := []models.TagCoverage{}
+	for _, tag := range coverage {
+		if tag.Solved < tag.Total {
+			weak = append(weak, *tag)
+		}
+	}
+
+	sort.Slice(weak, func(i, j int) bool {
+		wi, wj := tagWeakness(&weak[i], maxTotal), tagWeakness(&weak[j], maxTotal)
+		if wi != wj {
+			return wi > wj
+		}
+		return weak[i].Tag < weak[j].Tag
+	})
+
+	if len(weak) > limit {
+		weak = weak[:limit]
+	}
+	return weak
+}
+
+func largestTotal(coverage map[string]*models.TagCoverage) int {
+	largest := 0
+	for _, tag := range coverage {
+		if tag.Total > largest {
+			largest = tag.Total
+		}
+	}
+	return largest
+}
+
+// currentLevel is the easiest difficulty the user has not yet solved enough questions of
+func currentLevel(questions []models.Question, solved map[string]bool) string {
+	total := make(map[string]int)
+	done := make(map[string]int)
+	for _, question := range questions {
+		total[question.Difficulty]++
+		if solved[question.QuestionID] {
+			done[question.Difficulty]++
+		}
+	}
+
+	for _, level := range sorting.DifficultyOrder {
+		needed := config.RECOMMENDATION_LEVEL_UP_SOLVES
+		if total[level] < needed {
+			needed = total[level]
+		}
+		if done[level] < needed {
+			return level
+		}
+	}
+	return sorting.DifficultyOrder[len(sorting.DifficultyOrder)-1]
+}
+
+// levelDistance is how many levels apart two difficulties are, or -1 when either is unknown
+func levelDistance(difficulty, level string) int {
+	a, b := -1, -1
+	for i, l := range sorting.DifficultyOrder {
+		if l == difficulty {
+			a = i
+		}
+		if l == level {
+			b = i
+		}
+	}
+	if a < 0 || b < 0 {
+		return -1
+	}
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+func hasTag(tags []string, tag string) bool {
+	for _, t := range tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package services
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"math"
+	"time"
+)
+
+var ErrInvalidRating = errors.New("invalid rating: must be between 0 and 5")
+
+type ReviewService struct {
+	reviewRepo      interfaces.ReviewRepository
+	questionService interfaces.QuestionService
+}
+
+func NewReviewService(reviewRepo interfaces.ReviewRepository, questionService interfaces.QuestionService) interfaces.ReviewService {
+	return &ReviewService{
+		reviewRepo:      reviewRepo,
+		questionService: questionService,
+	}
+}
+
+// RecordReview applies a recall rating to the user's card for the question and schedules the next review.
+// The first rating after a solve creates the card.
+func (s *ReviewService) RecordReview(userID, questionID string, rating int) (*models.ReviewCard, error) {
+	if rating < 0 || rating > models.RecallEasy {
+		return nil, ErrInvalidRating
+	}
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		return nil, fmt.Errorf("could not check if question exists: %v", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("question with ID %s does not exist", questionID)
+	}
+
+	card, err := s.reviewRepo.FetchReviewCard(userID, questionID)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+		card = &models.ReviewCard{
+			ID:         userID + ":" + questionID,
+			UserID:     userID,
+			QuestionID: questionID,
+			EaseFactor: config.SM2_INITIAL_EASE_FACTOR,
+		}
+	}
+
+	now := time.Now()
+	scheduleReview(card, rating)
+	card.LastReviewed = now.UTC()
+	card.DueAt = startOfDay(now).AddDate(0, 0, card.Interval).UTC()
+
+	if err := s.reviewRepo.UpsertReviewCard(card); err != nil {
+		return nil, err
+	}
+
+	return card, nil
+}
+
+// GetDueReviews returns the user's cards due today or earlier, most overdue first
+func (s *ReviewService) GetDueReviews(userID string) (*[]models.ReviewCard, error) {
+	endOfToday := startOfDay(time.Now()).AddDate(0, 0, 1)
+	return s.reviewRepo.FetchDueReviewCards(userID, endOfToday.UTC())
+}
+
+// scheduleReview updates the card's interval and ease factor using the SM-2 algorithm
+func scheduleReview(card *models.ReviewCard, rating int) {
+	if rating < models.RecallHard {
+		// Forgotten questions start over but keep their ease factor penalty
+		card.Repetitions = 0
+		card.Interval = 1
+	} else {
+		switch card.Repetitions {
+		case 0:
+			card.Interval = 1
+		case 1:
+			card.Interval = 6
+		default:
+			card.Interval = int(math.Round(float64(card.Interval) * card.EaseFactor))
+		}
+		card.Repetitions++
+	}
+
+	quality := float64(models.RecallEasy - rating)
+	card.EaseFactor += 0.1 - quality*(0.08+quality*0.02)
+	if card.EaseFactor < config.SM2_MIN_EASE_FACTOR {
+		card.EaseFactor = config.SM2_MIN_EASE_FACTOR
+	}
+	card.LastRating = rating
+}
@@ -0,0 +1,115 @@
+package services
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils"
+	"cli-project/pkg/utils/tokens"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+var (
+	ErrInvalidSession = errors.New("invalid session")
+	ErrSessionExpired = errors.New("session expired, please login again")
+	ErrSessionRevoked = errors.New("session has been logged out, please login again")
+)
+
+// sessionClaims is the signed part of a session token
+type sessionClaims struct {
+	SessionID string `json:"sid"`
+	UserID    string `json:"uid"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+type SessionService struct {
+	sessionRepo interfaces.SessionRepository
+	secret      []byte
+}
+
+func NewSessionService(sessionRepo interfaces.SessionRepository, secret []byte) interfaces.SessionService {
+	return &SessionService{
+		sessionRepo: sessionRepo,
+		secret:      secret,
+	}
+}
+
+// CreateSession stores a new session for the user and returns it with its signed token
+func (s *SessionService) CreateSession(user *models.StandardUser) (*models.Session, error) {
+
+	now := time.Now().UTC()
+	session := &models.Session{
+		ID:        utils.GenerateUUID(),
+		UserID:    user.StandardUser.ID,
+		Username:  user.StandardUser.Username,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(config.SESSION_TTL),
+	}
+
+	claims, err := json.Marshal(sessionClaims{
+		SessionID: session.ID,
+		UserID:    session.UserID,
+		ExpiresAt: session.ExpiresAt.Unix(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not encode session: %v", err)
+	}
+
+	if err := s.sessionRepo.CreateSession(session); err != nil {
+		return nil, fmt.Errorf("could not create session: %v", err)
+	}
+
+	session.Token = tokens.Sign(s.secret, claims)
+
+	return session, nil
+}
+
+// ValidateSession checks the token signature and expiry and that the session has not been revoked
+func (s *SessionService) ValidateSession(token string) (*models.Session, error) {
+
+	payload, err := tokens.Verify(s.secret, token)
+	if err != nil {
+		return nil, ErrInvalidSession
+	}
+
+	var claims sessionClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalidSession
+	}
+
+	if time.Now().Unix() >= claims.ExpiresAt {
+		return nil, ErrSessionExpired
+	}
+
+	session, err := s.sessionRepo.FetchSessionByID(claims.SessionID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrInvalidSession
+		}
+		return nil, err
+	}
+
+	if session.UserID != claims.UserID {
+		return nil, ErrInvalidSession
+	}
+
+	if session.Revoked {
+		return nil, ErrSessionRevoked
+	}
+
+	session.Token = token
+
+	return session, nil
+}
+
+// RevokeSession logs the session out so its token can no longer be used
+func (s *SessionService) RevokeSession(session *models.Session) error {
+	if session == nil || session.ID == "" {
+		return ErrInvalidSession
+	}
+	return s.sessionRepo.RevokeSession(session.ID)
+}
@@ -0,0 +1,312 @@
+package services
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"fmt"
+	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
+	"time"
+)
+
+var (
+	ErrStudyListNotFound     = errors.New("study list not found")
+	ErrStudyListForbidden    = errors.New("you can only change your own study lists")
+	ErrDuplicateStudyList    = errors.New("you already have a study list with that name")
+	ErrQuestionAlreadyInList = errors.New("question is already in the list")
+	ErrQuestionNotInList     = errors.New("question is not in the list")
+	ErrInvalidListPosition   = errors.New("invalid position in the list")
+)
+
+type StudyListService struct {
+	studyListRepo   interfaces.StudyListRepository
+	userService     interfaces.UserService
+	questionService interfaces.QuestionService
+	accessService   interfaces.AccessService
+}
+
+func NewStudyListService(studyListRepo interfaces.StudyListRepository, userService interfaces.UserService, questionService interfaces.QuestionService, accessService interfaces.AccessService) interfaces.StudyListService {
+	return &StudyListService{
+		studyListRepo:   studyListRepo,
+		userService:     userService,
+		questionService: questionService,
+		accessService:   accessService,
+	}
+}
+
+// CreateStudyList creates an empty list owned by the user
+func (s *StudyListService) CreateStudyList(userID, name, description string, shared bool) (*models.StudyList, error) {
+	name, err := validation.ValidateStudyListName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	lists, err := s.studyListRepo.FetchVisibleStudyLists(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, list := range *lists {
+		if list.OwnerID == userID && strings.EqualFold(list.Name, name) {
+			return nil, ErrDuplicateStudyList
+		}
+	}
+
+	now := time.Now().UTC()
+	list := &models.StudyList{
+		ID:          utils.GenerateUUID(),
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		OwnerID:     userID,
+		OwnerName:   user.StandardUser.Username,
+		Shared:      shared,
+		QuestionIDs: []string{},
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if err := s.studyListRepo.CreateStudyList(list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// GetStudyLists returns the user's own lists and every shared list
+func (s *StudyListService) GetStudyLists(userID string) (*[]models.StudyList, error) {
+	return s.studyListRepo.FetchVisibleStudyLists(userID)
+}
+
+// GetStudyListProgress returns the questions of a list in order, marked with the user's solved set
+func (s *StudyListService) GetStudyListProgress(userID, listID string) (*models.StudyListProgress, error) {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.fetchVisibleList(user, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questionService.GetAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+	byID := make(map[string]models.Question, len(*questions))
+	for _, question := range *questions {
+		byID[question.QuestionID] = question
+	}
+
+	solved := make(map[string]bool, len(user.QuestionsSolved))
+	for _, questionID := range user.QuestionsSolved {
+		solved[questionID] = true
+	}
+
+	progress := &models.StudyListProgress{
+		List:    *list,
+		Items:   make([]models.StudyListItem, 0, len(list.QuestionIDs)),
+		CanEdit: s.authorizeEdit(user, list) == nil,
+	}
+	for i, questionID := range list.QuestionIDs {
+		item := models.StudyListItem{Position: i + 1}
+
+		question, ok := byID[questionID]
+		if !ok {
+			// Questions removed from the bank stay in the list but do not count towards progress
+			item.Question = models.Question{QuestionID: questionID}
+			item.Missing = true
+			progress.Items = append(progress.Items, item)
+			continue
+		}
+
+		item.Question = question
+		item.Solved = solved[questionID]
+		progress.Total++
+		if item.Solved {
+			progress.Solved++
+		}
+		progress.Items = append(progress.Items, item)
+	}
+
+	return progress, nil
+}
+
+// AddQuestionToList inserts the question at the 1-based position, or appends it when position is 0
+func (s *StudyListService) AddQuestionToList(userID, listID, questionID string, position int) error {
+	questionID = data_cleaning.CleanString(questionID)
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		return fmt.Errorf("could not check if question exists: %v", err)
+	}
+	if !exists {
+		return fmt.Errorf("question with ID %s does not exist", questionID)
+	}
+
+	return s.modifyList(userID, listID, func(list *models.StudyList) error {
+		if indexInList(list.QuestionIDs, questionID) >= 0 {
+			return ErrQuestionAlreadyInList
+		}
+		if position == 0 {
+			position = len(list.QuestionIDs) + 1
+		}
+		if position < 1 || position > len(list.QuestionIDs)+1 {
+			return ErrInvalidListPosition
+		}
+
+		list.QuestionIDs = insertAt(list.QuestionIDs, position-1, questionID)
+		return nil
+	})
+}
+
+// RemoveQuestionFromList drops the question from the list, keeping the order of the rest
+func (s *StudyListService) RemoveQuestionFromList(userID, listID, questionID string) error {
+	questionID = data_cleaning.CleanString(questionID)
+
+	return s.modifyList(userID, listID, func(list *models.StudyList) error {
+		index := indexInList(list.QuestionIDs, questionID)
+		if index < 0 {
+			return ErrQuestionNotInList
+		}
+
+		list.QuestionIDs = append(list.QuestionIDs[:index], list.QuestionIDs[index+1:]...)
+		return nil
+	})
+}
+
+// MoveQuestionInList moves a question already in the list to the 1-based position
+func (s *StudyListService) MoveQuestionInList(userID, listID, questionID string, position int) error {
+	questionID = data_cleaning.CleanString(questionID)
+
+	return s.modifyList(userID, listID, func(list *models.StudyList) error {
+		index := indexInList(list.QuestionIDs, questionID)
+		if index < 0 {
+			return ErrQuestionNotInList
+		}
+		if position < 1 || position > len(list.QuestionIDs) {
+			return ErrInvalidListPosition
+		}
+
+		rest := append(append([]string{}, list.QuestionIDs[:index]...), list.QuestionIDs[index+1:]...)
+		list.QuestionIDs = insertAt(rest, position-1, questionID)
+		return nil
+	})
+}
+
+// SetStudyListShared makes the list visible to everyone or only to its owner
+func (s *StudyListService) SetStudyListShared(userID, listID string, shared bool) error {
+	return s.modifyList(userID, listID, func(list *models.StudyList) error {
+		list.Shared = shared
+		return nil
+	})
+}
+
+func (s *StudyListService) DeleteStudyList(userID, listID string) error {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+
+	list, err := s.fetchVisibleList(user, listID)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeEdit(user, list); err != nil {
+		return err
+	}
+
+	if err := s.studyListRepo.DeleteStudyList(list.ID); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrStudyListNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// modifyList loads a list the user may edit, applies fn and saves it
+func (s *StudyListService) modifyList(userID, listID string, fn func(list *models.StudyList) error) error {
+	user, err := s.userService.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+
+	list, err := s.fetchVisibleList(user, listID)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeEdit(user, list); err != nil {
+		return err
+	}
+
+	if err := fn(list); err != nil {
+		return err
+	}
+	list.UpdatedAt = time.Now().UTC()
+
+	if err := s.studyListRepo.UpdateStudyList(list); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return ErrStudyListNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// fetchVisibleList treats other users' private lists as missing so their existence is not revealed
+func (s *StudyListService) fetchVisibleList(user *models.StandardUser, listID string) (*models.StudyList, error) {
+	list, err := s.studyListRepo.FetchStudyListByID(strings.TrimSpace(listID))
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrStudyListNotFound
+		}
+		return nil, err
+	}
+
+	if list.OwnerID != user.StandardUser.ID && !list.Shared {
+		return nil, ErrStudyListNotFound
+	}
+	return list, nil
+}
+
+// authorizeEdit lets owners change their lists, and users allowed to curate study lists change shared ones.
+// It returns ErrStudyListForbidden when the user may not change the list.
+func (s *StudyListService) authorizeEdit(user *models.StandardUser, list *models.StudyList) error {
+	if list.OwnerID == user.StandardUser.ID {
+		return nil
+	}
+	if !list.Shared {
+		return ErrStudyListForbidden
+	}
+
+	err := s.accessService.Authorize(user.StandardUser.ID, roles.STUDY_LISTS_CURATE)
+	if errors.Is(err, ErrPermissionDenied) {
+		return ErrStudyListForbidden
+	}
+	return err
+}
+
+func indexInList(questionIDs []string, questionID string) int {
+	for i, id := range questionIDs {
+		if id == questionID {
+			return i
+		}
+	}
+	return -1
+}
+
+func insertAt(questionIDs []string, index int, questionID string) []string {
+	questionIDs = append(questionIDs, "")
+	copy(questionIDs[index+1:], questionIDs[index:])
+	questionIDs[index] = questionID
+	return questionIDs
+}
@@ -2,42 +2,124 @@ package services
 
 import (
 	interfaces2 "cli-project/external/domain/interfaces"
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
 	"cli-project/internal/domain/interfaces"
 	"cli-project/internal/domain/models"
-	"cli-project/pkg/globals"
 	"cli-project/pkg/utils"
 	"cli-project/pkg/utils/data_cleaning"
 	pwd "cli-project/pkg/utils/password"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"go.mongodb.org/mongo-driver/mongo"
+	"io"
+	"slices"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
 var (
 	ErrInvalidCredentials = errors.New("username or password incorrect")
 	ErrUserNotFound       = errors.New("user not found")
+
+	ErrInvalidProgressExport     = errors.New("invalid progress export")
+	ErrUnsupportedProgressExport = errors.New("unsupported progress export, it was made by a newer or unknown version of CodeSage")
+	ErrProgressOwnerMismatch     = errors.New("the progress export belongs to a different Leetcode account")
+
+	ErrBanReasonRequired = errors.New("a ban needs a reason")
+	ErrBanReasonTooLong  = fmt.Errorf("ban reason cannot be longer than %d characters", config.BAN_REASON_MAX_LENGTH)
+	ErrBanExpiryInPast   = errors.New("ban expiry must be in the future")
+	ErrNoActiveBan       = errors.New("user is not banned")
+	ErrBanNotFound       = errors.New("ban not found")
+	ErrEmptyAppeal       = errors.New("appeal message cannot be empty")
+	ErrAppealTooLong     = fmt.Errorf("appeal message and response cannot be longer than %d characters", config.APPEAL_MAX_LENGTH)
+	ErrAppealExists      = errors.New("this ban has already been appealed")
+	ErrNoPendingAppeal   = errors.New("this ban has no appeal waiting for review")
+
+	ErrAdminExists   = errors.New("an admin account already exists, ask an admin to promote you instead")
+	ErrLastAdmin     = errors.New("cannot demote the last remaining admin")
+	ErrInvalidRole   = fmt.Errorf("invalid role, must be one of %s", strings.Join(roles.All, ", "))
+	ErrUsernameTaken = errors.New("username already taken")
+	ErrEmailTaken    = errors.New("email already registered")
 )
 
 type UserService struct {
 	userRepo        interfaces.UserRepository
+	banRepo         interfaces.BanRepository
 	questionService interfaces.QuestionService
+	sessionService  interfaces.SessionService
 	LeetcodeAPI     interfaces2.LeetcodeAPI
+	auditService    interfaces.AuditService
+	accessService   interfaces.AccessService
 	//userWG   *sync.WaitGroup
 }
 
-func NewUserService(userRepo interfaces.UserRepository, questionService interfaces.QuestionService, LeetcodeAPI interfaces2.LeetcodeAPI) interfaces.UserService {
+func NewUserService(userRepo interfaces.UserRepository, banRepo interfaces.BanRepository, questionService interfaces.QuestionService, sessionService interfaces.SessionService, LeetcodeAPI interfaces2.LeetcodeAPI, auditService interfaces.AuditService, accessService interfaces.AccessService) interfaces.UserService {
 	return &UserService{
 		userRepo:        userRepo,
+		banRepo:         banRepo,
 		questionService: questionService,
+		sessionService:  sessionService,
 		LeetcodeAPI:     LeetcodeAPI,
+		auditService:    auditService,
+		accessService:   accessService,
 		//userWG:   &sync.WaitGroup{},
 	}
 }
 
 // Signup creates a new user account
 func (s *UserService) Signup(user *models.StandardUser) error {
+	return s.register(user, roles.USER)
+}
+
+// BootstrapAdmin creates the first admin account. It is refused once any admin exists, later admins
+// are promoted by an existing one.
+func (s *UserService) BootstrapAdmin(user *models.StandardUser) error {
+	admins, err := s.userRepo.CountUsersByRole(roles.ADMIN)
+	if err != nil {
+		return err
+	}
+	if admins > 0 {
+		return ErrAdminExists
+	}
+
+	unique, err := s.userRepo.IsUsernameUnique(strings.ToLower(user.StandardUser.Username))
+	if err != nil {
+		return err
+	}
+	if !unique {
+		return ErrUsernameTaken
+	}
+	unique, err = s.userRepo.IsEmailUnique(strings.ToLower(user.StandardUser.Email))
+	if err != nil {
+		return err
+	}
+	if !unique {
+		return ErrEmailTaken
+	}
+
+	if err := s.prepareAccount(user, roles.ADMIN); err != nil {
+		return err
+	}
+	if err := s.auditService.Record(user.StandardUser.ID, models.AuditUserBootstrap, user.StandardUser.Username, nil, roleState{Role: roles.ADMIN}); err != nil {
+		return fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	return s.createAccount(user)
+}
+
+// register normalises the user's details and stores them as a new account with the role
+func (s *UserService) register(user *models.StandardUser, role string) error {
+	if err := s.prepareAccount(user, role); err != nil {
+		return err
+	}
+	return s.createAccount(user)
+}
+
+// prepareAccount cleans the user's details, gives them an ID and the role and hashes their password
+func (s *UserService) prepareAccount(user *models.StandardUser, role string) error {
 
 	// Change username to lowercase for consistency
 	user.StandardUser.Username = strings.ToLower(user.StandardUser.Username)
@@ -60,29 +142,30 @@ func (s *UserService) Signup(user *models.StandardUser) error {
 	}
 	user.StandardUser.Password = hashedPassword
 
-	// Set default role
-	user.StandardUser.Role = "user"
+	user.StandardUser.Role = role
 
 	// Set default blocked status
 	user.StandardUser.IsBanned = false
 
 	// set question solved
 	user.QuestionsSolved = []string{}
+	user.SolveHistory = []models.SolveEvent{}
 
 	// set last seen
 	user.LastSeen = time.Now().UTC()
+	return nil
+}
 
-	// Register the user
-	err = s.userRepo.CreateUser(user)
-	if err != nil {
+// createAccount stores a prepared account
+func (s *UserService) createAccount(user *models.StandardUser) error {
+	if err := s.userRepo.CreateUser(user); err != nil {
 		return fmt.Errorf("could not register user")
 	}
-
 	return nil
 }
 
-// Login authenticates a user
-func (s *UserService) Login(username, password string) error {
+// Login authenticates a user and starts a new session for them
+func (s *UserService) Login(username, password string) (*models.Session, error) {
 
 	// Change username to lowercase for consistency
 	username = data_cleaning.CleanString(username)
@@ -92,22 +175,53 @@ func (s *UserService) Login(username, password string) error {
 
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return ErrUserNotFound // Return error if user doesn't exist
+			return nil, ErrUserNotFound // Return error if user doesn't exist
 		}
-		return fmt.Errorf("%v", err)
+		return nil, fmt.Errorf("%v", err)
 	}
 
 	// Verify the password
 	if !pwd.VerifyPassword(password, user.StandardUser.Password) {
-		return ErrInvalidCredentials
+		return nil, ErrInvalidCredentials
+	}
+
+	return s.sessionService.CreateSession(user)
+}
+
+// ResumeSession restores a session from a previously issued token
+func (s *UserService) ResumeSession(token string) (*models.Session, error) {
+	session, err := s.sessionService.ValidateSession(token)
+	if err != nil {
+		return nil, err
+	}
+
+	// A resumed session counts as a visit
+	if err := s.touchLastSeen(session.UserID); err != nil {
+		return nil, err
+	}
+
+	return session, nil
+}
+
+// Logout records the user's last visit and revokes their session
+func (s *UserService) Logout(session *models.Session) error {
+	if session == nil {
+		return ErrInvalidSession
+	}
+
+	if err := s.touchLastSeen(session.UserID); err != nil {
+		return err
+	}
+
+	if err := s.sessionService.RevokeSession(session); err != nil {
+		return fmt.Errorf("could not end session: %v", err)
 	}
 
 	return nil
 }
 
-func (s *UserService) Logout() error {
-	// Get active user
-	user, err := s.userRepo.FetchUserByID(globals.ActiveUserID)
+func (s *UserService) touchLastSeen(userID string) error {
+	user, err := s.userRepo.FetchUserByID(userID)
 	if err != nil {
 		return errors.New("user not found")
 	}
@@ -121,9 +235,6 @@ func (s *UserService) Logout() error {
 		return errors.New("could not update user details")
 	}
 
-	// clear active user
-	globals.ActiveUserID = ""
-
 	return nil
 }
 
@@ -131,41 +242,185 @@ func (s *UserService) GetAllUsers() (*[]models.StandardUser, error) {
 	return s.userRepo.FetchAllUsers()
 }
 
-// ViewDashboard retrieves the dashboard for the active user
-func (s *UserService) ViewDashboard() error {
+// GetUsersPage returns one page of the users with the role, or of every user when role is empty,
+// sorted by username unless the request says otherwise
+func (s *UserService) GetUsersPage(role string, request models.PageRequest) (*models.UserPage, error) {
+	request, err := normalizePageRequest(request, models.SortByUsername, models.SortByUsername, models.SortByLastSeen)
+	if err != nil {
+		return nil, err
+	}
+	return s.userRepo.FetchUsersPage(role, request)
+}
+
+// ViewDashboard retrieves the dashboard for the given user
+func (s *UserService) ViewDashboard(userID string) error {
 	// Placeholder implementation
 	return nil
 }
 
-// UpdateUserProgress updates the user's progress by adding a solved question ID.
-func (s *UserService) UpdateUserProgress(solvedQuestionID string) (bool, error) {
-	// Fetch the current user from the repository
-	user, err := s.userRepo.FetchUserByID(globals.ActiveUserID)
+// UpdateUserProgress records a solve of the question and reports whether it was solved for the first time.
+// Solving a question again is recorded as another event so revisits show up in the history.
+func (s *UserService) UpdateUserProgress(userID string, event models.SolveEvent) (bool, error) {
+	// Fetch the user from the repository
+	user, err := s.userRepo.FetchUserByID(userID)
 	if err != nil {
 		return false, fmt.Errorf("could not fetch user: %v", err)
 	}
 
-	// Check if the question ID is already in the user's progress
-	for _, id := range user.QuestionsSolved {
-		if id == solvedQuestionID {
-			return false, nil // No need to update if the question ID is already in the list
-		}
-	}
-
 	// Check if the question ID exists in the questions repository
-	exists, err := s.questionService.QuestionExists(solvedQuestionID)
+	exists, err := s.questionService.QuestionExists(event.QuestionID)
 	if err != nil {
 		return false, fmt.Errorf("could not check if question exists: %v", err)
 	}
 	if !exists {
-		return false, fmt.Errorf("question with ID %s does not exist", solvedQuestionID)
+		return false, fmt.Errorf("question with ID %s does not exist", event.QuestionID)
+	}
+
+	if event.TimeTaken < 0 {
+		return false, errors.New("time taken cannot be negative")
+	}
+
+	if event.SolvedAt.IsZero() {
+		event.SolvedAt = time.Now().UTC()
+	}
+	event.Language = data_cleaning.CleanString(event.Language)
+	event.Notes = strings.TrimSpace(event.Notes)
+
+	firstSolve := true
+	for _, id := range user.QuestionsSolved {
+		if id == event.QuestionID {
+			firstSolve = false
+			break
+		}
 	}
 
 	// Update the user's progress
-	return true, s.userRepo.UpdateUserProgress(solvedQuestionID)
+	if err := s.userRepo.UpdateUserProgress(userID, &event); err != nil {
+		return false, err
+	}
+
+	return firstSolve, nil
+}
+
+// GetSolveHistory returns the user's solve events, oldest first
+func (s *UserService) GetSolveHistory(userID string) (*[]models.SolveEvent, error) {
+	user, err := s.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := append([]models.SolveEvent{}, user.SolveHistory...)
+	sort.SliceStable(history, func(i, j int) bool {
+		return history[i].SolvedAt.Before(history[j].SolvedAt)
+	})
+
+	return &history, nil
 }
 
-func (s *UserService) CountActiveUserInLast24Hours() (int64, error) {
+// GetDailyActivity returns one entry per local calendar day for the last days days, oldest first.
+// Untimed events carried over from the old questions_solved list are not counted.
+func (s *UserService) GetDailyActivity(userID string, days int) (*[]models.DailyActivity, error) {
+	if days <= 0 {
+		return nil, errors.New("number of days must be positive")
+	}
+
+	history, err := s.GetSolveHistory(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	today := startOfDay(time.Now())
+	first := today.AddDate(0, 0, -(days - 1))
+
+	activity := make([]models.DailyActivity, days)
+	for i := range activity {
+		activity[i].Date = first.AddDate(0, 0, i)
+	}
+
+	for _, event := range *history {
+		if event.SolvedAt.IsZero() {
+			continue
+		}
+		day := startOfDay(event.SolvedAt)
+		if day.Before(first) || day.After(today) {
+			continue
+		}
+		// Count calendar days rather than 24h periods so DST changes do not shift entries
+		index := daysBetween(first, day)
+		activity[index].Solves++
+		activity[index].Questions = append(activity[index].Questions, event.QuestionID)
+	}
+
+	return &activity, nil
+}
+
+// GetStreaks computes the current and longest runs of consecutive local days with a solve.
+// The current streak stays alive until a full day passes without solving, so it is not reset
+// first thing in the morning.
+func (s *UserService) GetStreaks(userID string) (*models.Streaks, error) {
+	history, err := s.GetSolveHistory(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	streaks := &models.Streaks{}
+
+	// History is sorted, so solve days come out in order
+	var days []time.Time
+	for _, event := range *history {
+		if event.SolvedAt.IsZero() {
+			continue
+		}
+		day := startOfDay(event.SolvedAt)
+		if len(days) == 0 || !day.Equal(days[len(days)-1]) {
+			days = append(days, day)
+		}
+	}
+	if len(days) == 0 {
+		return streaks, nil
+	}
+
+	runStart, runLength := days[0], 1
+	streaks.Longest, streaks.LongestStart, streaks.LongestEnd = 1, days[0], days[0]
+	for i := 1; i < len(days); i++ {
+		if daysBetween(days[i-1], days[i]) == 1 {
+			runLength++
+		} else {
+			runStart, runLength = days[i], 1
+		}
+		if runLength > streaks.Longest {
+			streaks.Longest, streaks.LongestStart, streaks.LongestEnd = runLength, runStart, days[i]
+		}
+	}
+
+	last := days[len(days)-1]
+	streaks.LastSolved = last
+	if daysBetween(last, startOfDay(time.Now())) <= 1 {
+		streaks.Current = runLength
+	}
+
+	return streaks, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.In(time.Local).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+}
+
+func daysBetween(from, to time.Time) int {
+	fromYear, fromMonth, fromDay := from.Date()
+	toYear, toMonth, toDay := to.Date()
+	fromUTC := time.Date(fromYear, fromMonth, fromDay, 0, 0, 0, 0, time.UTC)
+	toUTC := time.Date(toYear, toMonth, toDay, 0, 0, 0, 0, time.UTC)
+	return int(toUTC.Sub(fromUTC).Hours() / 24)
+}
+
+// CountActiveUserInLast24Hours is a platform stat, so the actor needs the stats:view permission
+func (s *UserService) CountActiveUserInLast24Hours(actorID string) (int64, error) {
+	if err := s.accessService.Authorize(actorID, roles.STATS_VIEW); err != nil {
+		return 0, err
+	}
+
 	count, err := s.userRepo.CountActiveUsersInLast24Hours()
 	if err != nil {
 		return count, err
@@ -194,7 +449,16 @@ func (s *UserService) GetUserByID(userID string) (*models.StandardUser, error) {
 	userID = data_cleaning.CleanString(userID)
 
 	// Fetch user by ID from the repository
-	return s.userRepo.FetchUserByID(userID)
+	user, err := s.userRepo.FetchUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Callers check IsBanned, so a ban that has run out is lifted before they see it
+	if err := liftExpiredBan(s.userRepo, s.banRepo, user); err != nil {
+		return nil, err
+	}
+	return user, nil
 }
 
 func (s *UserService) GetUserRole(userID string) (string, error) {
@@ -222,26 +486,77 @@ func (s *UserService) GetUserID(username string) (string, error) {
 	return user.StandardUser.ID, nil
 }
 
-func (s *UserService) BanUser(username string) (bool, error) {
+// banState is what the audit log records for a user with no ban details
+type banState struct {
+	IsBanned bool `json:"is_banned"`
+}
 
-	userID, err := s.GetUserID(username)
-	if err != nil {
+// BanUser bans the user for the reason until expiresAt, a zero expiresAt bans them until an admin lifts it.
+// It reports whether the user was already banned, in which case the existing ban is left as it is.
+func (s *UserService) BanUser(actorID, username, reason string, expiresAt time.Time) (bool, error) {
+	if err := s.accessService.Authorize(actorID, roles.USERS_BAN); err != nil {
 		return false, err
 	}
 
-	alreadyBanned, err := s.IsUserBanned(userID)
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return false, ErrBanReasonRequired
+	}
+	if utf8.RuneCountInString(reason) > config.BAN_REASON_MAX_LENGTH {
+		return false, ErrBanReasonTooLong
+	}
+	now := time.Now().UTC()
+	if !expiresAt.IsZero() && !expiresAt.After(now) {
+		return false, ErrBanExpiryInPast
+	}
+
+	user, err := s.userRepo.FetchUserByUsername(username)
 	if err != nil {
 		return false, err
 	}
+	userID := user.StandardUser.ID
 
-	if alreadyBanned {
+	// Banning staff also needs the right to change roles, so a moderator cannot lock out an admin
+	if roles.IsStaff(user.StandardUser.Role) {
+		if err := s.accessService.Authorize(actorID, roles.USERS_ROLES); err != nil {
+			return false, err
+		}
+	}
+
+	if err := liftExpiredBan(s.userRepo, s.banRepo, user); err != nil {
+		return false, err
+	}
+	if user.StandardUser.IsBanned {
 		return true, nil
 	}
 
-	return false, s.userRepo.BanUser(userID)
+	ban := &models.Ban{
+		ID:           utils.GenerateUUID(),
+		UserID:       userID,
+		Username:     user.StandardUser.Username,
+		Reason:       reason,
+		IssuedBy:     actorID,
+		IssuedByName: s.usernameOf(actorID),
+		StartedAt:    now,
+		ExpiresAt:    expiresAt.UTC(),
+	}
+	if err := s.auditService.Record(actorID, models.AuditUserBan, username, banState{IsBanned: false}, ban); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	if err := s.banRepo.CreateBan(ban); err != nil {
+		return false, err
+	}
+	if err := s.userRepo.BanUser(userID); err != nil {
+		return false, err
+	}
+	return false, nil
 }
 
-func (s *UserService) UnbanUser(username string) (bool, error) {
+// UnbanUser lifts the user's ban and reports whether they were not banned to begin with
+func (s *UserService) UnbanUser(actorID, username string) (bool, error) {
+	if err := s.accessService.Authorize(actorID, roles.USERS_BAN); err != nil {
+		return false, err
+	}
 
 	userID, err := s.GetUserID(username)
 	if err != nil {
@@ -257,7 +572,88 @@ func (s *UserService) UnbanUser(username string) (bool, error) {
 		return true, nil
 	}
 
-	return false, s.userRepo.UnbanUser(userID)
+	// Users banned before ban details were recorded have no ban to lift
+	var before interface{} = banState{IsBanned: true}
+	ban, err := s.banRepo.FetchActiveBan(userID)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return false, err
+	}
+	if ban != nil {
+		before = *ban
+	}
+	if err := s.auditService.Record(actorID, models.AuditUserUnban, username, before, banState{IsBanned: false}); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+
+	if ban != nil {
+		ban.LiftedAt, ban.LiftedBy = time.Now().UTC(), actorID
+		if err := s.banRepo.UpdateBan(ban); err != nil {
+			return false, err
+		}
+	}
+	if err := s.userRepo.UnbanUser(userID); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// roleState is what the audit log records for a role change
+type roleState struct {
+	Role string `json:"role"`
+}
+
+// PromoteUser makes the user an admin and reports whether they already were one
+func (s *UserService) PromoteUser(actorID, username string) (bool, error) {
+	return s.ChangeUserRole(actorID, username, roles.ADMIN)
+}
+
+// DemoteUser makes the user a standard user and reports whether they already were one
+func (s *UserService) DemoteUser(actorID, username string) (bool, error) {
+	return s.ChangeUserRole(actorID, username, roles.USER)
+}
+
+// ChangeUserRole gives the user one of the built in roles and reports whether they already had it.
+// The last remaining admin cannot lose the role, so the platform is never left without one.
+func (s *UserService) ChangeUserRole(actorID, username, role string) (bool, error) {
+	if err := s.accessService.Authorize(actorID, roles.USERS_ROLES); err != nil {
+		return false, err
+	}
+	if !roles.IsValid(role) {
+		return false, ErrInvalidRole
+	}
+
+	user, err := s.userRepo.FetchUserByUsername(username)
+	if err != nil {
+		return false, err
+	}
+
+	before := user.StandardUser.Role
+	if before == role {
+		return true, nil
+	}
+
+	if before == roles.ADMIN {
+		admins, err := s.userRepo.CountUsersByRole(roles.ADMIN)
+		if err != nil {
+			return false, err
+		}
+		if admins <= 1 {
+			return false, ErrLastAdmin
+		}
+	}
+
+	// roles.All runs from the least to the most privileged role
+	action := models.AuditUserDemote
+	if slices.Index(roles.All, role) > slices.Index(roles.All, before) {
+		action = models.AuditUserPromote
+	}
+	if err := s.auditService.Record(actorID, action, username, roleState{Role: before}, roleState{Role: role}); err != nil {
+		return false, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	if err := s.userRepo.UpdateUserRole(user.StandardUser.ID, role); err != nil {
+		return false, err
+	}
+	return false, nil
 }
 
 func (s *UserService) IsUserBanned(userID string) (bool, error) {
@@ -267,9 +663,162 @@ func (s *UserService) IsUserBanned(userID string) (bool, error) {
 		return false, err
 	}
 
+	if err := liftExpiredBan(s.userRepo, s.banRepo, user); err != nil {
+		return false, err
+	}
+
 	return user.StandardUser.IsBanned, nil
 }
 
+// GetActiveBan returns the ban the user is under. ErrNoActiveBan means they are not banned, and
+// ErrBanNotFound that they were banned before ban details were recorded.
+func (s *UserService) GetActiveBan(userID string) (*models.Ban, error) {
+	banned, err := s.IsUserBanned(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !banned {
+		return nil, ErrNoActiveBan
+	}
+
+	ban, err := s.banRepo.FetchActiveBan(userID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrBanNotFound
+	}
+	return ban, err
+}
+
+// SubmitBanAppeal records the banned user's appeal, only one appeal can be made against a ban
+func (s *UserService) SubmitBanAppeal(userID, message string) (*models.Ban, error) {
+	message = strings.TrimSpace(message)
+	if message == "" {
+		return nil, ErrEmptyAppeal
+	}
+	if utf8.RuneCountInString(message) > config.APPEAL_MAX_LENGTH {
+		return nil, ErrAppealTooLong
+	}
+
+	ban, err := s.GetActiveBan(userID)
+	if err != nil {
+		return nil, err
+	}
+	if ban.Appeal != nil {
+		return nil, ErrAppealExists
+	}
+
+	ban.Appeal = &models.BanAppeal{
+		Message:     message,
+		SubmittedAt: time.Now().UTC(),
+		Status:      models.AppealPending,
+	}
+	if err := s.banRepo.UpdateBan(ban); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+// GetBanAppeals returns the bans whose appeal is in the status, pending when empty, the oldest appeal first
+func (s *UserService) GetBanAppeals(actorID, status string) (*[]models.Ban, error) {
+	if err := s.accessService.Authorize(actorID, roles.USERS_BAN); err != nil {
+		return nil, err
+	}
+	if status == "" {
+		status = models.AppealPending
+	}
+	return s.banRepo.FetchBansByAppealStatus(status)
+}
+
+// ResolveBanAppeal accepts or rejects a pending appeal with the admin's response, accepting it lifts the ban
+func (s *UserService) ResolveBanAppeal(actorID, banID string, accept bool, response string) (*models.Ban, error) {
+	if err := s.accessService.Authorize(actorID, roles.USERS_BAN); err != nil {
+		return nil, err
+	}
+
+	response = strings.TrimSpace(response)
+	if utf8.RuneCountInString(response) > config.APPEAL_MAX_LENGTH {
+		return nil, ErrAppealTooLong
+	}
+
+	ban, err := s.banRepo.FetchBanByID(banID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrBanNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ban.Appeal == nil || ban.Appeal.Status != models.AppealPending {
+		return nil, ErrNoPendingAppeal
+	}
+
+	before := *ban.Appeal
+	now := time.Now().UTC()
+	ban.Appeal.Status = models.AppealRejected
+	if accept {
+		ban.Appeal.Status = models.AppealAccepted
+	}
+	ban.Appeal.Response = response
+	ban.Appeal.ResolvedBy = actorID
+	ban.Appeal.ResolvedByName = s.usernameOf(actorID)
+	ban.Appeal.ResolvedAt = now
+
+	// The ban may have expired while the appeal waited, there is nothing left to lift then
+	liftBan := accept && ban.LiftedAt.IsZero()
+	if liftBan {
+		ban.LiftedAt, ban.LiftedBy = now, actorID
+	}
+
+	if err := s.auditService.Record(actorID, models.AuditUserAppeal, ban.Username, before, *ban.Appeal); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrAuditFailed, err)
+	}
+	if err := s.banRepo.UpdateBan(ban); err != nil {
+		return nil, err
+	}
+	if liftBan {
+		if err := s.userRepo.UnbanUser(ban.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return ban, nil
+}
+
+// liftExpiredBan unbans a banned user whose ban has run out, so expiring bans need no admin
+func liftExpiredBan(userRepo interfaces.UserRepository, banRepo interfaces.BanRepository, user *models.StandardUser) error {
+	if !user.StandardUser.IsBanned {
+		return nil
+	}
+
+	ban, err := banRepo.FetchActiveBan(user.StandardUser.ID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		// Banned before ban details were recorded, such bans never expire
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if ban.ExpiresAt.IsZero() || time.Now().Before(ban.ExpiresAt) {
+		return nil
+	}
+
+	ban.LiftedAt = ban.ExpiresAt
+	if err := banRepo.UpdateBan(ban); err != nil {
+		return err
+	}
+	if err := userRepo.UnbanUser(user.StandardUser.ID); err != nil {
+		return err
+	}
+	user.StandardUser.IsBanned = false
+	return nil
+}
+
+// usernameOf is the username recorded next to an admin's ID, empty when the account is not found
+func (s *UserService) usernameOf(userID string) string {
+	user, err := s.userRepo.FetchUserByID(userID)
+	if err != nil {
+		return ""
+	}
+	return user.StandardUser.Username
+}
+
 func (s *UserService) GetLeetcodeStats(userID string) (*models.LeetcodeStats, error) {
 	user, err := s.GetUserByID(userID)
 	if err != nil {
@@ -281,6 +830,253 @@ func (s *UserService) GetLeetcodeStats(userID string) (*models.LeetcodeStats, er
 	return s.LeetcodeAPI.GetStats(LeetcodeID)
 }
 
+// SyncLeetcodeProgress records the user's recent accepted Leetcode submissions as solves of the matching
+// questions in the bank. Questions are matched by title slug, and submissions recorded by an earlier sync are skipped.
+func (s *UserService) SyncLeetcodeProgress(userID string) (*models.SyncReport, error) {
+	user, err := s.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.LeetcodeID == "" {
+		return nil, errors.New("no Leetcode ID linked to this account")
+	}
+
+	submissions, err := s.LeetcodeAPI.GetRecentACSubmissions(user.LeetcodeID)
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch Leetcode submissions: %v", err)
+	}
+
+	questions, err := s.questionService.GetAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+
+	// Prefer the slug in the question link, the title is only a fallback for questions without one
+	questionsBySlug := make(map[string]models.Question, len(*questions))
+	for _, question := range *questions {
+		questionsBySlug[questionSlug(question.QuestionTitle, question.QuestionLink)] = question
+	}
+
+	// A solve at the same second of the same question means the submission was synced before
+	recorded := make(map[string]bool, len(user.SolveHistory))
+	for _, event := range user.SolveHistory {
+		recorded[syncKey(event.QuestionID, event.SolvedAt)] = true
+	}
+
+	// Leetcode lists the newest first, record them oldest first so first solves are reported correctly
+	sorted := append([]models.LeetcodeSubmission(nil), *submissions...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].SubmittedAt.Before(sorted[j].SubmittedAt)
+	})
+
+	report := &models.SyncReport{Added: []models.SyncedSolve{}, Unmatched: []models.LeetcodeSubmission{}}
+	for _, submission := range sorted {
+		question, ok := questionsBySlug[strings.ToLower(submission.TitleSlug)]
+		if !ok {
+			report.Unmatched = append(report.Unmatched, submission)
+			continue
+		}
+
+		solvedAt := submission.SubmittedAt
+		if solvedAt.IsZero() {
+			solvedAt = time.Now().UTC()
+		}
+
+		key := syncKey(question.QuestionID, solvedAt)
+		if recorded[key] {
+			report.Skipped++
+			continue
+		}
+
+		firstSolve, err := s.UpdateUserProgress(userID, models.SolveEvent{
+			QuestionID: question.QuestionID,
+			SolvedAt:   solvedAt,
+			Language:   submission.Language,
+		})
+		if err != nil {
+			return report, fmt.Errorf("could not record %s: %v", question.QuestionTitle, err)
+		}
+		recorded[key] = true
+
+		report.Added = append(report.Added, models.SyncedSolve{
+			QuestionID:    question.QuestionID,
+			QuestionTitle: question.QuestionTitle,
+			SolvedAt:      solvedAt,
+			FirstSolve:    firstSolve,
+		})
+	}
+
+	return report, nil
+}
+
+// ExportProgress writes the user's profile, solve history and a Leetcode stats snapshot as JSON.
+// The stats are left out when Leetcode cannot be reached so an export never depends on it.
+func (s *UserService) ExportProgress(userID string, writer io.Writer) error {
+	user, err := s.GetUserByID(userID)
+	if err != nil {
+		return err
+	}
+
+	questions, err := s.questionService.GetAllQuestions()
+	if err != nil {
+		return fmt.Errorf("could not fetch questions: %v", err)
+	}
+	byID := make(map[string]models.Question, len(*questions))
+	for _, question := range *questions {
+		byID[question.QuestionID] = question
+	}
+
+	export := models.ProgressExport{
+		Version:    models.ProgressExportVersion,
+		ExportedAt: time.Now().UTC(),
+		Profile: models.ExportedProfile{
+			Username:     user.StandardUser.Username,
+			Name:         user.StandardUser.Name,
+			Email:        user.StandardUser.Email,
+			Organisation: user.StandardUser.Organisation,
+			Country:      user.StandardUser.Country,
+			LeetcodeID:   user.LeetcodeID,
+		},
+		Solves: make([]models.ExportedSolve, 0, len(user.SolveHistory)),
+	}
+
+	for _, event := range user.SolveHistory {
+		question := byID[event.QuestionID]
+		export.Solves = append(export.Solves, models.ExportedSolve{
+			QuestionID:       event.QuestionID,
+			QuestionTitle:    question.QuestionTitle,
+			QuestionLink:     question.QuestionLink,
+			SolvedAt:         event.SolvedAt,
+			TimeTakenMinutes: int(event.TimeTaken / time.Minute),
+			Language:         event.Language,
+			Notes:            event.Notes,
+		})
+	}
+
+	if user.LeetcodeID != "" {
+		if stats, err := s.LeetcodeAPI.GetStats(user.LeetcodeID); err == nil {
+			export.LeetcodeStats = stats
+		}
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(export); err != nil {
+		return fmt.Errorf("could not write progress: %v", err)
+	}
+	return nil
+}
+
+// ImportProgress merges an export into the user's solve history. Solves already recorded are skipped,
+// and solves of questions that are missing here or link to a different problem are reported and left out.
+// The profile in the export is only used to check that it belongs to the same Leetcode account.
+func (s *UserService) ImportProgress(userID string, reader io.Reader) (*models.ProgressImportReport, error) {
+	var export models.ProgressExport
+	if err := json.NewDecoder(reader).Decode(&export); err != nil {
+		// Wrapped so callers can tell a bad file from a failure on our side
+		return nil, fmt.Errorf("%w: %v", ErrInvalidProgressExport, err)
+	}
+	if export.Version < 1 || export.Version > models.ProgressExportVersion {
+		return nil, ErrUnsupportedProgressExport
+	}
+
+	user, err := s.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	exportedLeetcodeID := strings.TrimSpace(export.Profile.LeetcodeID)
+	if user.LeetcodeID != "" && exportedLeetcodeID != "" && !strings.EqualFold(user.LeetcodeID, exportedLeetcodeID) {
+		return nil, ErrProgressOwnerMismatch
+	}
+
+	questions, err := s.questionService.GetAllQuestions()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch questions: %v", err)
+	}
+	byID := make(map[string]models.Question, len(*questions))
+	for _, question := range *questions {
+		byID[question.QuestionID] = question
+	}
+
+	recorded := make(map[string]bool, len(user.SolveHistory))
+	for _, event := range user.SolveHistory {
+		recorded[syncKey(event.QuestionID, event.SolvedAt)] = true
+	}
+	solved := make(map[string]bool, len(user.QuestionsSolved))
+	for _, questionID := range user.QuestionsSolved {
+		solved[questionID] = true
+	}
+
+	// Record the oldest solves first so first solves are reported correctly
+	solves := append([]models.ExportedSolve(nil), export.Solves...)
+	sort.SliceStable(solves, func(i, j int) bool {
+		return solves[i].SolvedAt.Before(solves[j].SolvedAt)
+	})
+
+	report := &models.ProgressImportReport{Added: []models.SyncedSolve{}, Unmatched: []string{}, Conflicts: []string{}}
+	unmatched := make(map[string]bool)
+	for _, solve := range solves {
+		questionID := data_cleaning.CleanString(solve.QuestionID)
+
+		question, ok := byID[questionID]
+		if !ok {
+			if !unmatched[questionID] {
+				unmatched[questionID] = true
+				report.Unmatched = append(report.Unmatched, questionID)
+			}
+			continue
+		}
+		if theirs, ours := questionSlug(solve.QuestionTitle, solve.QuestionLink), questionSlug(question.QuestionTitle, question.QuestionLink); theirs != "" && theirs != ours {
+			report.Conflicts = append(report.Conflicts, fmt.Sprintf("question %s is %q here but %q in the export", questionID, ours, theirs))
+			continue
+		}
+		if solve.TimeTakenMinutes < 0 {
+			report.Conflicts = append(report.Conflicts, fmt.Sprintf("question %s: time taken cannot be negative", questionID))
+			continue
+		}
+
+		key := syncKey(questionID, solve.SolvedAt)
+		if recorded[key] {
+			report.Skipped++
+			continue
+		}
+
+		event := models.SolveEvent{
+			QuestionID: questionID,
+			SolvedAt:   solve.SolvedAt,
+			TimeTaken:  time.Duration(solve.TimeTakenMinutes) * time.Minute,
+			Language:   data_cleaning.CleanString(solve.Language),
+			Notes:      strings.TrimSpace(solve.Notes),
+		}
+		if err := s.userRepo.UpdateUserProgress(userID, &event); err != nil {
+			return report, fmt.Errorf("could not record %s: %v", question.QuestionTitle, err)
+		}
+		recorded[key] = true
+
+		report.Added = append(report.Added, models.SyncedSolve{
+			QuestionID:    questionID,
+			QuestionTitle: question.QuestionTitle,
+			SolvedAt:      solve.SolvedAt,
+			FirstSolve:    !solved[questionID],
+		})
+		solved[questionID] = true
+	}
+
+	return report, nil
+}
+
+// questionSlug identifies a problem across question banks by its Leetcode slug
+func questionSlug(title, link string) string {
+	if slug := data_cleaning.TitleSlugFromLink(link); slug != "" {
+		return slug
+	}
+	return data_cleaning.Slugify(title)
+}
+
+func syncKey(questionID string, solvedAt time.Time) string {
+	return fmt.Sprintf("%s@%d", questionID, solvedAt.Unix())
+}
+
 //func (s *UserService) WaitForCompletion() {
 //	s.userWG.Wait()
 //}
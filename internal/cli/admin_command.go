@@ -0,0 +1,371 @@
+package cli
+
+import (
+	"bytes"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+func (c *CLI) runAdmin(args []string) error {
+	if len(args) == 0 {
+		return newUsageError("admin: missing subcommand")
+	}
+	switch args[0] {
+	case "import":
+		return c.importQuestions(args[1:])
+	case "edit":
+		return c.editQuestion(args[1:])
+	case "export":
+		return c.exportQuestions(args[1:])
+	case "announce":
+		return c.postAnnouncement(args[1:])
+	case "audit":
+		return c.exportAuditLog(args[1:])
+	case "ban":
+		return c.banUser(args[1:])
+	}
+
+	var creds credentials
+	flags := newFlagSet("admin " + args[0])
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args[1:])
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "unban", "promote", "demote":
+		if len(positional) != 1 {
+			return newUsageError("admin %s: expected exactly one username", args[0])
+		}
+		username := data_cleaning.CleanString(positional[0])
+		if !validation.ValidateUsername(username) {
+			return newUsageError("admin %s: invalid username %q", args[0], positional[0])
+		}
+		if args[0] == "unban" {
+			admin, err := c.authenticate(creds, roles.USERS_BAN)
+			if err != nil {
+				return err
+			}
+			return c.unbanUser(admin.StandardUser.ID, username)
+		}
+		admin, err := c.authenticate(creds, roles.USERS_ROLES)
+		if err != nil {
+			return err
+		}
+		if args[0] == "promote" {
+			return c.promoteUser(admin.StandardUser.ID, username)
+		}
+		return c.demoteUser(admin.StandardUser.ID, username)
+
+	case "role":
+		if len(positional) != 2 {
+			return newUsageError("admin role: expected a username and a role")
+		}
+		username := data_cleaning.CleanString(positional[0])
+		if !validation.ValidateUsername(username) {
+			return newUsageError("admin role: invalid username %q", positional[0])
+		}
+		role := data_cleaning.CleanString(positional[1])
+		if !roles.IsValid(role) {
+			return newUsageError("admin role: unknown role %q, must be one of %s", positional[1], strings.Join(roles.All, ", "))
+		}
+		admin, err := c.authenticate(creds, roles.USERS_ROLES)
+		if err != nil {
+			return err
+		}
+		return c.changeUserRole(admin.StandardUser.ID, username, role)
+
+	case "stats":
+		if len(positional) != 0 {
+			return newUsageError("admin stats: unexpected arguments %v", positional)
+		}
+		staff, err := c.authenticate(creds, roles.STATS_VIEW)
+		if err != nil {
+			return err
+		}
+		return c.platformStats(staff.StandardUser.ID)
+
+	default:
+		return newUsageError("admin: unknown subcommand %q", args[0])
+	}
+}
+
+// importQuestions adds new and updates changed questions from a CSV file, printing the diff first
+func (c *CLI) importQuestions(args []string) error {
+	var creds credentials
+	flags := newFlagSet("admin import")
+	creds.register(flags)
+	dryRun := flags.Bool("dry-run", false, "only report what the import would change")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("admin import: expected exactly one CSV file")
+	}
+	filePath := positional[0]
+
+	admin, err := c.authenticate(creds, roles.QUESTIONS_WRITE)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.questionService.ImportQuestionsFromFile(admin.StandardUser.ID, filePath, *dryRun)
+	if err != nil {
+		return fmt.Errorf("error importing questions from file %s: %v", filePath, err)
+	}
+
+	for _, row := range report.Rows {
+		switch row.Status {
+		case models.ImportRowNew:
+			fmt.Fprintf(c.out, "row %d: %s new: %s\n", row.Row, row.QuestionID, row.Question.QuestionTitle)
+		case models.ImportRowChanged:
+			fmt.Fprintf(c.out, "row %d: %s changed: %s\n", row.Row, row.QuestionID, strings.Join(row.Changes, "; "))
+		case models.ImportRowInvalid:
+			fmt.Fprintf(c.errOut, "row %d: invalid: %s\n", row.Row, row.Reason)
+		}
+	}
+
+	fmt.Fprintf(c.out, "%d new, %d changed, %d unchanged, %d invalid\n", report.New, report.Changed, report.Unchanged, report.Invalid)
+	switch {
+	case *dryRun:
+		fmt.Fprintln(c.out, "dry run, nothing was changed")
+	case report.Applied:
+		fmt.Fprintln(c.out, "Questions successfully imported from file:", filePath)
+	default:
+		fmt.Fprintln(c.out, "No new or changed questions in the file:", filePath)
+	}
+
+	if report.Invalid > 0 {
+		return errors.New("some rows were invalid and were skipped")
+	}
+	return nil
+}
+
+// exportQuestions writes the bank to --output, or to stdout so it can be piped
+func (c *CLI) exportQuestions(args []string) error {
+	var creds credentials
+	flags := newFlagSet("admin export")
+	creds.register(flags)
+	format := flags.String("format", models.ExportFormatCSV, "csv, json or markdown")
+	output := flags.String("output", "", "file to write, defaults to stdout")
+	difficulty := flags.String("difficulty", "", "easy, medium or hard")
+	company := flags.String("company", "", "company tag")
+	topic := flags.String("topic", "", "topic tag")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("admin export: unexpected arguments %v", positional)
+	}
+	if _, err := validation.ValidateExportFormat(*format); err != nil {
+		return newUsageError("admin export: %v", err)
+	}
+
+	admin, err := c.authenticate(creds, roles.QUESTIONS_WRITE)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err := c.questionService.ExportQuestions(admin.StandardUser.ID, c.out, *format, *difficulty, *company, *topic)
+		return err
+	}
+
+	// Write to a buffer first so a failed export does not leave a partial file behind
+	var buffer bytes.Buffer
+	count, err := c.questionService.ExportQuestions(admin.StandardUser.ID, &buffer, *format, *difficulty, *company, *topic)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, buffer.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("could not write %s: %v", *output, err)
+	}
+
+	fmt.Fprintf(c.out, "exported %d questions to %s\n", count, *output)
+	return nil
+}
+
+// editQuestion only changes the fields whose flags were given, so --topics "" clears the topic tags
+func (c *CLI) editQuestion(args []string) error {
+	var creds credentials
+	flags := newFlagSet("admin edit")
+	creds.register(flags)
+	title := flags.String("title", "", "new question title")
+	difficulty := flags.String("difficulty", "", "new difficulty: easy, medium or hard")
+	link := flags.String("link", "", "new Leetcode link")
+	topics := flags.String("topics", "", "comma separated topic tags")
+	companies := flags.String("companies", "", "comma separated company tags")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("admin edit: expected exactly one question ID")
+	}
+
+	var update models.QuestionUpdate
+	flags.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "title":
+			update.QuestionTitle = title
+		case "difficulty":
+			update.Difficulty = difficulty
+		case "link":
+			update.QuestionLink = link
+		case "topics":
+			tags := data_cleaning.CleanTags(*topics)
+			update.TopicTags = &tags
+		case "companies":
+			tags := data_cleaning.CleanTags(*companies)
+			update.CompanyTags = &tags
+		}
+	})
+	if update == (models.QuestionUpdate{}) {
+		return newUsageError("admin edit: nothing to change, use --title, --difficulty, --link, --topics or --companies")
+	}
+
+	admin, err := c.authenticate(creds, roles.QUESTIONS_WRITE)
+	if err != nil {
+		return err
+	}
+
+	question, err := c.questionService.UpdateQuestion(admin.StandardUser.ID, positional[0], update)
+	if err != nil {
+		return fmt.Errorf("could not update question %s: %v", positional[0], err)
+	}
+
+	fmt.Fprintf(c.out, "updated %s: %s (%s)\n", question.QuestionID, question.QuestionTitle, question.Difficulty)
+	return nil
+}
+
+// banUser bans a user for a reason, until --expires or until an admin lifts the ban
+func (c *CLI) banUser(args []string) error {
+	var creds credentials
+	flags := newFlagSet("admin ban")
+	creds.register(flags)
+	reason := flags.String("reason", "", "why the user is banned, shown to them at login")
+	expires := flags.String("expires", "", "a date like 2006-01-02, a number of days like 7d or a duration like 36h")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("admin ban: expected exactly one username")
+	}
+	username := data_cleaning.CleanString(positional[0])
+	if !validation.ValidateUsername(username) {
+		return newUsageError("admin ban: invalid username %q", positional[0])
+	}
+	if strings.TrimSpace(*reason) == "" {
+		return newUsageError("admin ban: --reason is required")
+	}
+	expiresAt, err := validation.ValidateExpiry(*expires, time.Now())
+	if err != nil {
+		return newUsageError("admin ban: %v", err)
+	}
+
+	admin, err := c.authenticate(creds, roles.USERS_BAN)
+	if err != nil {
+		return err
+	}
+
+	alreadyBanned, err := c.userService.BanUser(admin.StandardUser.ID, username, *reason, expiresAt)
+	if err != nil {
+		return fmt.Errorf("could not ban %s: %v", username, err)
+	}
+
+	if alreadyBanned {
+		fmt.Fprintln(c.out, "user already banned")
+	} else {
+		fmt.Fprintln(c.out, "user banned successfully")
+	}
+	return nil
+}
+
+func (c *CLI) unbanUser(adminID, username string) error {
+	alreadyUnbanned, err := c.userService.UnbanUser(adminID, username)
+	if err != nil {
+		return fmt.Errorf("could not unban %s: %v", username, err)
+	}
+
+	if alreadyUnbanned {
+		fmt.Fprintln(c.out, "user already unbanned")
+	} else {
+		fmt.Fprintln(c.out, "user unbanned successfully")
+	}
+	return nil
+}
+
+func (c *CLI) promoteUser(adminID, username string) error {
+	alreadyAdmin, err := c.userService.PromoteUser(adminID, username)
+	if err != nil {
+		return fmt.Errorf("could not promote %s: %v", username, err)
+	}
+
+	if alreadyAdmin {
+		fmt.Fprintln(c.out, "user is already an admin")
+	} else {
+		fmt.Fprintln(c.out, "user promoted to admin")
+	}
+	return nil
+}
+
+func (c *CLI) demoteUser(adminID, username string) error {
+	alreadyUser, err := c.userService.DemoteUser(adminID, username)
+	if err != nil {
+		return fmt.Errorf("could not demote %s: %v", username, err)
+	}
+
+	if alreadyUser {
+		fmt.Fprintln(c.out, "user is already a standard user")
+	} else {
+		fmt.Fprintln(c.out, "user demoted to a standard user")
+	}
+	return nil
+}
+
+func (c *CLI) changeUserRole(adminID, username, role string) error {
+	alreadyHad, err := c.userService.ChangeUserRole(adminID, username, role)
+	if err != nil {
+		return fmt.Errorf("could not change the role of %s: %v", username, err)
+	}
+
+	if alreadyHad {
+		fmt.Fprintf(c.out, "user already has the %s role\n", role)
+	} else {
+		fmt.Fprintf(c.out, "user is now a %s\n", role)
+	}
+	return nil
+}
+
+func (c *CLI) platformStats(staffID string) error {
+	activeUsers, err := c.userService.CountActiveUserInLast24Hours(staffID)
+	if err != nil {
+		return fmt.Errorf("error fetching active users count: %v", err)
+	}
+
+	totalQuestions, err := c.questionService.GetTotalQuestionsCount()
+	if err != nil {
+		return fmt.Errorf("error fetching total questions count: %v", err)
+	}
+
+	fmt.Fprintf(c.out, "Active Users (Last 24 Hours) : %d\n", activeUsers)
+	fmt.Fprintf(c.out, "Total Questions on the Platform : %d\n", totalQuestions)
+	return nil
+}
@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/validation"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// runAnnouncements prints the announcements addressed to the user and marks the unread ones as read
+func (c *CLI) runAnnouncements(args []string) error {
+	var creds credentials
+	flags := newFlagSet("announcements")
+	creds.register(flags)
+	unreadOnly := flags.Bool("unread", false, "only show announcements you have not read")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("announcements: unexpected arguments %v", positional)
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	announcements, err := c.announcements.GetUserAnnouncements(user.StandardUser.ID)
+	if err != nil {
+		return err
+	}
+
+	shown := 0
+	for _, announcement := range *announcements {
+		if *unreadOnly && announcement.Read {
+			continue
+		}
+		if shown > 0 {
+			fmt.Fprintln(c.out)
+		}
+		shown++
+
+		var labels []string
+		if !announcement.Read {
+			labels = append(labels, "new")
+		}
+		if announcement.Announcement.Pinned {
+			labels = append(labels, "pinned")
+		}
+		title := announcement.Announcement.Title
+		if len(labels) > 0 {
+			title = fmt.Sprintf("[%s] %s", strings.Join(labels, ", "), title)
+		}
+
+		fmt.Fprintln(c.out, title)
+		fmt.Fprintln(c.out, announcement.Announcement.Body)
+		fmt.Fprintf(c.out, "- %s, %s\n", announcement.Announcement.AuthorName, announcement.Announcement.CreatedAt.Local().Format("02 Jan 2006 15:04"))
+
+		if !announcement.Read {
+			if err := c.announcements.MarkAnnouncementRead(user.StandardUser.ID, announcement.Announcement.ID); err != nil {
+				fmt.Fprintln(c.errOut, "warning: could not mark announcement as read:", err)
+			}
+		}
+	}
+
+	if shown == 0 {
+		fmt.Fprintln(c.out, "No announcements")
+	}
+	return nil
+}
+
+// postAnnouncement publishes an announcement from the command line
+func (c *CLI) postAnnouncement(args []string) error {
+	var creds credentials
+	flags := newFlagSet("admin announce")
+	creds.register(flags)
+	title := flags.String("title", "", "announcement title")
+	message := flags.String("message", "", "announcement message")
+	organisation := flags.String("organisation", "", "only show it to users of this organisation")
+	country := flags.String("country", "", "only show it to users from this country")
+	pin := flags.Bool("pin", false, "pin it above the other announcements")
+	expires := flags.String("expires", "", "a date like 2006-01-02, a number of days like 7d or a duration like 36h")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("admin announce: unexpected arguments %v", positional)
+	}
+	if strings.TrimSpace(*title) == "" || strings.TrimSpace(*message) == "" {
+		return newUsageError("admin announce: --title and --message are required")
+	}
+	if *organisation != "" && *country != "" {
+		return newUsageError("admin announce: give either --organisation or --country")
+	}
+	expiresAt, err := validation.ValidateExpiry(*expires, time.Now())
+	if err != nil {
+		return newUsageError("admin announce: %v", err)
+	}
+
+	draft := models.AnnouncementDraft{
+		Title:     *title,
+		Body:      *message,
+		Audience:  models.AudienceEveryone,
+		Pinned:    *pin,
+		ExpiresAt: expiresAt,
+	}
+	if *organisation != "" {
+		draft.Audience, draft.Target = models.AudienceOrganisation, *organisation
+	} else if *country != "" {
+		draft.Audience, draft.Target = models.AudienceCountry, *country
+	}
+
+	admin, err := c.authenticate(creds, roles.ANNOUNCEMENTS_WRITE)
+	if err != nil {
+		return err
+	}
+
+	announcement, err := c.announcements.CreateAnnouncement(admin.StandardUser.ID, draft)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Posted announcement %s\n", announcement.ID)
+	return nil
+}
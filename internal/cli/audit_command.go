@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"bytes"
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/validation"
+	"fmt"
+	"os"
+)
+
+// exportAuditLog writes the audit log, or the entries matching the filters, in the order they were recorded
+func (c *CLI) exportAuditLog(args []string) error {
+	var creds credentials
+	flags := newFlagSet("admin audit")
+	creds.register(flags)
+	format := flags.String("format", models.ExportFormatCSV, "csv or json")
+	output := flags.String("output", "", "file to write, defaults to stdout")
+	actor := flags.String("actor", "", "username or ID of the admin who acted")
+	action := flags.String("action", "", "action such as user.ban, or a kind such as user")
+	target := flags.String("target", "", "question ID, username or file the action was on")
+	since := flags.String("since", "", "first day, like 2006-01-02")
+	until := flags.String("until", "", "last day, like 2006-01-02")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("admin audit: unexpected arguments %v", positional)
+	}
+	if validFormat, err := validation.ValidateExportFormat(*format); err != nil {
+		return newUsageError("admin audit: %v", err)
+	} else if validFormat == models.ExportFormatMarkdown {
+		return newUsageError("admin audit: %v", services.ErrUnsupportedAuditFormat)
+	}
+	from, to, err := validation.ValidateDateRange(*since, *until)
+	if err != nil {
+		return newUsageError("admin audit: %v", err)
+	}
+	filter := models.AuditFilter{Actor: *actor, Action: *action, Target: *target, Since: from, Until: to}
+
+	admin, err := c.authenticate(creds, roles.AUDIT_VIEW)
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err := c.audit.ExportAuditLog(admin.StandardUser.ID, c.out, *format, filter)
+		return err
+	}
+
+	// Write to a buffer first so a failed export does not leave a partial file behind
+	var buffer bytes.Buffer
+	count, err := c.audit.ExportAuditLog(admin.StandardUser.ID, &buffer, *format, filter)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, buffer.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("could not write %s: %v", *output, err)
+	}
+
+	fmt.Fprintf(c.out, "exported %d audit entries to %s\n", count, *output)
+	return nil
+}
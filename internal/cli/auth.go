@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	credstore "cli-project/pkg/utils/credentials"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+)
+
+var (
+	ErrNoCredentials = errors.New("no credentials: run codesage login, pass --username and --password or set " + config.USERNAME_ENV + " and " + config.PASSWORD_ENV)
+	ErrBanned        = errors.New("you are banned from the platform")
+	ErrForbidden     = errors.New("your role does not allow this command")
+)
+
+type credentials struct {
+	username string
+	password string
+}
+
+// register adds the credential flags to a subcommand
+func (creds *credentials) register(flags *flag.FlagSet) {
+	flags.StringVar(&creds.username, "username", "", "CodeSage username (default $"+config.USERNAME_ENV+")")
+	flags.StringVar(&creds.password, "password", "", "CodeSage password (default $"+config.PASSWORD_ENV+")")
+}
+
+// resolve fills credentials that were not passed as flags from the environment
+func (creds *credentials) resolve() error {
+	if creds.username == "" {
+		creds.username = os.Getenv(config.USERNAME_ENV)
+	}
+	if creds.password == "" {
+		creds.password = os.Getenv(config.PASSWORD_ENV)
+	}
+	if creds.username == "" || creds.password == "" {
+		return ErrNoCredentials
+	}
+	creds.username = data_cleaning.CleanString(creds.username)
+	return nil
+}
+
+// openSession logs in with the given credentials, falling back to the session stored by codesage login.
+// Sessions opened from a password only last for this run and are revoked when it ends.
+func (c *CLI) openSession(creds credentials) (*models.Session, error) {
+	if err := creds.resolve(); err == nil {
+		session, err := c.userService.Login(creds.username, creds.password)
+		if err != nil {
+			return nil, err
+		}
+		c.session, c.ownsSession = session, true
+		return session, nil
+	}
+
+	stored, err := credstore.Load(credstore.DefaultPath())
+	if err != nil {
+		if errors.Is(err, credstore.ErrNoCredentials) {
+			return nil, ErrNoCredentials
+		}
+		return nil, err
+	}
+
+	session, err := c.userService.ResumeSession(stored.Token)
+	if err != nil {
+		_ = credstore.Remove(credstore.DefaultPath())
+		return nil, err
+	}
+	c.session = session
+	return session, nil
+}
+
+// banError tells a banned user why they are banned and until when, falling back to ErrBanned alone
+// for bans without details. The error always wraps ErrBanned.
+func (c *CLI) banError(userID string) error {
+	ban, err := c.userService.GetActiveBan(userID)
+	if err != nil {
+		return ErrBanned
+	}
+
+	until := "until an admin lifts it"
+	if !ban.ExpiresAt.IsZero() {
+		until = "until " + ban.ExpiresAt.Local().Format("02 Jan 2006 15:04")
+	}
+	return fmt.Errorf("%w %s: %s", ErrBanned, until, ban.Reason)
+}
+
+// authenticate opens a session and applies the same ban check as the login page. A non empty
+// permission must be granted by the user's role, the services check it again when they run.
+func (c *CLI) authenticate(creds credentials, permission string) (*models.StandardUser, error) {
+	session, err := c.openSession(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := c.userService.GetUserByID(session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.StandardUser.IsBanned {
+		return nil, c.banError(user.StandardUser.ID)
+	}
+
+	if permission != "" && !roles.Has(user.StandardUser.Role, permission) {
+		return nil, fmt.Errorf("%w, it needs %s", ErrForbidden, permission)
+	}
+
+	return user, nil
+}
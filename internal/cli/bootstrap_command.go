@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"fmt"
+	"strings"
+)
+
+// runBootstrapAdmin creates the first admin account, it is refused once an admin exists
+func (c *CLI) runBootstrapAdmin(args []string) error {
+	flags := newFlagSet("bootstrap-admin")
+	username := flags.String("username", "", "username of the new admin")
+	password := flags.String("password", "", "password of the new admin, asked for when not given")
+	name := flags.String("name", "", "name of the new admin")
+	email := flags.String("email", "", "email of the new admin")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("bootstrap-admin: unexpected arguments %v", positional)
+	}
+
+	// The same checks as the signup page, apart from the Leetcode account an admin does not need
+	user := models.StandardUser{StandardUser: models.User{
+		Username: data_cleaning.CleanString(*username),
+		Name:     strings.TrimSpace(*name),
+		Email:    data_cleaning.CleanString(*email),
+	}}
+	if !validation.ValidateUsername(user.StandardUser.Username) {
+		return newUsageError("bootstrap-admin: invalid username %q, it should be between 4 and 20 characters long, should not be only numbers and contain no spaces", *username)
+	}
+	if !validation.ValidateName(user.StandardUser.Name) {
+		return newUsageError("bootstrap-admin: invalid name %q, it should be 3 to 30 characters long and contain only letters and spaces", *name)
+	}
+	if validFormat, validDomain := validation.ValidateEmail(user.StandardUser.Email); !validFormat || !validDomain {
+		return newUsageError("bootstrap-admin: invalid email %q", *email)
+	}
+
+	if *password == "" {
+		fmt.Fprint(c.out, "Password: ")
+		*password = c.readPassword()
+		fmt.Fprint(c.out, "\nConfirm Password: ")
+		confirm := c.readPassword()
+		fmt.Fprintln(c.out)
+		if strings.TrimSpace(*password) != strings.TrimSpace(confirm) {
+			return newUsageError("bootstrap-admin: passwords do not match")
+		}
+	}
+	user.StandardUser.Password = strings.TrimSpace(*password)
+	if !validation.ValidatePassword(user.StandardUser.Password) {
+		return newUsageError("bootstrap-admin: invalid password, it must be at least 8 characters long and include at least 1 uppercase & lowercase letters, 1 digit, and 1 special character")
+	}
+
+	if err := c.userService.BootstrapAdmin(&user); err != nil {
+		return fmt.Errorf("could not create the admin: %v", err)
+	}
+
+	fmt.Fprintf(c.out, "admin %s created, log in to manage the platform\n", user.StandardUser.Username)
+	return nil
+}
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"bufio"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+const usage = `Usage: codesage [-storage mongo|bolt] [-db dsn] <command> [flags]
+
+Commands:
+  login [--username u] [--password p]                         remember a session for later commands
+  logout                                                      end the remembered session
+  questions list [--difficulty a,b] [--topic a,b] [--company a,b]
+                 [--all-topics] [--all-companies] [--status s]
+                 [--exclude-topic a,b] [--exclude-company a,b]
+                                                              list questions in the bank, tag lists match
+                                                              any tag unless --all-* is given (status
+                                                              solved|unsolved|any)
+  questions next [--company c] [--progression p] [--limit n]  recommend what to solve next (progression
+                                                              gradual|easy|medium|hard)
+  questions search <query>... [--limit n]                     search titles and tags, tolerating typos
+  progress add <question-id>... [--minutes m] [--language l] [--notes n] [--rating r]
+                                                              mark questions as solved and schedule
+                                                              a revision (rating again|hard|good|easy)
+  progress sync                                               mark recent accepted Leetcode submissions
+                                                              as solved
+  progress export [--output file]                             export your profile and solve history
+                                                              as JSON
+  progress import <file.json>                                 merge an exported solve history into
+                                                              your account
+  stats                                                       show your Leetcode stats
+  lists [list]                                                show your study lists, or one list with
+                                                              your progress (by name or ID)
+  lists create <name> [--description d] [--shared]            create a study list
+  lists add <list> <question-id>... [--position n]            add questions to a list
+  lists remove <list> <question-id>...                        remove questions from a list
+  notes [question-id]                                         list your notes, or show the note on
+                                                              one question
+  notes edit <question-id>                                    write the note in $EDITOR, saving it
+                                                              empty deletes it
+  notes delete <question-id>                                  delete the note on a question
+  notes search <query>...                                     show the notes containing every word
+  announcements [--unread]                                    show announcements for you, marking them
+                                                              as read
+  admin import <file.csv> [--dry-run]                         add new and update changed questions from
+                                                              a CSV file, reporting the diff
+  admin edit <question-id> [--title t] [--difficulty d]       change a question, only the given fields
+             [--link l] [--topics a,b] [--companies a,b]      are updated
+  admin export [--format csv|json|markdown] [--output file]   export the question bank, or the questions
+               [--difficulty d] [--company c] [--topic t]     matching the filters
+  admin announce --title t --message m [--pin] [--expires e]  post an announcement to everyone, or to
+                 [--organisation o | --country c]             one organisation or country (expires
+                                                              2006-01-02|7d|36h)
+  admin audit [--actor a] [--action a] [--target t]           export the audit log of admin actions, or
+              [--since d] [--until d]                         the entries matching the filters (dates
+              [--format csv|json] [--output file]             2006-01-02, action e.g. user.ban or user)
+  admin ban <username> --reason r [--expires e]               ban a user for a reason, until the expiry
+                                                              or until a moderator lifts it
+  admin unban <username>                                      unban a user
+  admin promote <username>                                    make a user an admin
+  admin demote <username>                                     make a user a standard user, the last
+                                                              admin cannot be demoted
+  admin role <username> <role>                                give a user a role: user, content_editor,
+                                                              moderator or admin
+  admin stats                                                 show platform stats
+  bootstrap-admin --username u --name n --email e [--password p]
+                                                              create the first admin account, refused
+                                                              once an admin exists
+
+Every command accepts --username and --password, reads them from
+CODESAGE_USERNAME and CODESAGE_PASSWORD, or uses the session saved by login.
+Admin commands need the permission for them: moderators can ban and announce,
+content editors can import, edit and export questions, both can see stats.
+Run without a command to start the interactive menu, or with -http addr to serve the REST API.
+`
+
+// usageError marks errors caused by wrong arguments rather than failed operations.
+type usageError struct {
+	msg string
+}
+
+func (e *usageError) Error() string {
+	return e.msg
+}
+
+func newUsageError(format string, args ...interface{}) error {
+	return &usageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// CLI runs the menu features as non-interactive subcommands
+type CLI struct {
+	userService     interfaces.UserService
+	questionService interfaces.QuestionService
+	reviewService   interfaces.ReviewService
+	recommender     interfaces.RecommendationService
+	studyLists      interfaces.StudyListService
+	notes           interfaces.NoteService
+	announcements   interfaces.AnnouncementService
+	audit           interfaces.AuditService
+	input           io.Reader
+	in              *bufio.Reader
+	out             io.Writer
+	errOut          io.Writer
+
+	// session is the session the current command runs under, ownsSession
+	// is set when it was opened for this command alone and must be revoked.
+	session     *models.Session
+	ownsSession bool
+}
+
+// NewCLI initializes the CLI with the provided services, input reader and output writers
+func NewCLI(userService interfaces.UserService, questionService interfaces.QuestionService, reviewService interfaces.ReviewService, recommender interfaces.RecommendationService, studyLists interfaces.StudyListService, notes interfaces.NoteService, announcements interfaces.AnnouncementService, audit interfaces.AuditService, in io.Reader, out, errOut io.Writer) *CLI {
+	return &CLI{
+		userService:     userService,
+		questionService: questionService,
+		reviewService:   reviewService,
+		recommender:     recommender,
+		studyLists:      studyLists,
+		notes:           notes,
+		announcements:   announcements,
+		audit:           audit,
+		input:           in,
+		in:              bufio.NewReader(in),
+		out:             out,
+		errOut:          errOut,
+	}
+}
+
+// Run executes the subcommand in args and returns the process exit code.
+func (c *CLI) Run(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprint(c.errOut, usage)
+		return exitUsage
+	}
+
+	var err error
+	switch args[0] {
+	case "login":
+		err = c.runLogin(args[1:])
+	case "logout":
+		err = c.runLogout(args[1:])
+	case "questions":
+		err = c.runQuestions(args[1:])
+	case "progress":
+		err = c.runProgress(args[1:])
+	case "stats":
+		err = c.runStats(args[1:])
+	case "lists":
+		err = c.runLists(args[1:])
+	case "notes":
+		err = c.runNotes(args[1:])
+	case "announcements":
+		err = c.runAnnouncements(args[1:])
+	case "admin":
+		err = c.runAdmin(args[1:])
+	case "bootstrap-admin":
+		err = c.runBootstrapAdmin(args[1:])
+	case "help", "-h", "--help":
+		fmt.Fprint(c.out, usage)
+		return exitOK
+	default:
+		err = newUsageError("unknown command %q", args[0])
+	}
+
+	// Sessions opened for a single command end with it, like logging out of the menus
+	if c.ownsSession && c.session != nil {
+		if logoutErr := c.userService.Logout(c.session); logoutErr != nil {
+			fmt.Fprintln(c.errOut, "warning: could not end session:", logoutErr)
+		}
+	}
+	c.session, c.ownsSession = nil, false
+
+	if err != nil {
+		fmt.Fprintln(c.errOut, "error:", err)
+
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			fmt.Fprint(c.errOut, "\n"+usage)
+			return exitUsage
+		}
+		return exitError
+	}
+
+	return exitOK
+}
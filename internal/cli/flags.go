@@ -0,0 +1,29 @@
+package cli
+
+import (
+	"flag"
+	"io"
+)
+
+// newFlagSet creates a subcommand flag set that reports errors instead of exiting
+func newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	return flags
+}
+
+// parseArgs parses flags placed anywhere between the positional arguments,
+// so both "progress add 202 --username x" and "progress add --username x 202" work.
+func parseArgs(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			return nil, newUsageError("%s: %v", flags.Name(), err)
+		}
+		if flags.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, flags.Arg(0))
+		args = flags.Args()[1:]
+	}
+}
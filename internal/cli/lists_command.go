@@ -0,0 +1,252 @@
+package cli
+
+import (
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"strconv"
+	"strings"
+)
+
+func (c *CLI) runLists(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "create":
+			return c.createList(args[1:])
+		case "add":
+			return c.addToList(args[1:])
+		case "remove":
+			return c.removeFromList(args[1:])
+		}
+	}
+	return c.showLists(args)
+}
+
+func (c *CLI) showLists(args []string) error {
+	var creds credentials
+	flags := newFlagSet("lists")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return newUsageError("lists: expected at most one list, got %v", positional)
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 1 {
+		list, err := c.resolveList(user.StandardUser.ID, positional[0])
+		if err != nil {
+			return err
+		}
+		progress, err := c.studyLists.GetStudyListProgress(user.StandardUser.ID, list.ID)
+		if err != nil {
+			return err
+		}
+		c.renderStudyList(progress)
+		return nil
+	}
+
+	lists, err := c.studyLists.GetStudyLists(user.StandardUser.ID)
+	if err != nil {
+		return err
+	}
+	if len(*lists) == 0 {
+		fmt.Fprintln(c.out, "No study lists yet, create one with: codesage lists create <name>")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(c.out)
+	table.SetHeader([]string{"ID", "Name", "Owner", "Visibility", "Questions"})
+	table.SetAutoWrapText(false)
+	for _, list := range *lists {
+		visibility := "private"
+		if list.Shared {
+			visibility = "shared"
+		}
+		table.Append([]string{list.ID, list.Name, list.OwnerName, visibility, strconv.Itoa(len(list.QuestionIDs))})
+	}
+	table.Render()
+	return nil
+}
+
+func (c *CLI) createList(args []string) error {
+	var creds credentials
+	flags := newFlagSet("lists create")
+	creds.register(flags)
+	description := flags.String("description", "", "what the list is for")
+	shared := flags.Bool("shared", false, "share the list with everyone")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		return newUsageError("lists create: a list name is required")
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	// Unquoted names arrive as several arguments
+	list, err := c.studyLists.CreateStudyList(user.StandardUser.ID, strings.Join(positional, " "), *description, *shared)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "created %s (%s)\n", list.Name, list.ID)
+	return nil
+}
+
+func (c *CLI) addToList(args []string) error {
+	var creds credentials
+	flags := newFlagSet("lists add")
+	creds.register(flags)
+	position := flags.Int("position", 0, "1-based position of the first question, defaults to the end")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 {
+		return newUsageError("lists add: a list and at least one question ID are required")
+	}
+	if *position < 0 {
+		return newUsageError("lists add: --position must be a positive number")
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	list, err := c.resolveList(user.StandardUser.ID, positional[0])
+	if err != nil {
+		return err
+	}
+
+	// Keep going on failures so one bad ID does not hide the others
+	failed := false
+	next := *position
+	for _, questionID := range positional[1:] {
+		if err := c.studyLists.AddQuestionToList(user.StandardUser.ID, list.ID, questionID, next); err != nil {
+			failed = true
+			fmt.Fprintf(c.errOut, "%s: %v\n", questionID, err)
+			continue
+		}
+		fmt.Fprintf(c.out, "%s: added to %s\n", questionID, list.Name)
+		if next > 0 {
+			next++
+		}
+	}
+
+	if failed {
+		return errors.New("some questions could not be added")
+	}
+	return nil
+}
+
+func (c *CLI) removeFromList(args []string) error {
+	var creds credentials
+	flags := newFlagSet("lists remove")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) < 2 {
+		return newUsageError("lists remove: a list and at least one question ID are required")
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	list, err := c.resolveList(user.StandardUser.ID, positional[0])
+	if err != nil {
+		return err
+	}
+
+	failed := false
+	for _, questionID := range positional[1:] {
+		if err := c.studyLists.RemoveQuestionFromList(user.StandardUser.ID, list.ID, questionID); err != nil {
+			failed = true
+			fmt.Fprintf(c.errOut, "%s: %v\n", questionID, err)
+			continue
+		}
+		fmt.Fprintf(c.out, "%s: removed from %s\n", questionID, list.Name)
+	}
+
+	if failed {
+		return errors.New("some questions could not be removed")
+	}
+	return nil
+}
+
+// resolveList finds a visible list by ID or by name, preferring the user's own list when names clash
+func (c *CLI) resolveList(userID, nameOrID string) (*models.StudyList, error) {
+	lists, err := c.studyLists.GetStudyLists(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []models.StudyList
+	for _, list := range *lists {
+		if list.ID == nameOrID {
+			return &list, nil
+		}
+		if strings.EqualFold(list.Name, strings.TrimSpace(nameOrID)) {
+			matches = append(matches, list)
+		}
+	}
+
+	for _, list := range matches {
+		if list.OwnerID == userID {
+			return &list, nil
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no study list named %q", nameOrID)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("several shared lists are named %q, use the list ID instead", nameOrID)
+	}
+}
+
+func (c *CLI) renderStudyList(progress *models.StudyListProgress) {
+	fmt.Fprintf(c.out, "%s: %d/%d solved\n", progress.List.Name, progress.Solved, progress.Total)
+	if progress.List.Description != "" {
+		fmt.Fprintln(c.out, progress.List.Description)
+	}
+	if len(progress.Items) == 0 {
+		return
+	}
+
+	table := tablewriter.NewWriter(c.out)
+	table.SetHeader([]string{"#", "ID", "Title", "Difficulty", "Solved"})
+	table.SetAutoWrapText(false)
+	for _, item := range progress.Items {
+		title, solved := item.Question.QuestionTitle, ""
+		if item.Missing {
+			title = "(question removed)"
+		}
+		if item.Solved {
+			solved = "yes"
+		}
+		table.Append([]string{strconv.Itoa(item.Position), item.Question.QuestionID, title, item.Question.Difficulty, solved})
+	}
+	table.Render()
+}
@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/editor"
+	"errors"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"strconv"
+	"strings"
+)
+
+func (c *CLI) runNotes(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "edit":
+			return c.editNote(args[1:])
+		case "delete":
+			return c.deleteNote(args[1:])
+		case "search":
+			return c.searchNotes(args[1:])
+		}
+	}
+	return c.showNotes(args)
+}
+
+// showNotes lists the user's notes, or prints the whole note on one question
+func (c *CLI) showNotes(args []string) error {
+	var creds credentials
+	flags := newFlagSet("notes")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 1 {
+		return newUsageError("notes: expected at most one question ID, got %v", positional)
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	if len(positional) == 1 {
+		note, err := c.notes.GetNote(user.StandardUser.ID, positional[0])
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "Question %s, last edited %s\n\n", note.QuestionID, note.UpdatedAt.Local().Format("02 Jan 2006 15:04"))
+		fmt.Fprintln(c.out, note.Body)
+		return nil
+	}
+
+	notes, err := c.notes.GetNotes(user.StandardUser.ID)
+	if err != nil {
+		return err
+	}
+	if len(*notes) == 0 {
+		fmt.Fprintln(c.out, "No notes yet, write one with: codesage notes edit <question-id>")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(c.out)
+	table.SetHeader([]string{"Question", "First Line", "Snippets", "Updated"})
+	table.SetAutoWrapText(false)
+	for _, note := range *notes {
+		table.Append([]string{
+			note.QuestionID,
+			firstLine(note.Body),
+			snippetLanguages(note.Snippets),
+			note.UpdatedAt.Local().Format("02 Jan 2006"),
+		})
+	}
+	table.Render()
+	return nil
+}
+
+// editNote opens the note on a question in the user's editor, saving it empty deletes the note
+func (c *CLI) editNote(args []string) error {
+	var creds credentials
+	flags := newFlagSet("notes edit")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("notes edit: expected one question ID")
+	}
+	questionID := data_cleaning.CleanString(positional[0])
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	// Check the question before opening the editor, so nothing written is thrown away
+	exists, err := c.questionService.QuestionExists(questionID)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("question with ID %s does not exist", questionID)
+	}
+
+	body := ""
+	note, err := c.notes.GetNote(user.StandardUser.ID, questionID)
+	if err == nil {
+		body = note.Body + "\n"
+	} else if !errors.Is(err, services.ErrNoteNotFound) {
+		return err
+	}
+
+	edited, err := editor.Edit(body, ".md", c.input, c.out, c.errOut)
+	if err != nil {
+		return err
+	}
+	edited = strings.TrimSpace(edited)
+
+	switch {
+	case edited == strings.TrimSpace(body):
+		fmt.Fprintln(c.out, "Note unchanged")
+	case edited == "":
+		if err := c.notes.DeleteNote(user.StandardUser.ID, questionID); err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "Deleted your note on question %s\n", questionID)
+	default:
+		saved, err := c.notes.SaveNote(user.StandardUser.ID, questionID, edited)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.out, "Saved your note on question %s with %d code snippet(s)\n", questionID, len(saved.Snippets))
+	}
+	return nil
+}
+
+func (c *CLI) deleteNote(args []string) error {
+	var creds credentials
+	flags := newFlagSet("notes delete")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("notes delete: expected one question ID")
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	if err := c.notes.DeleteNote(user.StandardUser.ID, positional[0]); err != nil {
+		return err
+	}
+	fmt.Fprintf(c.out, "Deleted your note on question %s\n", data_cleaning.CleanString(positional[0]))
+	return nil
+}
+
+func (c *CLI) searchNotes(args []string) error {
+	var creds credentials
+	flags := newFlagSet("notes search")
+	creds.register(flags)
+
+	words, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	query := strings.TrimSpace(strings.Join(words, " "))
+	if query == "" {
+		return newUsageError("notes search: missing search query")
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	matches, err := c.notes.SearchNotes(user.StandardUser.ID, query)
+	if err != nil {
+		return err
+	}
+	if len(*matches) == 0 {
+		fmt.Fprintln(c.out, "No notes match the search")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(c.out)
+	table.SetHeader([]string{"Question", "Match", "Updated"})
+	table.SetAutoWrapText(false)
+	for _, match := range *matches {
+		table.Append([]string{match.Note.QuestionID, match.Excerpt, match.Note.UpdatedAt.Local().Format("02 Jan 2006")})
+	}
+	table.Render()
+	return nil
+}
+
+// firstLine returns the first non-blank line of a note, without Markdown heading marks
+func firstLine(body string) string {
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(strings.TrimLeft(line, "#")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// snippetLanguages summarises a note's snippets, such as "go, python", or their count when untagged
+func snippetLanguages(snippets []models.CodeSnippet) string {
+	if len(snippets) == 0 {
+		return ""
+	}
+	languages := []string{}
+	for _, snippet := range snippets {
+		if snippet.Language != "" {
+			languages = append(languages, snippet.Language)
+		}
+	}
+	if len(languages) == 0 {
+		return strconv.Itoa(len(snippets))
+	}
+	return strings.Join(languages, ", ")
+}
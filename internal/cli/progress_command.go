@@ -0,0 +1,231 @@
+package cli
+
+import (
+	"bytes"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+func (c *CLI) runProgress(args []string) error {
+	if len(args) == 0 {
+		return newUsageError("progress: missing subcommand")
+	}
+
+	switch args[0] {
+	case "add":
+		return c.addProgress(args[1:])
+	case "sync":
+		return c.syncProgress(args[1:])
+	case "export":
+		return c.exportProgress(args[1:])
+	case "import":
+		return c.importProgress(args[1:])
+	default:
+		return newUsageError("progress: unknown subcommand %q", args[0])
+	}
+}
+
+func (c *CLI) addProgress(args []string) error {
+	var creds credentials
+	flags := newFlagSet("progress add")
+	creds.register(flags)
+	minutes := flags.String("minutes", "", "time taken in minutes")
+	language := flags.String("language", "", "language the question was solved in")
+	notes := flags.String("notes", "", "notes about the solve")
+	rating := flags.String("rating", "good", "how hard the solution was to recall: again, hard, good or easy")
+
+	questionIDs, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(questionIDs) == 0 {
+		return newUsageError("progress add: at least one question ID is required")
+	}
+
+	for i, questionID := range questionIDs {
+		questionIDs[i] = data_cleaning.CleanString(questionID)
+		if valid, err := validation.ValidateQuestionID(questionIDs[i]); !valid {
+			return newUsageError("progress add: %v", err)
+		}
+	}
+
+	var timeTaken time.Duration
+	if *minutes != "" {
+		if timeTaken, err = validation.ValidateMinutes(*minutes); err != nil {
+			return newUsageError("progress add: %v", err)
+		}
+	}
+
+	recall, err := validation.ValidateRecallRating(*rating)
+	if err != nil {
+		return newUsageError("progress add: %v", err)
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	// Keep going on failures so one bad ID does not hide the others
+	failed := false
+	for _, questionID := range questionIDs {
+		progressUpdated, err := c.userService.UpdateUserProgress(user.StandardUser.ID, models.SolveEvent{
+			QuestionID: questionID,
+			TimeTaken:  timeTaken,
+			Language:   *language,
+			Notes:      *notes,
+		})
+		if err != nil {
+			failed = true
+			fmt.Fprintf(c.errOut, "%s: failed to update progress: %v\n", questionID, err)
+			continue
+		}
+
+		if !progressUpdated {
+			fmt.Fprintf(c.out, "%s: already marked as done, revisit recorded\n", questionID)
+		} else {
+			fmt.Fprintf(c.out, "%s: marked as done\n", questionID)
+		}
+
+		card, err := c.reviewService.RecordReview(user.StandardUser.ID, questionID, recall)
+		if err != nil {
+			failed = true
+			fmt.Fprintf(c.errOut, "%s: failed to schedule revision: %v\n", questionID, err)
+			continue
+		}
+		fmt.Fprintf(c.out, "%s: next revision on %s\n", questionID, card.DueAt.Local().Format("02 Jan 2006"))
+	}
+
+	if failed {
+		return errors.New("some questions could not be marked as done")
+	}
+	return nil
+}
+
+func (c *CLI) syncProgress(args []string) error {
+	var creds credentials
+	flags := newFlagSet("progress sync")
+	creds.register(flags)
+
+	if extra, err := parseArgs(flags, args); err != nil {
+		return err
+	} else if len(extra) > 0 {
+		return newUsageError("progress sync: unexpected argument %q", extra[0])
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	report, err := c.userService.SyncLeetcodeProgress(user.StandardUser.ID)
+	if report != nil {
+		for _, solve := range report.Added {
+			status := "revisit recorded"
+			if solve.FirstSolve {
+				status = "marked as done"
+			}
+			fmt.Fprintf(c.out, "%s: %s (%s, solved %s)\n", solve.QuestionID, status, solve.QuestionTitle, solve.SolvedAt.Local().Format("02 Jan 2006 15:04"))
+
+			// Synced solves have no recall rating, so schedule them as recalled well
+			if _, reviewErr := c.reviewService.RecordReview(user.StandardUser.ID, solve.QuestionID, models.RecallGood); reviewErr != nil {
+				fmt.Fprintf(c.errOut, "%s: failed to schedule revision: %v\n", solve.QuestionID, reviewErr)
+			}
+		}
+		for _, submission := range report.Unmatched {
+			fmt.Fprintf(c.out, "skipped %q: not in the question bank\n", submission.Title)
+		}
+		fmt.Fprintf(c.out, "added %d solve(s), %d already synced, %d not in the question bank\n", len(report.Added), report.Skipped, len(report.Unmatched))
+	}
+
+	return err
+}
+
+// exportProgress writes the progress export to --output, or to stdout so it can be piped
+func (c *CLI) exportProgress(args []string) error {
+	var creds credentials
+	flags := newFlagSet("progress export")
+	creds.register(flags)
+	output := flags.String("output", "", "file to write, defaults to stdout")
+
+	if extra, err := parseArgs(flags, args); err != nil {
+		return err
+	} else if len(extra) > 0 {
+		return newUsageError("progress export: unexpected argument %q", extra[0])
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		return c.userService.ExportProgress(user.StandardUser.ID, c.out)
+	}
+
+	var buffer bytes.Buffer
+	if err := c.userService.ExportProgress(user.StandardUser.ID, &buffer); err != nil {
+		return err
+	}
+	if err := os.WriteFile(*output, buffer.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("could not write %s: %v", *output, err)
+	}
+
+	fmt.Fprintf(c.out, "progress exported to %s\n", *output)
+	return nil
+}
+
+func (c *CLI) importProgress(args []string) error {
+	var creds credentials
+	flags := newFlagSet("progress import")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return newUsageError("progress import: expected exactly one progress file")
+	}
+
+	file, err := os.Open(positional[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	report, err := c.userService.ImportProgress(user.StandardUser.ID, file)
+	if report != nil {
+		for _, solve := range report.Added {
+			status := "revisit recorded"
+			if solve.FirstSolve {
+				status = "marked as done"
+
+				// Imported solves have no recall rating, so schedule the newly solved ones as recalled well
+				if _, reviewErr := c.reviewService.RecordReview(user.StandardUser.ID, solve.QuestionID, models.RecallGood); reviewErr != nil {
+					fmt.Fprintf(c.errOut, "%s: failed to schedule revision: %v\n", solve.QuestionID, reviewErr)
+				}
+			}
+			fmt.Fprintf(c.out, "%s: %s (%s)\n", solve.QuestionID, status, solve.QuestionTitle)
+		}
+		for _, questionID := range report.Unmatched {
+			fmt.Fprintf(c.out, "skipped %s: not in the question bank\n", questionID)
+		}
+		for _, conflict := range report.Conflicts {
+			fmt.Fprintf(c.errOut, "skipped %s\n", conflict)
+		}
+		fmt.Fprintf(c.out, "added %d solve(s), %d already recorded, %d not in the question bank, %d conflict(s)\n", len(report.Added), report.Skipped, len(report.Unmatched), len(report.Conflicts))
+	}
+
+	return err
+}
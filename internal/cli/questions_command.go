@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"io"
+	"strconv"
+	"strings"
+)
+
+func (c *CLI) runQuestions(args []string) error {
+	if len(args) == 0 {
+		return newUsageError("questions: missing subcommand")
+	}
+
+	switch args[0] {
+	case "list":
+		return c.listQuestions(args[1:])
+	case "next":
+		return c.recommendQuestions(args[1:])
+	case "search":
+		return c.searchQuestions(args[1:])
+	default:
+		return newUsageError("questions: unknown subcommand %q", args[0])
+	}
+}
+
+// listQuestions lists the bank, or the questions matching the filter flags. Flags taking a list
+// accept comma separated values.
+func (c *CLI) listQuestions(args []string) error {
+	var creds credentials
+	flags := newFlagSet("questions list")
+	creds.register(flags)
+	difficulty := flags.String("difficulty", "", "easy, medium or hard")
+	company := flags.String("company", "", "company tags, any of them by default")
+	topic := flags.String("topic", "", "topic tags, any of them by default")
+	allCompanies := flags.Bool("all-companies", false, "require every --company tag")
+	allTopics := flags.Bool("all-topics", false, "require every --topic tag")
+	excludeCompany := flags.String("exclude-company", "", "leave out questions with these company tags")
+	excludeTopic := flags.String("exclude-topic", "", "leave out questions with these topic tags")
+	status := flags.String("status", "", "solved, unsolved or any")
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("questions list: unexpected arguments %v", positional)
+	}
+	if _, err := validation.ValidateSolveStatus(*status); err != nil {
+		return newUsageError("questions list: %v", err)
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	filter := models.QuestionFilter{
+		Difficulties:      data_cleaning.CleanTags(*difficulty),
+		Topics:            data_cleaning.CleanTags(*topic),
+		MatchAllTopics:    *allTopics,
+		Companies:         data_cleaning.CleanTags(*company),
+		MatchAllCompanies: *allCompanies,
+		ExcludeTopics:     data_cleaning.CleanTags(*excludeTopic),
+		ExcludeCompanies:  data_cleaning.CleanTags(*excludeCompany),
+		Status:            *status,
+		SolvedQuestionIDs: user.QuestionsSolved,
+	}
+
+	filtered := *difficulty != "" || *company != "" || *topic != "" || *excludeCompany != "" || *excludeTopic != "" || *status != ""
+
+	var questions *[]models.Question
+	if filtered {
+		questions, err = c.questionService.FilterQuestions(filter)
+	} else {
+		questions, err = c.questionService.GetAllQuestions()
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(*questions) == 0 {
+		fmt.Fprintln(c.out, "No questions match the filter")
+		return nil
+	}
+
+	renderQuestions(c.out, *questions)
+	return nil
+}
+
+func (c *CLI) recommendQuestions(args []string) error {
+	var creds credentials
+	flags := newFlagSet("questions next")
+	creds.register(flags)
+	company := flags.String("company", "", "company you are preparing for")
+	progression := flags.String("progression", "gradual", "gradual, easy, medium or hard")
+	limit := flags.Int("limit", config.RECOMMENDATION_LIMIT, "number of questions to recommend")
+
+	if extra, err := parseArgs(flags, args); err != nil {
+		return err
+	} else if len(extra) > 0 {
+		return newUsageError("questions next: unexpected argument %q", extra[0])
+	}
+
+	if _, err := validation.ValidateProgression(*progression); err != nil {
+		return newUsageError("questions next: %v", err)
+	}
+	if *limit < 1 {
+		return newUsageError("questions next: --limit must be a positive number")
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	report, err := c.recommender.RecommendQuestions(user.StandardUser.ID, models.RecommendationOptions{
+		TargetCompany: *company,
+		Progression:   *progression,
+		Limit:         *limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(c.out, "Level: %s\n", report.Level)
+	if len(report.WeakTopics) > 0 {
+		weak := make([]string, 0, len(report.WeakTopics))
+		for _, topic := range report.WeakTopics {
+			weak = append(weak, fmt.Sprintf("%s (%d/%d)", topic.Tag, topic.Solved, topic.Total))
+		}
+		fmt.Fprintf(c.out, "Weakest topics: %s\n", strings.Join(weak, ", "))
+	}
+
+	if len(report.Recommendations) == 0 {
+		fmt.Fprintln(c.out, "You have solved every question in the bank")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(c.out)
+	table.SetHeader([]string{"#", "ID", "Title", "Difficulty", "Link", "Why"})
+	table.SetAutoWrapText(false)
+	for i, recommendation := range report.Recommendations {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			recommendation.Question.QuestionID,
+			recommendation.Question.QuestionTitle,
+			recommendation.Question.Difficulty,
+			recommendation.Question.QuestionLink,
+			strings.Join(recommendation.Reasons, "; "),
+		})
+	}
+	table.Render()
+	return nil
+}
+
+// searchQuestions ranks questions by how closely their title or tags match the query words
+func (c *CLI) searchQuestions(args []string) error {
+	var creds credentials
+	flags := newFlagSet("questions search")
+	creds.register(flags)
+	limit := flags.Int("limit", config.SEARCH_LIMIT, "maximum number of results")
+
+	words, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	query := strings.TrimSpace(strings.Join(words, " "))
+	if query == "" {
+		return newUsageError("questions search: missing search query")
+	}
+	if *limit < 1 {
+		return newUsageError("questions search: --limit must be a positive number")
+	}
+
+	if _, err := c.authenticate(creds, ""); err != nil {
+		return err
+	}
+
+	results, err := c.questionService.SearchQuestions(query, *limit)
+	if err != nil {
+		return err
+	}
+
+	if len(*results) == 0 {
+		fmt.Fprintln(c.out, "No questions match the search")
+		return nil
+	}
+
+	table := tablewriter.NewWriter(c.out)
+	table.SetHeader([]string{"ID", "Title", "Difficulty", "Link", "Matched On"})
+	table.SetAutoWrapText(false)
+	for _, result := range *results {
+		table.Append([]string{
+			result.Question.QuestionID,
+			result.Question.QuestionTitle,
+			result.Question.Difficulty,
+			result.Question.QuestionLink,
+			result.MatchedOn,
+		})
+	}
+	table.Render()
+	return nil
+}
+
+// renderQuestions prints questions with the same columns as the questions page
+func renderQuestions(out io.Writer, questions []models.Question) {
+	table := tablewriter.NewWriter(out)
+	table.SetHeader([]string{"ID", "Title", "Difficulty", "Link", "Topic-Tags", "Company-Tags"})
+	table.SetAutoWrapText(false)
+
+	for _, question := range questions {
+		table.Append([]string{
+			question.QuestionID,
+			question.QuestionTitle,
+			question.Difficulty,
+			question.QuestionLink,
+			strings.Join(question.TopicTags, ", "),
+			strings.Join(question.CompanyTags, ", "),
+		})
+	}
+
+	table.Render()
+}
@@ -0,0 +1,104 @@
+package cli
+
+import (
+	credstore "cli-project/pkg/utils/credentials"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/ssh/terminal"
+	"os"
+	"strings"
+)
+
+func (c *CLI) runLogin(args []string) error {
+	var creds credentials
+	flags := newFlagSet("login")
+	creds.register(flags)
+
+	if extra, err := parseArgs(flags, args); err != nil {
+		return err
+	} else if len(extra) > 0 {
+		return newUsageError("login: unexpected argument %q", extra[0])
+	}
+
+	// Ask for whatever was not passed as a flag or set in the environment
+	if err := creds.resolve(); err != nil {
+		if creds.username == "" {
+			fmt.Fprint(c.out, "Username: ")
+			creds.username = c.readLine()
+		}
+		if creds.password == "" {
+			fmt.Fprint(c.out, "Password: ")
+			creds.password = c.readPassword()
+			fmt.Fprintln(c.out)
+		}
+		if err := creds.resolve(); err != nil {
+			return err
+		}
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	err = credstore.Save(credstore.DefaultPath(), &credstore.Credentials{
+		Username:  c.session.Username,
+		Token:     c.session.Token,
+		ExpiresAt: c.session.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The session outlives this command now that it is stored
+	c.ownsSession = false
+
+	fmt.Fprintf(c.out, "logged in as %s until %s\n", user.StandardUser.Username, c.session.ExpiresAt.Local().Format("02 Jan 2006 15:04"))
+	return nil
+}
+
+func (c *CLI) runLogout(args []string) error {
+	flags := newFlagSet("logout")
+	if extra, err := parseArgs(flags, args); err != nil {
+		return err
+	} else if len(extra) > 0 {
+		return newUsageError("logout: unexpected argument %q", extra[0])
+	}
+
+	stored, err := credstore.Load(credstore.DefaultPath())
+	if err != nil {
+		if errors.Is(err, credstore.ErrNoCredentials) {
+			fmt.Fprintln(c.out, "not logged in")
+			return nil
+		}
+		return err
+	}
+
+	// A session that is already invalid only needs its local copy removed
+	if session, err := c.userService.ResumeSession(stored.Token); err == nil {
+		if err := c.userService.Logout(session); err != nil {
+			return err
+		}
+	}
+
+	if err := credstore.Remove(credstore.DefaultPath()); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.out, "logged out")
+	return nil
+}
+
+func (c *CLI) readLine() string {
+	line, _ := c.in.ReadString('\n')
+	return strings.TrimSpace(line)
+}
+
+// readPassword reads without echo when attached to a terminal and falls back to a plain line otherwise
+func (c *CLI) readPassword() string {
+	if file, ok := c.input.(*os.File); ok && c.in.Buffered() == 0 && terminal.IsTerminal(int(file.Fd())) {
+		password, _ := terminal.ReadPassword(int(file.Fd()))
+		return string(password)
+	}
+	return c.readLine()
+}
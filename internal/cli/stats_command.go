@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"fmt"
+)
+
+func (c *CLI) runStats(args []string) error {
+	var creds credentials
+	flags := newFlagSet("stats")
+	creds.register(flags)
+
+	positional, err := parseArgs(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return newUsageError("stats: unexpected arguments %v", positional)
+	}
+
+	user, err := c.authenticate(creds, "")
+	if err != nil {
+		return err
+	}
+
+	stats, err := c.userService.GetLeetcodeStats(user.StandardUser.ID)
+	if err != nil {
+		return fmt.Errorf("could not fetch stats: %v", err)
+	}
+
+	fmt.Fprintf(c.out, "Solved on CodeSage : %d\n", len(user.QuestionsSolved))
+	fmt.Fprintf(c.out, "Easy : %d/%d\n", stats.EasyDoneCount, stats.TotalEasyCount)
+	fmt.Fprintf(c.out, "Medium : %d/%d\n", stats.MediumDoneCount, stats.TotalMediumCount)
+	fmt.Fprintf(c.out, "Hard : %d/%d\n", stats.HardDoneCount, stats.TotalHardCount)
+
+	if len(stats.RecentACSubmissions) > 0 {
+		fmt.Fprintln(c.out, "Recent Accepted Submissions")
+		for _, submission := range stats.RecentACSubmissions {
+			fmt.Fprintln(c.out, "- "+submission)
+		}
+	}
+
+	return nil
+}
@@ -1,12 +1,69 @@
 package config
 
+import "time"
+
 const (
 	DB_NAME                 = "codesage"
 	USER_COLLECTION         = "users"
 	QUESTION_COLLECTION     = "questions"
+	SESSION_COLLECTION      = "sessions"
+	REVIEW_COLLECTION       = "reviews"
+	STUDY_LIST_COLLECTION   = "study_lists"
+	NOTE_COLLECTION         = "notes"
+	ANNOUNCEMENT_COLLECTION = "announcements"
+	AUDIT_COLLECTION        = "audit_log"
+	BAN_COLLECTION          = "bans"
 	CSV_DIR                 = "C:/Projects-WG/CLI-Project/csv"
 	GPT_API_ENDPOINT        = "https://api.openai.com/v1/chat/completions"
 	GPT_MODEL               = "gpt-4"
 	Leetcode_API            = "https://Leetcode.com/graphql/"
 	RECENT_SUBMISSION_LIMIT = 10
+	SYNC_SUBMISSION_LIMIT   = 20
+	MONGO_URI               = "mongodb://localhost:27017"
+	BOLT_DB_PATH            = "codesage.db"
+	STORAGE_DRIVER_ENV      = "CODESAGE_STORAGE"
+	STORAGE_DSN_ENV         = "CODESAGE_DB"
+	EASY_QUESTION_SCORE     = 1
+	MEDIUM_QUESTION_SCORE   = 3
+	HARD_QUESTION_SCORE     = 5
+	LEADERBOARD_SIZE        = 10
+	USERNAME_ENV            = "CODESAGE_USERNAME"
+	PASSWORD_ENV            = "CODESAGE_PASSWORD"
+	CONFIG_DIR              = ".codesage"
+	CREDENTIALS_FILE        = "credentials.json"
+	CREDENTIALS_ENV         = "CODESAGE_CREDENTIALS"
+	SESSION_KEY_FILE        = "session.key"
+	SESSION_SECRET_ENV      = "CODESAGE_SESSION_SECRET"
+	SESSION_TTL             = 7 * 24 * time.Hour
+	HTTP_ADDR_ENV           = "CODESAGE_HTTP_ADDR"
+	ACTIVITY_HISTORY_DAYS   = 14
+	HEATMAP_WEEKS           = 52
+	SM2_INITIAL_EASE_FACTOR = 2.5
+	SM2_MIN_EASE_FACTOR     = 1.3
+
+	// Recommendations move up a difficulty once this many questions of the current one are solved
+	RECOMMENDATION_LEVEL_UP_SOLVES = 10
+	RECOMMENDATION_LIMIT           = 10
+	WEAK_TOPIC_LIMIT               = 5
+
+	// Search results scoring below this share of a perfect match are left out
+	SEARCH_MIN_SCORE = 0.6
+	SEARCH_LIMIT     = 20
+
+	// Listings are paged, a request can ask for up to MAX_PAGE_SIZE rows at a time
+	PAGE_SIZE     = 20
+	MAX_PAGE_SIZE = 100
+
+	// Notes longer than this many characters are refused, EDITOR_ENV picks the editor notes open in
+	NOTE_MAX_LENGTH = 20000
+	EDITOR_ENV      = "EDITOR"
+	DEFAULT_EDITOR  = "vi"
+
+	// Announcement titles and bodies are refused beyond these many characters
+	ANNOUNCEMENT_TITLE_MAX_LENGTH = 100
+	ANNOUNCEMENT_BODY_MAX_LENGTH  = 2000
+
+	// Ban reasons and appeals are plain text shown to admins and to the banned user
+	BAN_REASON_MAX_LENGTH = 500
+	APPEAL_MAX_LENGTH     = 1000
 )
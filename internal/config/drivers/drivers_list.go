@@ -0,0 +1,6 @@
+package drivers
+
+const (
+	MONGO = "mongo"
+	BOLT  = "bolt"
+)
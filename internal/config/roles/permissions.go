@@ -0,0 +1,43 @@
+package roles
+
+import "slices"
+
+// Capabilities a role can grant, named <resource>:<action>
+const (
+	QUESTIONS_WRITE     = "questions:write"
+	USERS_BAN           = "users:ban"
+	USERS_ROLES         = "users:roles"
+	STATS_VIEW          = "stats:view"
+	ANNOUNCEMENTS_WRITE = "announcements:write"
+	AUDIT_VIEW          = "audit:view"
+	STUDY_LISTS_CURATE  = "study_lists:curate"
+)
+
+// permissions maps each role to what it may do, standard users only practise and have none
+var permissions = map[string][]string{
+	USER:           {},
+	CONTENT_EDITOR: {QUESTIONS_WRITE, STATS_VIEW, STUDY_LISTS_CURATE},
+	MODERATOR:      {USERS_BAN, STATS_VIEW, ANNOUNCEMENTS_WRITE},
+	ADMIN:          {QUESTIONS_WRITE, USERS_BAN, USERS_ROLES, STATS_VIEW, ANNOUNCEMENTS_WRITE, AUDIT_VIEW, STUDY_LISTS_CURATE},
+}
+
+// IsValid reports whether role is one of the built in roles
+func IsValid(role string) bool {
+	_, ok := permissions[role]
+	return ok
+}
+
+// Has reports whether the role grants the permission, unknown roles grant nothing
+func Has(role, permission string) bool {
+	return slices.Contains(permissions[role], permission)
+}
+
+// Permissions returns a copy of what the role grants
+func Permissions(role string) []string {
+	return slices.Clone(permissions[role])
+}
+
+// IsStaff reports whether the role grants any permission, staff get the staff menu instead of practising
+func IsStaff(role string) bool {
+	return len(permissions[role]) > 0
+}
@@ -1,6 +1,21 @@
 package roles
 
+import "slices"
+
 const (
-	USER  = "user"
-	ADMIN = "admin"
+	USER           = "user"
+	ADMIN          = "admin"
+	MODERATOR      = "moderator"
+	CONTENT_EDITOR = "content_editor"
 )
+
+// All lists the built in roles, from the least to the most privileged
+var All = []string{USER, CONTENT_EDITOR, MODERATOR, ADMIN}
+
+// Ranked lists the roles that practise and appear on the leaderboard, admins run the platform and are left out
+var Ranked = []string{USER, CONTENT_EDITOR, MODERATOR}
+
+// IsRanked reports whether users with the role appear on the leaderboard
+func IsRanked(role string) bool {
+	return slices.Contains(Ranked, role)
+}
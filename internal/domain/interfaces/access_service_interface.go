@@ -0,0 +1,5 @@
+package interfaces
+
+type AccessService interface {
+	Authorize(userID, permission string) error
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type AnnouncementRepository interface {
+	CreateAnnouncement(announcement *models.Announcement) error
+	FetchAnnouncementByID(announcementID string) (*models.Announcement, error)
+	FetchAnnouncements() (*[]models.Announcement, error)
+	UpdateAnnouncement(announcement *models.Announcement) error
+	AddReadReceipt(announcementID string, receipt models.ReadReceipt) error
+}
@@ -0,0 +1,15 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type AnnouncementService interface {
+	CreateAnnouncement(authorID string, draft models.AnnouncementDraft) (*models.Announcement, error)
+	UpdateAnnouncement(actorID, announcementID string, draft models.AnnouncementDraft) (*models.Announcement, error)
+	ExpireAnnouncement(actorID, announcementID string) error
+	SetAnnouncementPinned(actorID, announcementID string, pinned bool) error
+	GetAnnouncement(announcementID string) (*models.Announcement, error)
+	GetAllAnnouncements() (*[]models.Announcement, error)
+	GetUserAnnouncements(userID string) (*[]models.UserAnnouncement, error)
+	GetUnreadAnnouncements(userID string) (*[]models.Announcement, error)
+	MarkAnnouncementRead(userID, announcementID string) error
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+// AuditRepository is append-only, entries are never changed or removed
+type AuditRepository interface {
+	AppendAuditEntry(entry *models.AuditEntry) error
+	FetchAuditEntries(filter models.AuditFilter, page models.PageRequest) (*models.AuditPage, error)
+	FetchAllAuditEntries(filter models.AuditFilter) (*[]models.AuditEntry, error)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"cli-project/internal/domain/models"
+	"io"
+)
+
+type AuditService interface {
+	Record(actorID, action, target string, before, after interface{}) error
+	GetAuditLog(actorID string, filter models.AuditFilter, request models.PageRequest) (*models.AuditPage, error)
+	ExportAuditLog(actorID string, writer io.Writer, format string, filter models.AuditFilter) (int, error)
+}
@@ -0,0 +1,11 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type BanRepository interface {
+	CreateBan(ban *models.Ban) error
+	FetchBanByID(banID string) (*models.Ban, error)
+	FetchActiveBan(userID string) (*models.Ban, error)
+	FetchBansByAppealStatus(status string) (*[]models.Ban, error)
+	UpdateBan(ban *models.Ban) error
+}
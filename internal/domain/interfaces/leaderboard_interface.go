@@ -0,0 +1,7 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type LeaderboardRepository interface {
+	FetchSolveCounts(organisation, country string) (*[]models.LeaderboardEntry, error)
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type LeaderboardService interface {
+	GetLeaderboard(scope, scopeValue string) (*models.Leaderboard, error)
+	GetUserStanding(board *models.Leaderboard, userID string) (*models.LeaderboardEntry, error)
+}
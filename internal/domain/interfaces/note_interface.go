@@ -0,0 +1,10 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type NoteRepository interface {
+	UpsertNote(note *models.Note) error
+	FetchNote(userID, questionID string) (*models.Note, error)
+	FetchNotes(userID string) (*[]models.Note, error)
+	DeleteNote(userID, questionID string) error
+}
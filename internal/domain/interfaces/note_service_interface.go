@@ -0,0 +1,11 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type NoteService interface {
+	GetNote(userID, questionID string) (*models.Note, error)
+	GetNotes(userID string) (*[]models.Note, error)
+	SaveNote(userID, questionID, body string) (*models.Note, error)
+	DeleteNote(userID, questionID string) error
+	SearchNotes(userID, query string) (*[]models.NoteMatch, error)
+}
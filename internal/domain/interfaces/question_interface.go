@@ -5,9 +5,12 @@ import "cli-project/internal/domain/models"
 type QuestionRepository interface {
 	AddQuestionsByID(*[]string) error
 	AddQuestions(*[]models.Question) error
+	UpsertQuestions(*[]models.Question) error
 	RemoveQuestionByID(string) error
+	UpdateQuestion(*models.Question) error
 	FetchQuestionByID(string) (*models.Question, error)
 	FetchAllQuestions() (*[]models.Question, error)
+	FetchQuestionsPage(page models.PageRequest, excludeIDs []string) (*models.QuestionPage, error)
 	FetchQuestionsByFilters(string, string, string) (*[]models.Question, error)
 	QuestionExists(string) (bool, error)
 	CountQuestions() (int64, error)
@@ -2,14 +2,22 @@ package interfaces
 
 import (
 	"cli-project/internal/domain/models"
+	"io"
 )
 
 type QuestionService interface {
-	AddQuestionsFromFile(questionFilePath string) (bool, error)
-	RemoveQuestionByID(questionID string) error
+	AddQuestionsFromFile(actorID, questionFilePath string) (bool, error)
+	ImportQuestionsFromFile(actorID, questionFilePath string, dryRun bool) (*models.ImportReport, error)
+	ExportQuestions(actorID string, writer io.Writer, format, difficulty, company, topic string) (int, error)
+	RemoveQuestionByID(actorID, questionID string) error
+	UpdateQuestion(actorID, questionID string, update models.QuestionUpdate) (*models.Question, error)
 	GetQuestionByID(questionID string) (*models.Question, error)
 	GetAllQuestions() (*[]models.Question, error)
+	GetQuestionsPage(request models.PageRequest, excludeIDs []string) (*models.QuestionPage, error)
+	GetCompletionByDifficulty(solvedQuestionIDs []string) (*[]models.DifficultyCompletion, error)
 	GetQuestionsByFilters(difficulty, company, topic string) (*[]models.Question, error)
+	FilterQuestions(filter models.QuestionFilter) (*[]models.Question, error)
+	SearchQuestions(query string, limit int) (*[]models.SearchResult, error)
 	QuestionExists(questionID string) (bool, error)
 	GetTotalQuestionsCount() (int64, error)
 }
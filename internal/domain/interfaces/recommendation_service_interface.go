@@ -0,0 +1,7 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type RecommendationService interface {
+	RecommendQuestions(userID string, options models.RecommendationOptions) (*models.RecommendationReport, error)
+}
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"cli-project/internal/domain/models"
+	"time"
+)
+
+type ReviewRepository interface {
+	UpsertReviewCard(card *models.ReviewCard) error
+	FetchReviewCard(userID, questionID string) (*models.ReviewCard, error)
+	FetchDueReviewCards(userID string, dueBefore time.Time) (*[]models.ReviewCard, error)
+}
@@ -0,0 +1,8 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type ReviewService interface {
+	RecordReview(userID, questionID string, rating int) (*models.ReviewCard, error)
+	GetDueReviews(userID string) (*[]models.ReviewCard, error)
+}
@@ -0,0 +1,9 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type SessionRepository interface {
+	CreateSession(*models.Session) error
+	FetchSessionByID(string) (*models.Session, error)
+	RevokeSession(string) error
+}
@@ -0,0 +1,9 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type SessionService interface {
+	CreateSession(user *models.StandardUser) (*models.Session, error)
+	ValidateSession(token string) (*models.Session, error)
+	RevokeSession(session *models.Session) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+// StorageDriver hands out the repositories backed by a single storage engine.
+type StorageDriver interface {
+	Name() string
+	UserRepository() UserRepository
+	QuestionRepository() QuestionRepository
+	LeaderboardRepository() LeaderboardRepository
+	SessionRepository() SessionRepository
+	ReviewRepository() ReviewRepository
+	StudyListRepository() StudyListRepository
+	NoteRepository() NoteRepository
+	AnnouncementRepository() AnnouncementRepository
+	AuditRepository() AuditRepository
+	BanRepository() BanRepository
+	Close() error
+}
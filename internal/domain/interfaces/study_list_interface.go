@@ -0,0 +1,11 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type StudyListRepository interface {
+	CreateStudyList(list *models.StudyList) error
+	FetchStudyListByID(listID string) (*models.StudyList, error)
+	FetchVisibleStudyLists(userID string) (*[]models.StudyList, error)
+	UpdateStudyList(list *models.StudyList) error
+	DeleteStudyList(listID string) error
+}
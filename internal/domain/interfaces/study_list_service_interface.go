@@ -0,0 +1,14 @@
+package interfaces
+
+import "cli-project/internal/domain/models"
+
+type StudyListService interface {
+	CreateStudyList(userID, name, description string, shared bool) (*models.StudyList, error)
+	GetStudyLists(userID string) (*[]models.StudyList, error)
+	GetStudyListProgress(userID, listID string) (*models.StudyListProgress, error)
+	AddQuestionToList(userID, listID, questionID string, position int) error
+	RemoveQuestionFromList(userID, listID, questionID string) error
+	MoveQuestionInList(userID, listID, questionID string, position int) error
+	SetStudyListShared(userID, listID string, shared bool) error
+	DeleteStudyList(userID, listID string) error
+}
@@ -4,13 +4,17 @@ import "cli-project/internal/domain/models"
 
 type UserRepository interface {
 	CreateUser(*models.StandardUser) error
-	UpdateUserProgress(questionID string) error
+	UpdateUserProgress(userID string, event *models.SolveEvent) error
+	MigrateSolveHistory() (int64, error)
 	FetchAllUsers() (*[]models.StandardUser, error)
+	FetchUsersPage(role string, page models.PageRequest) (*models.UserPage, error)
 	FetchUserByID(string) (*models.StandardUser, error)
 	FetchUserByUsername(string) (*models.StandardUser, error)
 	UpdateUserDetails(*models.StandardUser) error
 	BanUser(string) error
 	UnbanUser(string) error
+	UpdateUserRole(userID, role string) error
+	CountUsersByRole(role string) (int64, error)
 	CountActiveUsersInLast24Hours() (int64, error)
 	IsUsernameUnique(string) (bool, error)
 	IsEmailUnique(string) (bool, error)
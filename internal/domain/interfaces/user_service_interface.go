@@ -2,22 +2,40 @@ package interfaces
 
 import (
 	"cli-project/internal/domain/models"
+	"io"
+	"time"
 )
 
 type UserService interface {
 	Signup(user *models.StandardUser) error
-	Login(username, password string) error
-	Logout() error
+	BootstrapAdmin(user *models.StandardUser) error
+	Login(username, password string) (*models.Session, error)
+	ResumeSession(token string) (*models.Session, error)
+	Logout(session *models.Session) error
 	GetAllUsers() (*[]models.StandardUser, error)
-	ViewDashboard() error
-	UpdateUserProgress(solvedQuestionID string) (bool, error)
-	CountActiveUserInLast24Hours() (int64, error)
+	GetUsersPage(role string, request models.PageRequest) (*models.UserPage, error)
+	ViewDashboard(userID string) error
+	UpdateUserProgress(userID string, event models.SolveEvent) (bool, error)
+	GetSolveHistory(userID string) (*[]models.SolveEvent, error)
+	GetDailyActivity(userID string, days int) (*[]models.DailyActivity, error)
+	GetStreaks(userID string) (*models.Streaks, error)
+	CountActiveUserInLast24Hours(actorID string) (int64, error)
 	GetUserByUsername(username string) (*models.StandardUser, error)
 	GetUserByID(userID string) (*models.StandardUser, error)
 	GetUserRole(userID string) (string, error)
 	GetUserID(username string) (string, error)
-	BanUser(username string) (bool, error)
-	UnbanUser(username string) (bool, error)
+	BanUser(actorID, username, reason string, expiresAt time.Time) (bool, error)
+	UnbanUser(actorID, username string) (bool, error)
+	PromoteUser(actorID, username string) (bool, error)
+	DemoteUser(actorID, username string) (bool, error)
+	ChangeUserRole(actorID, username, role string) (bool, error)
 	IsUserBanned(userID string) (bool, error)
+	GetActiveBan(userID string) (*models.Ban, error)
+	SubmitBanAppeal(userID, message string) (*models.Ban, error)
+	GetBanAppeals(actorID, status string) (*[]models.Ban, error)
+	ResolveBanAppeal(actorID, banID string, accept bool, response string) (*models.Ban, error)
 	GetLeetcodeStats(userID string) (*models.LeetcodeStats, error)
+	SyncLeetcodeProgress(userID string) (*models.SyncReport, error)
+	ExportProgress(userID string, writer io.Writer) error
+	ImportProgress(userID string, reader io.Reader) (*models.ProgressImportReport, error)
 }
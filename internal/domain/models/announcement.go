@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+// Audiences an announcement can be shown to
+const (
+	AudienceEveryone     = "everyone"
+	AudienceOrganisation = "organisation"
+	AudienceCountry      = "country"
+)
+
+// Announcement is a message from the admins, shown to the users of its audience after they log in.
+// Target is the organisation or country for those audiences, and a zero ExpiresAt never expires.
+type Announcement struct {
+	ID         string        `bson:"id"`
+	Title      string        `bson:"title"`
+	Body       string        `bson:"body"`
+	Audience   string        `bson:"audience"`
+	Target     string        `bson:"target"`
+	Pinned     bool          `bson:"pinned"`
+	AuthorID   string        `bson:"author_id"`
+	AuthorName string        `bson:"author_name"`
+	CreatedAt  time.Time     `bson:"created_at"`
+	UpdatedAt  time.Time     `bson:"updated_at"`
+	ExpiresAt  time.Time     `bson:"expires_at"`
+	Reads      []ReadReceipt `bson:"reads"`
+}
+
+// ReadReceipt records when a user first read an announcement
+type ReadReceipt struct {
+	UserID   string    `bson:"user_id"`
+	Username string    `bson:"username"`
+	ReadAt   time.Time `bson:"read_at"`
+}
+
+// AnnouncementDraft holds the parts of an announcement an admin writes
+type AnnouncementDraft struct {
+	Title     string
+	Body      string
+	Audience  string
+	Target    string
+	Pinned    bool
+	ExpiresAt time.Time
+}
+
+// UserAnnouncement is an announcement as seen by one user
+type UserAnnouncement struct {
+	Announcement Announcement
+	Read         bool
+}
@@ -0,0 +1,46 @@
+package models
+
+import "time"
+
+// Privileged actions recorded in the audit log, named <target kind>.<verb>
+const (
+	AuditQuestionAdd    = "question.add"
+	AuditQuestionImport = "question.import"
+	AuditQuestionUpdate = "question.update"
+	AuditQuestionRemove = "question.remove"
+	AuditUserBan        = "user.ban"
+	AuditUserUnban      = "user.unban"
+	AuditUserAppeal     = "user.appeal"
+	AuditUserPromote    = "user.promote"
+	AuditUserDemote     = "user.demote"
+	AuditUserBootstrap  = "user.bootstrap"
+)
+
+// AuditEntry records one privileged action. Before and After hold the JSON of the target
+// before and after the action, and are empty when there was nothing on that side.
+type AuditEntry struct {
+	ID        string    `bson:"id"`
+	ActorID   string    `bson:"actor_id"`
+	ActorName string    `bson:"actor_name"`
+	Action    string    `bson:"action"`
+	Target    string    `bson:"target"`
+	Before    string    `bson:"before"`
+	After     string    `bson:"after"`
+	Timestamp time.Time `bson:"timestamp"`
+}
+
+// AuditFilter narrows the audit log, empty fields match every entry.
+// Actor matches the actor's ID or username, and Action matches an action or a whole kind such as "user".
+type AuditFilter struct {
+	Actor  string
+	Action string
+	Target string
+	Since  time.Time
+	Until  time.Time
+}
+
+// AuditPage is one page of the audit log, newest entries first
+type AuditPage struct {
+	Entries []AuditEntry
+	PageInfo
+}
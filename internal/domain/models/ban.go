@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Statuses of an appeal against a ban
+const (
+	AppealPending  = "pending"
+	AppealAccepted = "accepted"
+	AppealRejected = "rejected"
+)
+
+// Ban records why and by whom a user was banned. A zero ExpiresAt never expires, and LiftedAt is
+// set once the ban ends, by an admin (LiftedBy) or by expiring (LiftedBy is empty).
+type Ban struct {
+	ID           string     `bson:"id" json:"id"`
+	UserID       string     `bson:"user_id" json:"user_id"`
+	Username     string     `bson:"username" json:"username"`
+	Reason       string     `bson:"reason" json:"reason"`
+	IssuedBy     string     `bson:"issued_by" json:"issued_by"`
+	IssuedByName string     `bson:"issued_by_name" json:"issued_by_name"`
+	StartedAt    time.Time  `bson:"started_at" json:"started_at"`
+	ExpiresAt    time.Time  `bson:"expires_at" json:"expires_at"`
+	LiftedAt     time.Time  `bson:"lifted_at" json:"lifted_at"`
+	LiftedBy     string     `bson:"lifted_by" json:"lifted_by"`
+	Appeal       *BanAppeal `bson:"appeal" json:"appeal"`
+}
+
+// BanAppeal is the one appeal a banned user can make against a ban, and how an admin resolved it
+type BanAppeal struct {
+	Message        string    `bson:"message" json:"message"`
+	SubmittedAt    time.Time `bson:"submitted_at" json:"submitted_at"`
+	Status         string    `bson:"status" json:"status"`
+	Response       string    `bson:"response" json:"response"`
+	ResolvedBy     string    `bson:"resolved_by" json:"resolved_by"`
+	ResolvedByName string    `bson:"resolved_by_name" json:"resolved_by_name"`
+	ResolvedAt     time.Time `bson:"resolved_at" json:"resolved_at"`
+}
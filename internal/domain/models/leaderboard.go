@@ -1,5 +1,29 @@
 package models
 
+import "time"
+
+const (
+	GlobalScope       = "global"
+	OrganisationScope = "organisation"
+	CountryScope      = "country"
+)
+
+type LeaderboardEntry struct {
+	Rank         int    `bson:"-"`
+	UserID       string `bson:"user_id"`
+	Username     string `bson:"username"`
+	Name         string `bson:"name"`
+	Organisation string `bson:"organisation"`
+	Country      string `bson:"country"`
+	EasySolved   int    `bson:"easy_solved"`
+	MediumSolved int    `bson:"medium_solved"`
+	HardSolved   int    `bson:"hard_solved"`
+	Score        int    `bson:"-"`
+}
+
 type Leaderboard struct {
-	topUsers []User
+	Scope       string
+	ScopeValue  string
+	Entries     []LeaderboardEntry
+	GeneratedAt time.Time
 }
@@ -1,13 +1,13 @@
 package models
 
 type LeetcodeStats struct {
-	TotalQuestionsCount     int
-	TotalQuestionsDoneCount int
-	TotalEasyCount          int
-	TotalMediumCount        int
-	TotalHardCount          int
-	EasyDoneCount           int
-	MediumDoneCount         int
-	HardDoneCount           int
-	RecentACSubmissions     []string `bson:"recent_ac_submissions"`
+	TotalQuestionsCount     int      `json:"total_questions_count"`
+	TotalQuestionsDoneCount int      `json:"total_questions_done_count"`
+	TotalEasyCount          int      `json:"total_easy_count"`
+	TotalMediumCount        int      `json:"total_medium_count"`
+	TotalHardCount          int      `json:"total_hard_count"`
+	EasyDoneCount           int      `json:"easy_done_count"`
+	MediumDoneCount         int      `json:"medium_done_count"`
+	HardDoneCount           int      `json:"hard_done_count"`
+	RecentACSubmissions     []string `bson:"recent_ac_submissions" json:"recent_ac_submissions"`
 }
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// LeetcodeSubmission is one of the user's recent accepted submissions on Leetcode
+type LeetcodeSubmission struct {
+	Title       string
+	TitleSlug   string
+	Language    string
+	SubmittedAt time.Time
+}
+
+// SyncedSolve is a submission that matched a question in the bank and was recorded as a solve
+type SyncedSolve struct {
+	QuestionID    string
+	QuestionTitle string
+	SolvedAt      time.Time
+	FirstSolve    bool
+}
+
+// SyncReport describes what a Leetcode sync recorded
+type SyncReport struct {
+	Added     []SyncedSolve
+	Skipped   int // submissions already recorded by an earlier sync
+	Unmatched []LeetcodeSubmission
+}
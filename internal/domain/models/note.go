@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// Note is a user's Markdown notes on one question, such as the approach, complexity and pitfalls.
+// Snippets are the fenced code blocks of the body, kept with their language tag.
+type Note struct {
+	UserID     string        `bson:"user_id" json:"-"`
+	QuestionID string        `bson:"question_id" json:"question_id"`
+	Body       string        `bson:"body" json:"body"`
+	Snippets   []CodeSnippet `bson:"snippets" json:"snippets"`
+	CreatedAt  time.Time     `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time     `bson:"updated_at" json:"updated_at"`
+}
+
+// CodeSnippet is a fenced code block in a note, Language is empty when the fence has no tag
+type CodeSnippet struct {
+	Language string `bson:"language" json:"language"`
+	Code     string `bson:"code" json:"code"`
+}
+
+// NoteMatch is a note matching a search, Excerpt is the first line containing a searched word
+type NoteMatch struct {
+	Note    Note
+	Excerpt string
+}
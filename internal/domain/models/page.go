@@ -0,0 +1,38 @@
+package models
+
+// Fields a question or user listing can be sorted by
+const (
+	SortByID         = "id"
+	SortByTitle      = "title"
+	SortByDifficulty = "difficulty"
+	SortByUsername   = "username"
+	SortByLastSeen   = "last_seen"
+)
+
+// PageRequest asks for one page of a listing, pages are numbered from 1
+type PageRequest struct {
+	Page       int
+	PageSize   int
+	SortBy     string
+	Descending bool
+}
+
+// PageInfo describes the page a listing returned, Total counts the items on every page
+type PageInfo struct {
+	Page       int
+	PageSize   int
+	Total      int64
+	TotalPages int
+}
+
+// QuestionPage is one page of the question bank
+type QuestionPage struct {
+	Questions []Question
+	PageInfo
+}
+
+// UserPage is one page of the user list
+type UserPage struct {
+	Users []StandardUser
+	PageInfo
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// ProgressExportVersion is bumped whenever the export format changes in a way older importers cannot read
+const ProgressExportVersion = 1
+
+// ProgressExport is a user's progress in a form that can be moved to another CodeSage instance
+type ProgressExport struct {
+	Version       int             `json:"version"`
+	ExportedAt    time.Time       `json:"exported_at"`
+	Profile       ExportedProfile `json:"profile"`
+	Solves        []ExportedSolve `json:"solves"`
+	LeetcodeStats *LeetcodeStats  `json:"leetcode_stats,omitempty"`
+}
+
+type ExportedProfile struct {
+	Username     string `json:"username"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	Organisation string `json:"organisation"`
+	Country      string `json:"country"`
+	LeetcodeID   string `json:"leetcode_id"`
+}
+
+// ExportedSolve carries the question link and title so the importer can tell whether
+// the question ID means the same question in its own bank
+type ExportedSolve struct {
+	QuestionID       string    `json:"question_id"`
+	QuestionTitle    string    `json:"question_title"`
+	QuestionLink     string    `json:"question_link"`
+	SolvedAt         time.Time `json:"solved_at"`
+	TimeTakenMinutes int       `json:"time_taken_minutes,omitempty"`
+	Language         string    `json:"language,omitempty"`
+	Notes            string    `json:"notes,omitempty"`
+}
+
+// ProgressImportReport lists what an import added and what it left out
+type ProgressImportReport struct {
+	Added     []SyncedSolve
+	Skipped   int
+	Unmatched []string
+	Conflicts []string
+}
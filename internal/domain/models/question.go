@@ -1,10 +1,61 @@
 package models
 
 type Question struct {
-	QuestionID    string   `bson:"question_id"`
-	QuestionTitle string   `bson:"question_title"`
-	Difficulty    string   `bson:"difficulty"`
-	QuestionLink  string   `bson:"question_link"`
-	TopicTags     []string `bson:"topic_tags"`
-	CompanyTags   []string `bson:"company_tags"`
+	QuestionID    string   `bson:"question_id" json:"question_id"`
+	QuestionTitle string   `bson:"question_title" json:"question_title"`
+	Difficulty    string   `bson:"difficulty" json:"difficulty"`
+	QuestionLink  string   `bson:"question_link" json:"question_link"`
+	TopicTags     []string `bson:"topic_tags" json:"topic_tags"`
+	CompanyTags   []string `bson:"company_tags" json:"company_tags"`
+}
+
+// QuestionUpdate holds the fields an admin wants to change, nil fields are left as they are
+type QuestionUpdate struct {
+	QuestionTitle *string
+	Difficulty    *string
+	QuestionLink  *string
+	TopicTags     *[]string
+	CompanyTags   *[]string
+}
+
+// Formats the question bank can be exported to
+const (
+	ExportFormatCSV      = "csv"
+	ExportFormatJSON     = "json"
+	ExportFormatMarkdown = "markdown"
+)
+
+// SearchResult is a question matching a search, MatchedOn names the field that matched best
+type SearchResult struct {
+	Question  Question
+	Score     float64
+	MatchedOn string
+}
+
+// Solve statuses a question filter can ask for
+const (
+	SolveStatusSolved   = "solved"
+	SolveStatusUnsolved = "unsolved"
+)
+
+// QuestionFilter selects questions for the question browser, empty fields match every question.
+// Topics and Companies match a question tagged with any of them, or with all of them when the
+// matching MatchAll flag is set. Status needs the IDs of the questions the user has solved.
+type QuestionFilter struct {
+	Difficulties      []string
+	Topics            []string
+	MatchAllTopics    bool
+	Companies         []string
+	MatchAllCompanies bool
+	ExcludeTopics     []string
+	ExcludeCompanies  []string
+	Status            string
+	SolvedQuestionIDs []string
+}
+
+// DifficultyCompletion is how many of the questions of one difficulty a user has solved
+type DifficultyCompletion struct {
+	Difficulty string
+	Solved     int
+	Total      int
 }
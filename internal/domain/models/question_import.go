@@ -0,0 +1,32 @@
+package models
+
+// Statuses of a row in a question import
+const (
+	ImportRowNew       = "new"
+	ImportRowChanged   = "changed"
+	ImportRowUnchanged = "unchanged"
+	ImportRowInvalid   = "invalid"
+)
+
+// ImportRow is the outcome for one CSV row, Row counts from 1 with the header as row 1.
+// Previous is the stored question a changed row overwrites.
+type ImportRow struct {
+	Row        int
+	QuestionID string
+	Status     string
+	Reason     string
+	Changes    []string
+	Question   Question
+	Previous   *Question
+}
+
+// ImportReport is the diff between a CSV file and the question bank.
+// Applied is false for a dry run or when no row was new or changed.
+type ImportReport struct {
+	Rows      []ImportRow
+	New       int
+	Changed   int
+	Unchanged int
+	Invalid   int
+	Applied   bool
+}
@@ -0,0 +1,37 @@
+package models
+
+// Difficulty progressions for recommendations, gradual moves up a level once enough of the current one is solved
+const (
+	ProgressionGradual = "gradual"
+	ProgressionEasy    = "easy"
+	ProgressionMedium  = "medium"
+	ProgressionHard    = "hard"
+)
+
+// RecommendationOptions describes what the user is preparing for
+type RecommendationOptions struct {
+	TargetCompany string
+	Progression   string
+	Limit         int
+}
+
+// TagCoverage is how much of the bank's questions with a tag the user has solved
+type TagCoverage struct {
+	Tag    string
+	Solved int
+	Total  int
+}
+
+// Recommendation is an unsolved question with the reasons it was picked
+type Recommendation struct {
+	Question Question
+	Score    float64
+	Reasons  []string
+}
+
+// RecommendationReport is the answer to "what should I solve next?"
+type RecommendationReport struct {
+	Level           string
+	WeakTopics      []TagCoverage
+	Recommendations []Recommendation
+}
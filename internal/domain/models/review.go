@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// Recall ratings on the SM-2 quality scale, anything below RecallHard counts as forgotten
+const (
+	RecallAgain = 1
+	RecallHard  = 3
+	RecallGood  = 4
+	RecallEasy  = 5
+)
+
+// ReviewCard is the spaced repetition state of one solved question for one user
+type ReviewCard struct {
+	ID           string    `bson:"id"`
+	UserID       string    `bson:"user_id"`
+	QuestionID   string    `bson:"question_id"`
+	EaseFactor   float64   `bson:"ease_factor"`
+	Interval     int       `bson:"interval"`
+	Repetitions  int       `bson:"repetitions"`
+	LastRating   int       `bson:"last_rating"`
+	LastReviewed time.Time `bson:"last_reviewed"`
+	DueAt        time.Time `bson:"due_at"`
+}
@@ -0,0 +1,13 @@
+package models
+
+import "time"
+
+type Session struct {
+	ID        string    `bson:"id"`
+	UserID    string    `bson:"user_id"`
+	Username  string    `bson:"username"`
+	IssuedAt  time.Time `bson:"issued_at"`
+	ExpiresAt time.Time `bson:"expires_at"`
+	Revoked   bool      `bson:"revoked"`
+	Token     string    `bson:"-"`
+}
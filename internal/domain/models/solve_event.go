@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// SolveEvent records one solve of a question, a question solved again later gets another event.
+// Events migrated from the old questions_solved list have no SolvedAt as the time was never stored.
+type SolveEvent struct {
+	QuestionID string        `bson:"question_id"`
+	SolvedAt   time.Time     `bson:"solved_at"`
+	TimeTaken  time.Duration `bson:"time_taken,omitempty"`
+	Language   string        `bson:"language,omitempty"`
+	Notes      string        `bson:"notes,omitempty"`
+}
+
+// DailyActivity groups the solves made on one calendar day
+type DailyActivity struct {
+	Date      time.Time
+	Solves    int
+	Questions []string
+}
+
+// Streaks summarises runs of consecutive days with at least one solve
+type Streaks struct {
+	Current      int
+	Longest      int
+	LongestStart time.Time
+	LongestEnd   time.Time
+	LastSolved   time.Time
+}
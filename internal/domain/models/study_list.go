@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// StudyList is a named, ordered set of questions such as "Blind 75". Private lists are only
+// visible to their owner, shared lists to everyone.
+type StudyList struct {
+	ID          string    `bson:"id"`
+	Name        string    `bson:"name"`
+	Description string    `bson:"description"`
+	OwnerID     string    `bson:"owner_id"`
+	OwnerName   string    `bson:"owner_name"`
+	Shared      bool      `bson:"shared"`
+	QuestionIDs []string  `bson:"question_ids"`
+	CreatedAt   time.Time `bson:"created_at"`
+	UpdatedAt   time.Time `bson:"updated_at"`
+}
+
+// StudyListItem is one question of a list with the user's progress on it.
+// Missing is set when the question has since been removed from the bank.
+type StudyListItem struct {
+	Position int
+	Question Question
+	Solved   bool
+	Missing  bool
+}
+
+// StudyListProgress is a list as seen by one user
+type StudyListProgress struct {
+	List    StudyList
+	Items   []StudyListItem
+	Solved  int
+	Total   int
+	CanEdit bool
+}
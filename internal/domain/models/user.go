@@ -19,8 +19,9 @@ type Admin struct {
 }
 
 type StandardUser struct {
-	StandardUser    User      `bson:",inline"`
-	LeetcodeID      string    `bson:"Leetcode_id"`
-	QuestionsSolved []string  `bson:"questions_solved"`
-	LastSeen        time.Time `bson:"last_seen"`
+	StandardUser    User         `bson:",inline"`
+	LeetcodeID      string       `bson:"Leetcode_id"`
+	QuestionsSolved []string     `bson:"questions_solved"`
+	SolveHistory    []SolveEvent `bson:"solve_history"`
+	LastSeen        time.Time    `bson:"last_seen"`
 }
@@ -0,0 +1,244 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"go.mongodb.org/mongo-driver/mongo"
+	"net/http"
+	"time"
+)
+
+func (s *Server) handlePlatformStats(w http.ResponseWriter, r *http.Request) {
+	activeUsers, err := s.userService.CountActiveUserInLast24Hours(userFrom(r).StandardUser.ID)
+	if errors.Is(err, services.ErrPermissionDenied) {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error fetching active users count: "+err.Error())
+		return
+	}
+
+	totalQuestions, err := s.questionService.GetTotalQuestionsCount()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "error fetching total questions count: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, platformStatsResponse{
+		ActiveUsersLast24Hours: activeUsers,
+		TotalQuestions:         totalQuestions,
+	})
+}
+
+func (s *Server) handleUpdateQuestion(w http.ResponseWriter, r *http.Request) {
+	questionID := data_cleaning.CleanString(r.PathValue("id"))
+	if valid, err := validation.ValidateQuestionID(questionID); !valid {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var req updateQuestionRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch question: "+err.Error())
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "question with ID "+questionID+" not found")
+		return
+	}
+
+	question, err := s.questionService.UpdateQuestion(userFrom(r).StandardUser.ID, questionID, models.QuestionUpdate{
+		QuestionTitle: req.QuestionTitle,
+		Difficulty:    req.Difficulty,
+		QuestionLink:  req.QuestionLink,
+		TopicTags:     req.TopicTags,
+		CompanyTags:   req.CompanyTags,
+	})
+	if errors.Is(err, services.ErrPermissionDenied) {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, services.ErrAuditFailed) {
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	if err != nil {
+		// The question exists, so what is left are invalid fields
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newQuestionResponse(*question))
+}
+
+func (s *Server) handleBanUser(w http.ResponseWriter, r *http.Request) {
+	username := data_cleaning.CleanString(r.PathValue("username"))
+
+	if username == userFrom(r).StandardUser.Username {
+		writeError(w, http.StatusBadRequest, "you cannot ban yourself")
+		return
+	}
+
+	var req banRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	alreadyBanned, err := s.userService.BanUser(userFrom(r).StandardUser.ID, username, req.Reason, req.ExpiresAt)
+	if err != nil {
+		writeBanError(w, username, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, banResponse{Username: username, Banned: true, Changed: !alreadyBanned})
+}
+
+func (s *Server) handleUnbanUser(w http.ResponseWriter, r *http.Request) {
+	username := data_cleaning.CleanString(r.PathValue("username"))
+
+	alreadyUnbanned, err := s.userService.UnbanUser(userFrom(r).StandardUser.ID, username)
+	if err != nil {
+		writeBanError(w, username, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, banResponse{Username: username, Banned: false, Changed: !alreadyUnbanned})
+}
+
+func (s *Server) handlePromoteUser(w http.ResponseWriter, r *http.Request) {
+	username := data_cleaning.CleanString(r.PathValue("username"))
+
+	alreadyAdmin, err := s.userService.PromoteUser(userFrom(r).StandardUser.ID, username)
+	if err != nil {
+		writeRoleError(w, username, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, roleResponse{Username: username, Role: roles.ADMIN, Changed: !alreadyAdmin})
+}
+
+func (s *Server) handleDemoteUser(w http.ResponseWriter, r *http.Request) {
+	username := data_cleaning.CleanString(r.PathValue("username"))
+
+	alreadyUser, err := s.userService.DemoteUser(userFrom(r).StandardUser.ID, username)
+	if err != nil {
+		writeRoleError(w, username, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, roleResponse{Username: username, Role: roles.USER, Changed: !alreadyUser})
+}
+
+func (s *Server) handleChangeUserRole(w http.ResponseWriter, r *http.Request) {
+	username := data_cleaning.CleanString(r.PathValue("username"))
+
+	var req roleRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	role := data_cleaning.CleanString(req.Role)
+	alreadyHad, err := s.userService.ChangeUserRole(userFrom(r).StandardUser.ID, username, role)
+	if err != nil {
+		writeRoleError(w, username, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, roleResponse{Username: username, Role: role, Changed: !alreadyHad})
+}
+
+func writeRoleError(w http.ResponseWriter, username string, err error) {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		writeError(w, http.StatusNotFound, "user "+username+" not found")
+	case errors.Is(err, services.ErrPermissionDenied):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, services.ErrInvalidRole):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, services.ErrLastAdmin):
+		writeError(w, http.StatusConflict, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "could not change role: "+err.Error())
+	}
+}
+
+func (s *Server) handleListBanAppeals(w http.ResponseWriter, r *http.Request) {
+	bans, err := s.userService.GetBanAppeals(userFrom(r).StandardUser.ID, r.URL.Query().Get("status"))
+	if errors.Is(err, services.ErrPermissionDenied) {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch ban appeals: "+err.Error())
+		return
+	}
+
+	response := make([]banAppealResponse, 0, len(*bans))
+	for _, ban := range *bans {
+		response = append(response, newBanAppealResponse(ban))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleResolveBanAppeal(w http.ResponseWriter, r *http.Request) {
+	var req resolveAppealRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ban, err := s.userService.ResolveBanAppeal(userFrom(r).StandardUser.ID, r.PathValue("id"), req.Accept, req.Response)
+	switch {
+	case errors.Is(err, services.ErrPermissionDenied):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, services.ErrBanNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrNoPendingAppeal):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, services.ErrAppealTooLong):
+		writeError(w, http.StatusBadRequest, err.Error())
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, "could not resolve appeal: "+err.Error())
+	default:
+		writeJSON(w, http.StatusOK, newBanAppealResponse(*ban))
+	}
+}
+
+// bannedMessage is the 403 message for a banned user, with the reason and expiry when the ban has them
+func (s *Server) bannedMessage(userID string) string {
+	ban, err := s.userService.GetActiveBan(userID)
+	if err != nil {
+		return "you are banned from the platform"
+	}
+	if ban.ExpiresAt.IsZero() {
+		return "you are banned from the platform: " + ban.Reason
+	}
+	return "you are banned from the platform until " + ban.ExpiresAt.Format(time.RFC3339) + ": " + ban.Reason
+}
+
+func writeBanError(w http.ResponseWriter, username string, err error) {
+	switch {
+	case errors.Is(err, mongo.ErrNoDocuments):
+		writeError(w, http.StatusNotFound, "user "+username+" not found")
+	case errors.Is(err, services.ErrPermissionDenied):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, services.ErrBanReasonRequired), errors.Is(err, services.ErrBanReasonTooLong),
+		errors.Is(err, services.ErrBanExpiryInPast):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "could not update ban status: "+err.Error())
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"errors"
+	"net/http"
+)
+
+// handleListAnnouncements returns the announcements addressed to the caller that have not expired
+func (s *Server) handleListAnnouncements(w http.ResponseWriter, r *http.Request) {
+	announcements, err := s.announcements.GetUserAnnouncements(userFrom(r).StandardUser.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch announcements: "+err.Error())
+		return
+	}
+
+	response := make([]userAnnouncementResponse, 0, len(*announcements))
+	for _, announcement := range *announcements {
+		response = append(response, userAnnouncementResponse{
+			announcementResponse: newAnnouncementResponse(announcement.Announcement),
+			Read:                 announcement.Read,
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleReadAnnouncement(w http.ResponseWriter, r *http.Request) {
+	if err := s.announcements.MarkAnnouncementRead(userFrom(r).StandardUser.ID, r.PathValue("id")); err != nil {
+		writeAnnouncementError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// handleAdminListAnnouncements returns every announcement with its read receipts, expired ones included
+func (s *Server) handleAdminListAnnouncements(w http.ResponseWriter, r *http.Request) {
+	announcements, err := s.announcements.GetAllAnnouncements()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch announcements: "+err.Error())
+		return
+	}
+
+	response := make([]adminAnnouncementResponse, 0, len(*announcements))
+	for _, announcement := range *announcements {
+		response = append(response, newAdminAnnouncementResponse(announcement))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleCreateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	var req announcementRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	announcement, err := s.announcements.CreateAnnouncement(userFrom(r).StandardUser.ID, req.draft())
+	if err != nil {
+		writeAnnouncementError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, newAdminAnnouncementResponse(*announcement))
+}
+
+func (s *Server) handleUpdateAnnouncement(w http.ResponseWriter, r *http.Request) {
+	var req announcementRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	announcement, err := s.announcements.UpdateAnnouncement(userFrom(r).StandardUser.ID, r.PathValue("id"), req.draft())
+	if err != nil {
+		writeAnnouncementError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newAdminAnnouncementResponse(*announcement))
+}
+
+func (s *Server) handleExpireAnnouncement(w http.ResponseWriter, r *http.Request) {
+	if err := s.announcements.ExpireAnnouncement(userFrom(r).StandardUser.ID, r.PathValue("id")); err != nil {
+		writeAnnouncementError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (req announcementRequest) draft() models.AnnouncementDraft {
+	return models.AnnouncementDraft{
+		Title:     req.Title,
+		Body:      req.Body,
+		Audience:  req.Audience,
+		Target:    req.Target,
+		Pinned:    req.Pinned,
+		ExpiresAt: req.ExpiresAt,
+	}
+}
+
+func writeAnnouncementError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrAnnouncementNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrPermissionDenied):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, services.ErrEmptyAnnouncement), errors.Is(err, services.ErrAnnouncementTooLong),
+		errors.Is(err, services.ErrMissingAudienceTarget), errors.Is(err, services.ErrExpiryInPast),
+		errors.Is(err, services.ErrInvalidAudience):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "could not update announcement: "+err.Error())
+	}
+}
+
+func newAnnouncementResponse(announcement models.Announcement) announcementResponse {
+	return announcementResponse{
+		ID:         announcement.ID,
+		Title:      announcement.Title,
+		Body:       announcement.Body,
+		Audience:   announcement.Audience,
+		Target:     announcement.Target,
+		Pinned:     announcement.Pinned,
+		AuthorName: announcement.AuthorName,
+		CreatedAt:  announcement.CreatedAt,
+		UpdatedAt:  announcement.UpdatedAt,
+		ExpiresAt:  announcement.ExpiresAt,
+	}
+}
+
+func newAdminAnnouncementResponse(announcement models.Announcement) adminAnnouncementResponse {
+	reads := make([]readReceiptResponse, 0, len(announcement.Reads))
+	for _, read := range announcement.Reads {
+		reads = append(reads, readReceiptResponse{Username: read.Username, ReadAt: read.ReadAt})
+	}
+	return adminAnnouncementResponse{announcementResponse: newAnnouncementResponse(announcement), Reads: reads}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/validation"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+func (s *Server) handleAuditLog(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	since, until, err := validation.ValidateDateRange(query.Get("since"), query.Get("until"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter := models.AuditFilter{
+		Actor:  query.Get("actor"),
+		Action: query.Get("action"),
+		Target: query.Get("target"),
+		Since:  since,
+		Until:  until,
+	}
+
+	var request models.PageRequest
+	if value := query.Get("page"); value != "" {
+		if request.Page, err = strconv.Atoi(value); err != nil || request.Page < 1 {
+			writeError(w, http.StatusBadRequest, "page must be a positive number")
+			return
+		}
+	}
+	if value := query.Get("page_size"); value != "" {
+		if request.PageSize, err = strconv.Atoi(value); err != nil || request.PageSize < 1 {
+			writeError(w, http.StatusBadRequest, "page_size must be a positive number")
+			return
+		}
+	}
+
+	page, err := s.audit.GetAuditLog(userFrom(r).StandardUser.ID, filter, request)
+	if errors.Is(err, services.ErrPermissionDenied) {
+		writeError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	if errors.Is(err, services.ErrInvalidAuditPeriod) || errors.Is(err, services.ErrInvalidPage) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch audit log: "+err.Error())
+		return
+	}
+
+	response := auditPageResponse{
+		Entries:    make([]auditEntryResponse, 0, len(page.Entries)),
+		Page:       page.Page,
+		PageSize:   page.PageSize,
+		Total:      page.Total,
+		TotalPages: page.TotalPages,
+	}
+	for _, entry := range page.Entries {
+		response.Entries = append(response.Entries, auditEntryResponse{
+			ID:        entry.ID,
+			ActorID:   entry.ActorID,
+			ActorName: entry.ActorName,
+			Action:    entry.Action,
+			Target:    entry.Target,
+			Before:    auditSnapshotJSON(entry.Before),
+			After:     auditSnapshotJSON(entry.After),
+			Timestamp: entry.Timestamp,
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// auditSnapshotJSON embeds a stored snapshot as JSON, an empty one as null
+func auditSnapshotJSON(snapshot string) json.RawMessage {
+	if snapshot == "" {
+		return json.RawMessage("null")
+	}
+	return json.RawMessage(snapshot)
+}
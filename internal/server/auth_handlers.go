@@ -0,0 +1,159 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+func (s *Server) handleSignup(w http.ResponseWriter, r *http.Request) {
+	var req signupRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	user, status, err := s.validateSignup(req)
+	if err != nil {
+		writeError(w, status, err.Error())
+		return
+	}
+
+	if err := s.userService.Signup(user); err != nil {
+		writeError(w, http.StatusInternalServerError, "signup failed: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, newUserResponse(user))
+}
+
+// validateSignup applies the same checks as the signup page and returns the user to register
+func (s *Server) validateSignup(req signupRequest) (*models.StandardUser, int, error) {
+	username := data_cleaning.CleanString(req.Username)
+	if !validation.ValidateUsername(username) {
+		return nil, http.StatusBadRequest, errors.New("invalid username: it should be between 4 and 20 characters long, should not be only numbers and contain no spaces")
+	}
+	unique, err := s.authService.IsUsernameUnique(username)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New("error checking username uniqueness")
+	}
+	if !unique {
+		return nil, http.StatusConflict, errors.New("username already taken")
+	}
+
+	password := strings.TrimSpace(req.Password)
+	if !validation.ValidatePassword(password) {
+		return nil, http.StatusBadRequest, errors.New("invalid password: it must be at least 8 characters long and include at least 1 uppercase & lowercase letters, 1 digit, and 1 special character")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if !validation.ValidateName(name) {
+		return nil, http.StatusBadRequest, errors.New("invalid name: it should be 3 to 30 characters long and contain only letters and spaces")
+	}
+
+	email := data_cleaning.CleanString(req.Email)
+	if validFormat, validDomain := validation.ValidateEmail(email); !validFormat {
+		return nil, http.StatusBadRequest, errors.New("invalid email format")
+	} else if !validDomain {
+		return nil, http.StatusBadRequest, errors.New("invalid email domain: we only support gmail, outlook, yahoo, hotmail, icloud, watchguard emails")
+	}
+	unique, err = s.authService.IsEmailUnique(email)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New("error checking email uniqueness")
+	}
+	if !unique {
+		return nil, http.StatusConflict, errors.New("email already registered")
+	}
+
+	LeetcodeID := strings.TrimSpace(req.LeetcodeID)
+	unique, err = s.authService.IsLeetcodeIDUnique(LeetcodeID)
+	if err != nil {
+		return nil, http.StatusInternalServerError, errors.New("error checking Leetcode ID uniqueness")
+	}
+	if !unique {
+		return nil, http.StatusConflict, errors.New("leetcode ID is already taken")
+	}
+	exists, err := s.authService.ValidateLeetcodeUsername(LeetcodeID)
+	if err != nil {
+		return nil, http.StatusBadGateway, errors.New("error validating Leetcode username: " + err.Error())
+	}
+	if !exists {
+		return nil, http.StatusBadRequest, errors.New("leetcode username does not exist")
+	}
+
+	organisation := data_cleaning.CleanString(req.Organisation)
+	if valid, err := validation.ValidateOrganizationName(organisation); !valid {
+		return nil, http.StatusBadRequest, err
+	}
+
+	country := data_cleaning.CleanString(req.Country)
+	if valid, err := validation.ValidateCountryName(country); !valid {
+		return nil, http.StatusBadRequest, err
+	}
+
+	return &models.StandardUser{
+		StandardUser: models.User{
+			Username:     username,
+			Password:     password,
+			Name:         name,
+			Email:        email,
+			Organisation: organisation,
+			Country:      country,
+		},
+		LeetcodeID: LeetcodeID,
+	}, http.StatusOK, nil
+}
+
+func (s *Server) handleLogin(w http.ResponseWriter, r *http.Request) {
+	var req loginRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Username == "" || req.Password == "" {
+		writeError(w, http.StatusBadRequest, "username and password are required")
+		return
+	}
+
+	session, err := s.userService.Login(req.Username, req.Password)
+	if err != nil {
+		// Unknown users and wrong passwords look the same so usernames cannot be probed
+		if errors.Is(err, services.ErrUserNotFound) || errors.Is(err, services.ErrInvalidCredentials) {
+			writeError(w, http.StatusUnauthorized, services.ErrInvalidCredentials.Error())
+			return
+		}
+		writeError(w, http.StatusInternalServerError, "login failed: "+err.Error())
+		return
+	}
+
+	user, err := s.userService.GetUserByID(session.UserID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "login failed: "+err.Error())
+		return
+	}
+
+	// Banned users get no usable session, as with the login page
+	if user.StandardUser.IsBanned {
+		_ = s.userService.Logout(session)
+		writeError(w, http.StatusForbidden, s.bannedMessage(user.StandardUser.ID))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, loginResponse{
+		Token:     session.Token,
+		ExpiresAt: session.ExpiresAt,
+		User:      newUserResponse(user),
+	})
+}
+
+func (s *Server) handleLogout(w http.ResponseWriter, r *http.Request) {
+	if err := s.userService.Logout(sessionFrom(r)); err != nil {
+		writeError(w, http.StatusInternalServerError, "could not log out: "+err.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, messageResponse{Message: "logged out"})
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"context"
+	"net/http"
+	"strings"
+)
+
+type contextKey int
+
+const (
+	sessionKey contextKey = iota
+	userKey
+)
+
+// requireUser authenticates the bearer token and applies the same ban checks as the login page.
+// Any signed in user is admitted, role and permission checks belong to requirePermission.
+func (s *Server) requireUser(next http.HandlerFunc) http.Handler {
+	return s.authenticated(func(*models.StandardUser) string { return "" }, next)
+}
+
+// requirePermission is requireUser for staff endpoints, it admits any role that grants the permission.
+// The services check the permission again, this only turns the request away before any work is done.
+func (s *Server) requirePermission(permission string, next http.HandlerFunc) http.Handler {
+	return s.authenticated(func(user *models.StandardUser) string {
+		if !roles.Has(user.StandardUser.Role, permission) {
+			return "this endpoint requires the " + permission + " permission"
+		}
+		return ""
+	}, next)
+}
+
+// authenticated resolves the bearer token to a user who is not banned, then lets check
+// refuse them with a 403 by returning a message
+func (s *Server) authenticated(check func(user *models.StandardUser) string, next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			writeError(w, http.StatusUnauthorized, "missing bearer token")
+			return
+		}
+
+		session, err := s.userService.ResumeSession(token)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		user, err := s.userService.GetUserByID(session.UserID)
+		if err != nil {
+			writeError(w, http.StatusUnauthorized, "user not found")
+			return
+		}
+
+		if user.StandardUser.IsBanned {
+			writeError(w, http.StatusForbidden, s.bannedMessage(user.StandardUser.ID))
+			return
+		}
+
+		if message := check(user); message != "" {
+			writeError(w, http.StatusForbidden, message)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), sessionKey, session)
+		ctx = context.WithValue(ctx, userKey, user)
+		next(w, r.WithContext(ctx))
+	})
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	token, found := strings.CutPrefix(header, "Bearer ")
+	if !found || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func sessionFrom(r *http.Request) *models.Session {
+	session, _ := r.Context().Value(sessionKey).(*models.Session)
+	return session
+}
+
+func userFrom(r *http.Request) *models.StandardUser {
+	user, _ := r.Context().Value(userKey).(*models.StandardUser)
+	return user
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+	"net/http"
+)
+
+func (s *Server) handleListNotes(w http.ResponseWriter, r *http.Request) {
+	notes, err := s.notes.GetNotes(userFrom(r).StandardUser.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch notes: "+err.Error())
+		return
+	}
+
+	response := make([]noteResponse, 0, len(*notes))
+	for _, note := range *notes {
+		response = append(response, newNoteResponse(note))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// handleSearchNotes returns the caller's notes containing every word of q
+func (s *Server) handleSearchNotes(w http.ResponseWriter, r *http.Request) {
+	matches, err := s.notes.SearchNotes(userFrom(r).StandardUser.ID, r.URL.Query().Get("q"))
+	if errors.Is(err, services.ErrEmptySearchQuery) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not search notes: "+err.Error())
+		return
+	}
+
+	response := make([]noteMatchResponse, 0, len(*matches))
+	for _, match := range *matches {
+		response = append(response, noteMatchResponse{Note: newNoteResponse(match.Note), Excerpt: match.Excerpt})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleGetNote(w http.ResponseWriter, r *http.Request) {
+	note, err := s.notes.GetNote(userFrom(r).StandardUser.ID, r.PathValue("questionID"))
+	if err != nil {
+		writeNoteError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newNoteResponse(*note))
+}
+
+// handleSaveNote creates or replaces the caller's note on a question
+func (s *Server) handleSaveNote(w http.ResponseWriter, r *http.Request) {
+	var req saveNoteRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionID := data_cleaning.CleanString(r.PathValue("questionID"))
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not check question: "+err.Error())
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "question with ID "+questionID+" not found")
+		return
+	}
+
+	note, err := s.notes.SaveNote(userFrom(r).StandardUser.ID, questionID, req.Body)
+	if err != nil {
+		writeNoteError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, newNoteResponse(*note))
+}
+
+func (s *Server) handleDeleteNote(w http.ResponseWriter, r *http.Request) {
+	if err := s.notes.DeleteNote(userFrom(r).StandardUser.ID, r.PathValue("questionID")); err != nil {
+		writeNoteError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeNoteError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrNoteNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrEmptyNote), errors.Is(err, services.ErrNoteTooLong):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "could not update note: "+err.Error())
+	}
+}
+
+func newNoteResponse(note models.Note) noteResponse {
+	snippets := make([]codeSnippetResponse, 0, len(note.Snippets))
+	for _, snippet := range note.Snippets {
+		snippets = append(snippets, codeSnippetResponse{Language: snippet.Language, Code: snippet.Code})
+	}
+	return noteResponse{
+		QuestionID: note.QuestionID,
+		Body:       note.Body,
+		Snippets:   snippets,
+		CreatedAt:  note.CreatedAt,
+		UpdatedAt:  note.UpdatedAt,
+	}
+}
@@ -0,0 +1,193 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// handleListQuestions lists the question bank, or the questions matching the filter parameters.
+// List parameters take comma separated or repeated values and match any of them, unless
+// topic_match or company_match is "all".
+func (s *Server) handleListQuestions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	filter := models.QuestionFilter{
+		Difficulties:     queryList(query, "difficulty"),
+		Topics:           queryList(query, "topic"),
+		Companies:        queryList(query, "company"),
+		ExcludeTopics:    queryList(query, "exclude_topic"),
+		ExcludeCompanies: queryList(query, "exclude_company"),
+	}
+	for _, difficulty := range filter.Difficulties {
+		if difficulty == "any" {
+			continue
+		}
+		if _, err := validation.ValidateDifficulty(difficulty); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+	for param, all := range map[string]*bool{"topic_match": &filter.MatchAllTopics, "company_match": &filter.MatchAllCompanies} {
+		switch data_cleaning.CleanString(query.Get(param)) {
+		case "", "any":
+		case "all":
+			*all = true
+		default:
+			writeError(w, http.StatusBadRequest, param+" must be 'any' or 'all'")
+			return
+		}
+	}
+	status, err := validation.ValidateSolveStatus(query.Get("status"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	filter.Status = status
+	filter.SolvedQuestionIDs = userFrom(r).QuestionsSolved
+
+	var questions *[]models.Question
+	if len(query) == 0 {
+		questions, err = s.questionService.GetAllQuestions()
+	} else {
+		questions, err = s.questionService.FilterQuestions(filter)
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch questions: "+err.Error())
+		return
+	}
+
+	response := make([]questionResponse, 0, len(*questions))
+	for _, question := range *questions {
+		response = append(response, newQuestionResponse(question))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// queryList collects the comma separated values of a query parameter that may be repeated
+func queryList(query url.Values, key string) []string {
+	var values []string
+	for _, value := range query[key] {
+		for _, item := range data_cleaning.CleanTags(value) {
+			if item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
+// handleSearchQuestions ranks questions by how closely their title or tags match q
+func (s *Server) handleSearchQuestions(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		var err error
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive number")
+			return
+		}
+	}
+
+	results, err := s.questionService.SearchQuestions(query.Get("q"), limit)
+	if errors.Is(err, services.ErrEmptySearchQuery) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not search questions: "+err.Error())
+		return
+	}
+
+	response := make([]searchResultResponse, 0, len(*results))
+	for _, result := range *results {
+		response = append(response, searchResultResponse{
+			Question:  newQuestionResponse(result.Question),
+			Score:     result.Score,
+			MatchedOn: result.MatchedOn,
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleGetQuestion(w http.ResponseWriter, r *http.Request) {
+	questionID := data_cleaning.CleanString(r.PathValue("id"))
+	if valid, err := validation.ValidateQuestionID(questionID); !valid {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch question: "+err.Error())
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "question with ID "+questionID+" not found")
+		return
+	}
+
+	question, err := s.questionService.GetQuestionByID(questionID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch question: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, newQuestionResponse(*question))
+}
+
+// handleRecommendations answers "what should I solve next?" for the signed in user
+func (s *Server) handleRecommendations(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	progression, err := validation.ValidateProgression(query.Get("progression"))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := 0
+	if value := query.Get("limit"); value != "" {
+		if limit, err = strconv.Atoi(value); err != nil || limit < 1 {
+			writeError(w, http.StatusBadRequest, "limit must be a positive number")
+			return
+		}
+	}
+
+	report, err := s.recommender.RecommendQuestions(userFrom(r).StandardUser.ID, models.RecommendationOptions{
+		TargetCompany: query.Get("company"),
+		Progression:   progression,
+		Limit:         limit,
+	})
+	if errors.Is(err, services.ErrUnknownCompany) {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not recommend questions: "+err.Error())
+		return
+	}
+
+	response := recommendationsResponse{
+		Level:           report.Level,
+		WeakTopics:      make([]tagCoverageResponse, 0, len(report.WeakTopics)),
+		Recommendations: make([]recommendationResponse, 0, len(report.Recommendations)),
+	}
+	for _, topic := range report.WeakTopics {
+		response.WeakTopics = append(response.WeakTopics, tagCoverageResponse{Tag: topic.Tag, Solved: topic.Solved, Total: topic.Total})
+	}
+	for _, recommendation := range report.Recommendations {
+		response.Recommendations = append(response.Recommendations, recommendationResponse{
+			Question: newQuestionResponse(recommendation.Question),
+			Score:    recommendation.Score,
+			Reasons:  recommendation.Reasons,
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"net/http"
+)
+
+// Server exposes the user, question and auth services as a JSON API
+type Server struct {
+	userService     interfaces.UserService
+	questionService interfaces.QuestionService
+	authService     interfaces.AuthService
+	reviewService   interfaces.ReviewService
+	recommender     interfaces.RecommendationService
+	studyLists      interfaces.StudyListService
+	notes           interfaces.NoteService
+	announcements   interfaces.AnnouncementService
+	audit           interfaces.AuditService
+	mux             *http.ServeMux
+}
+
+// NewServer initializes the server with the provided services and registers its routes
+func NewServer(userService interfaces.UserService, questionService interfaces.QuestionService, authService interfaces.AuthService, reviewService interfaces.ReviewService, recommender interfaces.RecommendationService, studyLists interfaces.StudyListService, notes interfaces.NoteService, announcements interfaces.AnnouncementService, audit interfaces.AuditService) *Server {
+	s := &Server{
+		userService:     userService,
+		questionService: questionService,
+		authService:     authService,
+		reviewService:   reviewService,
+		recommender:     recommender,
+		studyLists:      studyLists,
+		notes:           notes,
+		announcements:   announcements,
+		audit:           audit,
+		mux:             http.NewServeMux(),
+	}
+	s.routes()
+	return s
+}
+
+func (s *Server) routes() {
+	// Public endpoints
+	s.mux.HandleFunc("POST /api/signup", s.handleSignup)
+	s.mux.HandleFunc("POST /api/login", s.handleLogin)
+
+	// Any signed in user who is not banned
+	s.mux.Handle("POST /api/logout", s.requireUser(s.handleLogout))
+	s.mux.Handle("GET /api/questions", s.requireUser(s.handleListQuestions))
+	s.mux.Handle("GET /api/questions/search", s.requireUser(s.handleSearchQuestions))
+	s.mux.Handle("GET /api/questions/{id}", s.requireUser(s.handleGetQuestion))
+	s.mux.Handle("GET /api/recommendations", s.requireUser(s.handleRecommendations))
+	s.mux.Handle("POST /api/progress", s.requireUser(s.handleUpdateProgress))
+	s.mux.Handle("POST /api/progress/sync", s.requireUser(s.handleSyncProgress))
+	s.mux.Handle("GET /api/progress/export", s.requireUser(s.handleExportProgress))
+	s.mux.Handle("POST /api/progress/import", s.requireUser(s.handleImportProgress))
+	s.mux.Handle("GET /api/stats", s.requireUser(s.handleUserStats))
+	s.mux.Handle("GET /api/reviews/due", s.requireUser(s.handleDueReviews))
+	s.mux.Handle("GET /api/lists", s.requireUser(s.handleListStudyLists))
+	s.mux.Handle("POST /api/lists", s.requireUser(s.handleCreateStudyList))
+	s.mux.Handle("GET /api/lists/{id}", s.requireUser(s.handleGetStudyList))
+	s.mux.Handle("DELETE /api/lists/{id}", s.requireUser(s.handleDeleteStudyList))
+	s.mux.Handle("POST /api/lists/{id}/questions", s.requireUser(s.handleAddToStudyList))
+	s.mux.Handle("DELETE /api/lists/{id}/questions/{questionID}", s.requireUser(s.handleRemoveFromStudyList))
+	s.mux.Handle("GET /api/notes", s.requireUser(s.handleListNotes))
+	s.mux.Handle("GET /api/notes/search", s.requireUser(s.handleSearchNotes))
+	s.mux.Handle("GET /api/notes/{questionID}", s.requireUser(s.handleGetNote))
+	s.mux.Handle("PUT /api/notes/{questionID}", s.requireUser(s.handleSaveNote))
+	s.mux.Handle("DELETE /api/notes/{questionID}", s.requireUser(s.handleDeleteNote))
+	s.mux.Handle("GET /api/announcements", s.requireUser(s.handleListAnnouncements))
+	s.mux.Handle("POST /api/announcements/{id}/read", s.requireUser(s.handleReadAnnouncement))
+
+	// Staff, by the permission their role grants
+	s.mux.Handle("GET /api/admin/stats", s.requirePermission(roles.STATS_VIEW, s.handlePlatformStats))
+	s.mux.Handle("PATCH /api/admin/questions/{id}", s.requirePermission(roles.QUESTIONS_WRITE, s.handleUpdateQuestion))
+	s.mux.Handle("POST /api/admin/users/{username}/ban", s.requirePermission(roles.USERS_BAN, s.handleBanUser))
+	s.mux.Handle("POST /api/admin/users/{username}/unban", s.requirePermission(roles.USERS_BAN, s.handleUnbanUser))
+	s.mux.Handle("GET /api/admin/appeals", s.requirePermission(roles.USERS_BAN, s.handleListBanAppeals))
+	s.mux.Handle("POST /api/admin/appeals/{id}", s.requirePermission(roles.USERS_BAN, s.handleResolveBanAppeal))
+	s.mux.Handle("PUT /api/admin/users/{username}/role", s.requirePermission(roles.USERS_ROLES, s.handleChangeUserRole))
+	s.mux.Handle("POST /api/admin/users/{username}/promote", s.requirePermission(roles.USERS_ROLES, s.handlePromoteUser))
+	s.mux.Handle("POST /api/admin/users/{username}/demote", s.requirePermission(roles.USERS_ROLES, s.handleDemoteUser))
+	s.mux.Handle("GET /api/admin/announcements", s.requirePermission(roles.ANNOUNCEMENTS_WRITE, s.handleAdminListAnnouncements))
+	s.mux.Handle("POST /api/admin/announcements", s.requirePermission(roles.ANNOUNCEMENTS_WRITE, s.handleCreateAnnouncement))
+	s.mux.Handle("PUT /api/admin/announcements/{id}", s.requirePermission(roles.ANNOUNCEMENTS_WRITE, s.handleUpdateAnnouncement))
+	s.mux.Handle("POST /api/admin/announcements/{id}/expire", s.requirePermission(roles.ANNOUNCEMENTS_WRITE, s.handleExpireAnnouncement))
+	s.mux.Handle("GET /api/admin/audit", s.requirePermission(roles.AUDIT_VIEW, s.handleAuditLog))
+}
+
+// ServeHTTP lets the server be used directly as an http.Handler
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"net/http"
+)
+
+func (s *Server) handleListStudyLists(w http.ResponseWriter, r *http.Request) {
+	lists, err := s.studyLists.GetStudyLists(userFrom(r).StandardUser.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch study lists: "+err.Error())
+		return
+	}
+
+	response := make([]studyListResponse, 0, len(*lists))
+	for _, list := range *lists {
+		response = append(response, newStudyListResponse(list))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleCreateStudyList(w http.ResponseWriter, r *http.Request) {
+	var req createStudyListRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if _, err := validation.ValidateStudyListName(req.Name); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	list, err := s.studyLists.CreateStudyList(userFrom(r).StandardUser.ID, req.Name, req.Description, req.Shared)
+	if err != nil {
+		writeStudyListError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, newStudyListResponse(*list))
+}
+
+func (s *Server) handleGetStudyList(w http.ResponseWriter, r *http.Request) {
+	progress, err := s.studyLists.GetStudyListProgress(userFrom(r).StandardUser.ID, r.PathValue("id"))
+	if err != nil {
+		writeStudyListError(w, err)
+		return
+	}
+
+	response := studyListProgressResponse{
+		studyListResponse: newStudyListResponse(progress.List),
+		Solved:            progress.Solved,
+		Total:             progress.Total,
+		CanEdit:           progress.CanEdit,
+		Items:             make([]studyListItemResponse, 0, len(progress.Items)),
+	}
+	for _, item := range progress.Items {
+		response.Items = append(response.Items, studyListItemResponse{
+			Position: item.Position,
+			Question: newQuestionResponse(item.Question),
+			Solved:   item.Solved,
+			Missing:  item.Missing,
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleDeleteStudyList(w http.ResponseWriter, r *http.Request) {
+	if err := s.studyLists.DeleteStudyList(userFrom(r).StandardUser.ID, r.PathValue("id")); err != nil {
+		writeStudyListError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleAddToStudyList(w http.ResponseWriter, r *http.Request) {
+	var req studyListQuestionRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionID := data_cleaning.CleanString(req.QuestionID)
+	if valid, err := validation.ValidateQuestionID(questionID); !valid {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if req.Position < 0 {
+		writeError(w, http.StatusBadRequest, services.ErrInvalidListPosition.Error())
+		return
+	}
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not update study list: "+err.Error())
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "question with ID "+questionID+" not found")
+		return
+	}
+
+	if err := s.studyLists.AddQuestionToList(userFrom(r).StandardUser.ID, r.PathValue("id"), questionID, req.Position); err != nil {
+		writeStudyListError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) handleRemoveFromStudyList(w http.ResponseWriter, r *http.Request) {
+	err := s.studyLists.RemoveQuestionFromList(userFrom(r).StandardUser.ID, r.PathValue("id"), r.PathValue("questionID"))
+	if err != nil {
+		writeStudyListError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeStudyListError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, services.ErrStudyListNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, services.ErrStudyListForbidden):
+		writeError(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, services.ErrDuplicateStudyList), errors.Is(err, services.ErrQuestionAlreadyInList):
+		writeError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, services.ErrQuestionNotInList), errors.Is(err, services.ErrInvalidListPosition):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "could not update study list: "+err.Error())
+	}
+}
+
+func newStudyListResponse(list models.StudyList) studyListResponse {
+	questionIDs := list.QuestionIDs
+	if questionIDs == nil {
+		questionIDs = []string{}
+	}
+	return studyListResponse{
+		ID:          list.ID,
+		Name:        list.Name,
+		Description: list.Description,
+		Owner:       list.OwnerName,
+		Shared:      list.Shared,
+		QuestionIDs: questionIDs,
+		UpdatedAt:   list.UpdatedAt,
+	}
+}
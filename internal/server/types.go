@@ -0,0 +1,352 @@
+package server
+
+import (
+	"cli-project/internal/domain/models"
+	"encoding/json"
+	"time"
+)
+
+type signupRequest struct {
+	Username     string `json:"username"`
+	Password     string `json:"password"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	LeetcodeID   string `json:"leetcode_id"`
+	Organisation string `json:"organisation"`
+	Country      string `json:"country"`
+}
+
+type loginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type loginResponse struct {
+	Token     string       `json:"token"`
+	ExpiresAt time.Time    `json:"expires_at"`
+	User      userResponse `json:"user"`
+}
+
+type progressRequest struct {
+	QuestionID       string `json:"question_id"`
+	TimeTakenMinutes int    `json:"time_taken_minutes"`
+	Language         string `json:"language"`
+	Notes            string `json:"notes"`
+	Rating           string `json:"rating"`
+}
+
+type progressResponse struct {
+	QuestionID   string    `json:"question_id"`
+	Updated      bool      `json:"updated"`
+	NextReviewAt time.Time `json:"next_review_at"`
+}
+
+type syncedSolveResponse struct {
+	QuestionID    string    `json:"question_id"`
+	QuestionTitle string    `json:"question_title"`
+	SolvedAt      time.Time `json:"solved_at"`
+	FirstSolve    bool      `json:"first_solve"`
+}
+
+type syncResponse struct {
+	Added     []syncedSolveResponse `json:"added"`
+	Skipped   int                   `json:"skipped"`
+	Unmatched []string              `json:"unmatched"`
+}
+
+type progressImportResponse struct {
+	Added     []syncedSolveResponse `json:"added"`
+	Skipped   int                   `json:"skipped"`
+	Unmatched []string              `json:"unmatched"`
+	Conflicts []string              `json:"conflicts"`
+}
+
+type reviewResponse struct {
+	QuestionID   string    `json:"question_id"`
+	DueAt        time.Time `json:"due_at"`
+	Interval     int       `json:"interval_days"`
+	Repetitions  int       `json:"repetitions"`
+	EaseFactor   float64   `json:"ease_factor"`
+	LastReviewed time.Time `json:"last_reviewed"`
+}
+
+type banRequest struct {
+	Reason    string    `json:"reason"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type roleRequest struct {
+	Role string `json:"role"`
+}
+
+type resolveAppealRequest struct {
+	Accept   bool   `json:"accept"`
+	Response string `json:"response"`
+}
+
+type banAppealResponse struct {
+	BanID       string    `json:"ban_id"`
+	Username    string    `json:"username"`
+	Reason      string    `json:"reason"`
+	IssuedBy    string    `json:"issued_by"`
+	StartedAt   time.Time `json:"started_at"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	Message     string    `json:"message"`
+	SubmittedAt time.Time `json:"submitted_at"`
+	Status      string    `json:"status"`
+	Response    string    `json:"response,omitempty"`
+	ResolvedBy  string    `json:"resolved_by,omitempty"`
+}
+
+func newBanAppealResponse(ban models.Ban) banAppealResponse {
+	response := banAppealResponse{
+		BanID:     ban.ID,
+		Username:  ban.Username,
+		Reason:    ban.Reason,
+		IssuedBy:  ban.IssuedByName,
+		StartedAt: ban.StartedAt,
+		ExpiresAt: ban.ExpiresAt,
+	}
+	if ban.Appeal != nil {
+		response.Message = ban.Appeal.Message
+		response.SubmittedAt = ban.Appeal.SubmittedAt
+		response.Status = ban.Appeal.Status
+		response.Response = ban.Appeal.Response
+		response.ResolvedBy = ban.Appeal.ResolvedByName
+	}
+	return response
+}
+
+type banResponse struct {
+	Username string `json:"username"`
+	Banned   bool   `json:"banned"`
+	Changed  bool   `json:"changed"`
+}
+
+type roleResponse struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+	Changed  bool   `json:"changed"`
+}
+
+type messageResponse struct {
+	Message string `json:"message"`
+}
+
+// userResponse is the public view of a user, the password hash never leaves the server
+type userResponse struct {
+	ID              string    `json:"id"`
+	Username        string    `json:"username"`
+	Name            string    `json:"name"`
+	Email           string    `json:"email"`
+	Role            string    `json:"role"`
+	Organisation    string    `json:"organisation"`
+	Country         string    `json:"country"`
+	LeetcodeID      string    `json:"leetcode_id"`
+	QuestionsSolved []string  `json:"questions_solved"`
+	LastSeen        time.Time `json:"last_seen"`
+}
+
+type questionResponse struct {
+	QuestionID    string   `json:"question_id"`
+	QuestionTitle string   `json:"question_title"`
+	Difficulty    string   `json:"difficulty"`
+	QuestionLink  string   `json:"question_link"`
+	TopicTags     []string `json:"topic_tags"`
+	CompanyTags   []string `json:"company_tags"`
+}
+
+type recommendationResponse struct {
+	Question questionResponse `json:"question"`
+	Score    float64          `json:"score"`
+	Reasons  []string         `json:"reasons"`
+}
+
+type searchResultResponse struct {
+	Question  questionResponse `json:"question"`
+	Score     float64          `json:"score"`
+	MatchedOn string           `json:"matched_on"`
+}
+
+type tagCoverageResponse struct {
+	Tag    string `json:"tag"`
+	Solved int    `json:"solved"`
+	Total  int    `json:"total"`
+}
+
+type recommendationsResponse struct {
+	Level           string                   `json:"level"`
+	WeakTopics      []tagCoverageResponse    `json:"weak_topics"`
+	Recommendations []recommendationResponse `json:"recommendations"`
+}
+
+type createStudyListRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+}
+
+type studyListQuestionRequest struct {
+	QuestionID string `json:"question_id"`
+	Position   int    `json:"position"`
+}
+
+type studyListResponse struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Owner       string    `json:"owner"`
+	Shared      bool      `json:"shared"`
+	QuestionIDs []string  `json:"question_ids"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type studyListItemResponse struct {
+	Position int              `json:"position"`
+	Question questionResponse `json:"question"`
+	Solved   bool             `json:"solved"`
+	Missing  bool             `json:"missing"`
+}
+
+type studyListProgressResponse struct {
+	studyListResponse
+	Solved  int                     `json:"solved"`
+	Total   int                     `json:"total"`
+	CanEdit bool                    `json:"can_edit"`
+	Items   []studyListItemResponse `json:"items"`
+}
+
+type userStatsResponse struct {
+	CodesageSolved      int      `json:"codesage_solved"`
+	TotalQuestions      int      `json:"total_questions"`
+	TotalSolved         int      `json:"total_solved"`
+	EasySolved          int      `json:"easy_solved"`
+	EasyTotal           int      `json:"easy_total"`
+	MediumSolved        int      `json:"medium_solved"`
+	MediumTotal         int      `json:"medium_total"`
+	HardSolved          int      `json:"hard_solved"`
+	HardTotal           int      `json:"hard_total"`
+	RecentACSubmissions []string `json:"recent_ac_submissions"`
+}
+
+type platformStatsResponse struct {
+	ActiveUsersLast24Hours int64 `json:"active_users_last_24_hours"`
+	TotalQuestions         int64 `json:"total_questions"`
+}
+
+func newUserResponse(user *models.StandardUser) userResponse {
+	solved := user.QuestionsSolved
+	if solved == nil {
+		solved = []string{}
+	}
+	return userResponse{
+		ID:              user.StandardUser.ID,
+		Username:        user.StandardUser.Username,
+		Name:            user.StandardUser.Name,
+		Email:           user.StandardUser.Email,
+		Role:            user.StandardUser.Role,
+		Organisation:    user.StandardUser.Organisation,
+		Country:         user.StandardUser.Country,
+		LeetcodeID:      user.LeetcodeID,
+		QuestionsSolved: solved,
+		LastSeen:        user.LastSeen,
+	}
+}
+
+// updateQuestionRequest leaves out fields that should not change, an empty tag list clears the tags
+type updateQuestionRequest struct {
+	QuestionTitle *string   `json:"question_title"`
+	Difficulty    *string   `json:"difficulty"`
+	QuestionLink  *string   `json:"question_link"`
+	TopicTags     *[]string `json:"topic_tags"`
+	CompanyTags   *[]string `json:"company_tags"`
+}
+
+func newQuestionResponse(question models.Question) questionResponse {
+	return questionResponse{
+		QuestionID:    question.QuestionID,
+		QuestionTitle: question.QuestionTitle,
+		Difficulty:    question.Difficulty,
+		QuestionLink:  question.QuestionLink,
+		TopicTags:     question.TopicTags,
+		CompanyTags:   question.CompanyTags,
+	}
+}
+
+type saveNoteRequest struct {
+	Body string `json:"body"`
+}
+
+type codeSnippetResponse struct {
+	Language string `json:"language"`
+	Code     string `json:"code"`
+}
+
+type noteResponse struct {
+	QuestionID string                `json:"question_id"`
+	Body       string                `json:"body"`
+	Snippets   []codeSnippetResponse `json:"snippets"`
+	CreatedAt  time.Time             `json:"created_at"`
+	UpdatedAt  time.Time             `json:"updated_at"`
+}
+
+type noteMatchResponse struct {
+	Note    noteResponse `json:"note"`
+	Excerpt string       `json:"excerpt"`
+}
+
+type announcementRequest struct {
+	Title     string    `json:"title"`
+	Body      string    `json:"body"`
+	Audience  string    `json:"audience"`
+	Target    string    `json:"target"`
+	Pinned    bool      `json:"pinned"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+type announcementResponse struct {
+	ID         string    `json:"id"`
+	Title      string    `json:"title"`
+	Body       string    `json:"body"`
+	Audience   string    `json:"audience"`
+	Target     string    `json:"target,omitempty"`
+	Pinned     bool      `json:"pinned"`
+	AuthorName string    `json:"author_name"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+type userAnnouncementResponse struct {
+	announcementResponse
+	Read bool `json:"read"`
+}
+
+type readReceiptResponse struct {
+	Username string    `json:"username"`
+	ReadAt   time.Time `json:"read_at"`
+}
+
+type adminAnnouncementResponse struct {
+	announcementResponse
+	Reads []readReceiptResponse `json:"reads"`
+}
+
+type auditEntryResponse struct {
+	ID        string          `json:"id"`
+	ActorID   string          `json:"actor_id"`
+	ActorName string          `json:"actor"`
+	Action    string          `json:"action"`
+	Target    string          `json:"target"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	Timestamp time.Time       `json:"timestamp"`
+}
+
+type auditPageResponse struct {
+	Entries    []auditEntryResponse `json:"entries"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"page_size"`
+	Total      int64                `json:"total"`
+	TotalPages int                  `json:"total_pages"`
+}
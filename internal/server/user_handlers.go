@@ -0,0 +1,210 @@
+package server
+
+import (
+	"bytes"
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/validation"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+func (s *Server) handleUpdateProgress(w http.ResponseWriter, r *http.Request) {
+	var req progressRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	questionID := data_cleaning.CleanString(req.QuestionID)
+	if valid, err := validation.ValidateQuestionID(questionID); !valid {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if req.TimeTakenMinutes < 0 || req.TimeTakenMinutes > 24*60 {
+		writeError(w, http.StatusBadRequest, "time_taken_minutes must be between 0 and 1440")
+		return
+	}
+
+	// Solves without a rating are scheduled as if recalled with some thought
+	rating := models.RecallGood
+	if req.Rating != "" {
+		value, err := validation.ValidateRecallRating(req.Rating)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		rating = value
+	}
+
+	exists, err := s.questionService.QuestionExists(questionID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update progress: "+err.Error())
+		return
+	}
+	if !exists {
+		writeError(w, http.StatusNotFound, "question with ID "+questionID+" not found")
+		return
+	}
+
+	userID := userFrom(r).StandardUser.ID
+	updated, err := s.userService.UpdateUserProgress(userID, models.SolveEvent{
+		QuestionID: questionID,
+		TimeTaken:  time.Duration(req.TimeTakenMinutes) * time.Minute,
+		Language:   req.Language,
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to update progress: "+err.Error())
+		return
+	}
+
+	card, err := s.reviewService.RecordReview(userID, questionID, rating)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to schedule revision: "+err.Error())
+		return
+	}
+
+	writeJSON(w, http.StatusOK, progressResponse{QuestionID: questionID, Updated: updated, NextReviewAt: card.DueAt})
+}
+
+func (s *Server) handleSyncProgress(w http.ResponseWriter, r *http.Request) {
+	userID := userFrom(r).StandardUser.ID
+
+	report, err := s.userService.SyncLeetcodeProgress(userID)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "could not sync progress: "+err.Error())
+		return
+	}
+
+	response := syncResponse{
+		Added:     make([]syncedSolveResponse, 0, len(report.Added)),
+		Skipped:   report.Skipped,
+		Unmatched: make([]string, 0, len(report.Unmatched)),
+	}
+	for _, solve := range report.Added {
+		// Synced solves have no recall rating, so schedule them as recalled well
+		if _, err := s.reviewService.RecordReview(userID, solve.QuestionID, models.RecallGood); err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to schedule revision: "+err.Error())
+			return
+		}
+		response.Added = append(response.Added, syncedSolveResponse{
+			QuestionID:    solve.QuestionID,
+			QuestionTitle: solve.QuestionTitle,
+			SolvedAt:      solve.SolvedAt,
+			FirstSolve:    solve.FirstSolve,
+		})
+	}
+	for _, submission := range report.Unmatched {
+		response.Unmatched = append(response.Unmatched, submission.Title)
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleExportProgress(w http.ResponseWriter, r *http.Request) {
+	var buffer bytes.Buffer
+	if err := s.userService.ExportProgress(userFrom(r).StandardUser.ID, &buffer); err != nil {
+		writeError(w, http.StatusInternalServerError, "could not export progress: "+err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="codesage-progress.json"`)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buffer.Bytes())
+}
+
+func (s *Server) handleImportProgress(w http.ResponseWriter, r *http.Request) {
+	userID := userFrom(r).StandardUser.ID
+
+	report, err := s.userService.ImportProgress(userID, io.LimitReader(r.Body, maxBodySize))
+	switch {
+	case errors.Is(err, services.ErrInvalidProgressExport), errors.Is(err, services.ErrUnsupportedProgressExport):
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	case errors.Is(err, services.ErrProgressOwnerMismatch):
+		writeError(w, http.StatusConflict, err.Error())
+		return
+	case err != nil:
+		writeError(w, http.StatusInternalServerError, "could not import progress: "+err.Error())
+		return
+	}
+
+	response := progressImportResponse{
+		Added:     make([]syncedSolveResponse, 0, len(report.Added)),
+		Skipped:   report.Skipped,
+		Unmatched: report.Unmatched,
+		Conflicts: report.Conflicts,
+	}
+	for _, solve := range report.Added {
+		// Imported solves have no recall rating, so schedule the newly solved ones as recalled well
+		if solve.FirstSolve {
+			if _, err := s.reviewService.RecordReview(userID, solve.QuestionID, models.RecallGood); err != nil {
+				writeError(w, http.StatusInternalServerError, "failed to schedule revision: "+err.Error())
+				return
+			}
+		}
+		response.Added = append(response.Added, syncedSolveResponse{
+			QuestionID:    solve.QuestionID,
+			QuestionTitle: solve.QuestionTitle,
+			SolvedAt:      solve.SolvedAt,
+			FirstSolve:    solve.FirstSolve,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleDueReviews(w http.ResponseWriter, r *http.Request) {
+	cards, err := s.reviewService.GetDueReviews(userFrom(r).StandardUser.ID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "could not fetch due reviews: "+err.Error())
+		return
+	}
+
+	response := make([]reviewResponse, 0, len(*cards))
+	for _, card := range *cards {
+		response = append(response, reviewResponse{
+			QuestionID:   card.QuestionID,
+			DueAt:        card.DueAt,
+			Interval:     card.Interval,
+			Repetitions:  card.Repetitions,
+			EaseFactor:   card.EaseFactor,
+			LastReviewed: card.LastReviewed,
+		})
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) handleUserStats(w http.ResponseWriter, r *http.Request) {
+	user := userFrom(r)
+
+	stats, err := s.userService.GetLeetcodeStats(user.StandardUser.ID)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, "could not fetch stats: "+err.Error())
+		return
+	}
+
+	recent := stats.RecentACSubmissions
+	if recent == nil {
+		recent = []string{}
+	}
+
+	writeJSON(w, http.StatusOK, userStatsResponse{
+		CodesageSolved:      len(user.QuestionsSolved),
+		TotalQuestions:      stats.TotalQuestionsCount,
+		TotalSolved:         stats.TotalQuestionsDoneCount,
+		EasySolved:          stats.EasyDoneCount,
+		EasyTotal:           stats.TotalEasyCount,
+		MediumSolved:        stats.MediumDoneCount,
+		MediumTotal:         stats.TotalMediumCount,
+		HardSolved:          stats.HardDoneCount,
+		HardTotal:           stats.TotalHardCount,
+		RecentACSubmissions: recent,
+	})
+}
@@ -0,0 +1,120 @@
+package ui
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/formatting"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// heatmapCells are the glyphs for each activity level, from no solves to the busiest days
+var heatmapCells = []string{
+	formatting.Colorize("·", "white", ""),
+	formatting.Colorize("░", "green", ""),
+	formatting.Colorize("▒", "green", ""),
+	formatting.Colorize("▓", "green", ""),
+	formatting.Colorize("█", "green", "bold"),
+}
+
+// showStreaks prints the current and longest daily solve streaks
+func (ui *UI) showStreaks() {
+	streaks, err := ui.userService.GetStreaks(ui.session.UserID)
+	if err != nil {
+		fmt.Println("Error fetching streaks:", err)
+		return
+	}
+
+	fmt.Println(formatting.Colorize("\nStreaks", "cyan", "bold"))
+	current := fmt.Sprintf("Current streak : %d %s", streaks.Current, pluralDays(streaks.Current))
+	if streaks.Current > 0 {
+		fmt.Println(formatting.Colorize(current, "green", "bold"))
+	} else {
+		fmt.Println(current)
+	}
+
+	longest := fmt.Sprintf("Longest streak : %d %s", streaks.Longest, pluralDays(streaks.Longest))
+	if streaks.Longest > 0 {
+		longest += fmt.Sprintf(" (%s - %s)", streaks.LongestStart.Format("02 Jan 2006"), streaks.LongestEnd.Format("02 Jan 2006"))
+	}
+	fmt.Println(longest)
+}
+
+// showActivityHeatmap renders the last HEATMAP_WEEKS weeks as a contribution grid,
+// one column per week starting on Monday and one row per weekday.
+func (ui *UI) showActivityHeatmap() {
+	// Cover whole weeks so the grid lines up, ending with today's partial week
+	today := time.Now()
+	daysIntoWeek := (int(today.Weekday()) + 6) % 7
+	days := (config.HEATMAP_WEEKS-1)*7 + daysIntoWeek + 1
+
+	activity, err := ui.userService.GetDailyActivity(ui.session.UserID, days)
+	if err != nil {
+		fmt.Println("Error fetching activity:", err)
+		return
+	}
+
+	fmt.Println(formatting.Colorize(fmt.Sprintf("\nActivity over the last %d weeks", config.HEATMAP_WEEKS), "cyan", "bold"))
+	fmt.Println(renderHeatmap(*activity))
+}
+
+// renderHeatmap lays the days out column by column, the first day must be a Monday
+func renderHeatmap(activity []models.DailyActivity) string {
+	weeks := (len(activity) + 6) / 7
+	var b strings.Builder
+
+	// Month labels above the first week of each month
+	months := []rune(strings.Repeat(" ", weeks+3))
+	for week := 0; week < weeks; week++ {
+		date := activity[week*7].Date
+		if date.Day() <= 7 {
+			label := date.Format("Jan")
+			if week+len(label) <= weeks {
+				copy(months[week:], []rune(label))
+			}
+		}
+	}
+	b.WriteString("    " + strings.TrimRight(string(months), " ") + "\n")
+
+	weekdays := []string{"Mon", "", "Wed", "", "Fri", "", "Sun"}
+	total := 0
+	for weekday := 0; weekday < 7; weekday++ {
+		b.WriteString(fmt.Sprintf("%-4s", weekdays[weekday]))
+		for week := 0; week < weeks; week++ {
+			index := week*7 + weekday
+			if index >= len(activity) {
+				break
+			}
+			b.WriteString(heatmapCells[heatmapLevel(activity[index].Solves)])
+			total += activity[index].Solves
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(fmt.Sprintf("    %d solves    Less %s More", total, strings.Join(heatmapCells, "")))
+	return b.String()
+}
+
+// heatmapLevel maps a day's solve count to one of the heatmap cells
+func heatmapLevel(solves int) int {
+	switch {
+	case solves <= 0:
+		return 0
+	case solves == 1:
+		return 1
+	case solves == 2:
+		return 2
+	case solves <= 4:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func pluralDays(count int) string {
+	if count == 1 {
+		return "day"
+	}
+	return "days"
+}
@@ -1,49 +1,27 @@
 package ui
 
 import (
+	"cli-project/internal/config/roles"
 	"cli-project/pkg/utils/formatting"
 	"fmt"
-	"strings"
 )
 
+// ShowAdminMenu is the menu for every staff role, it only lists what the role's permissions allow
 func (ui *UI) ShowAdminMenu() {
-	for {
-		// Clear the screen
-		fmt.Print("\033[H\033[2J")
-
-		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("             ADMIN MENU             ", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("1. View platform stats", "", ""))
-		fmt.Println(formatting.Colorize("2. Add or remove questions", "", ""))
-		fmt.Println(formatting.Colorize("3. Manage users", "", ""))
-		//fmt.Println(formatting.Colorize("4. Post Announcement", "", ""))
-		fmt.Println(formatting.Colorize("4. Logout", "", ""))
-
-		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
-		choice, err := ui.reader.ReadString('\n')
-		choice = strings.TrimSuffix(choice, "\n")
-		choice = strings.TrimSpace(choice)
-
-		if err != nil {
-			fmt.Println(formatting.Colorize("Error reading input:", "red", "bold"), err)
-			return
-		}
+	err := ui.showMenu("STAFF MENU", "Logout", []menuItem{
+		{"View platform stats", roles.STATS_VIEW, ui.DisplayPlatformStats},
+		{"Manage questions", roles.QUESTIONS_WRITE, ui.ManageQuestions},
+		{"Manage users", roles.USERS_BAN, ui.ManageUsers},
+		{"Study lists", "", ui.ShowStudyListsPage},
+		{"Announcements", roles.ANNOUNCEMENTS_WRITE, ui.ManageAnnouncements},
+		{"Audit log", roles.AUDIT_VIEW, ui.ViewAuditLog},
+	})
+	if err != nil {
+		return
+	}
 
-		switch choice {
-		case "1":
-			ui.DisplayPlatformStats()
-		case "2":
-			ui.ManageQuestions()
-		case "3":
-			ui.ManageUsers()
-		case "4":
-			fmt.Println("Logging out...")
-			return
-		//case "4":
-		//	ui.PostAnnouncement()
-		default:
-			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
-		}
+	if err := ui.endSession(); err != nil {
+		fmt.Println(formatting.Colorize("Error logging out: ", "red", "bold"), err)
 	}
+	fmt.Println("Logging out...")
 }
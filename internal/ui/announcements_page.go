@@ -0,0 +1,313 @@
+package ui
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
+	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ShowUnreadAnnouncements shows the announcements the user has not read yet and leaves a read
+// receipt on each of them. It is shown right after login and does nothing when all are read.
+func (ui *UI) ShowUnreadAnnouncements() {
+	unread, err := ui.announcements.GetUnreadAnnouncements(ui.session.UserID)
+	if err != nil || len(*unread) == 0 {
+		return
+	}
+
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("           ANNOUNCEMENTS            ", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	for _, announcement := range *unread {
+		printAnnouncement(announcement)
+		if err := ui.announcements.MarkAnnouncementRead(ui.session.UserID, announcement.ID); err != nil {
+			fmt.Println(formatting.Colorize("Could not mark the announcement as read:", "yellow", ""), err)
+		}
+	}
+
+	fmt.Println("\nPress any key to continue...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// ShowAnnouncementsPage shows every announcement addressed to the user that has not expired
+func (ui *UI) ShowAnnouncementsPage() {
+
+	// Clear the screen
+	fmt.Print("\033[H\033[2J")
+
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("           ANNOUNCEMENTS            ", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+	announcements, err := ui.announcements.GetUserAnnouncements(ui.session.UserID)
+	switch {
+	case err != nil:
+		fmt.Println(formatting.Colorize("Failed to load announcements:", "red", "bold"), err)
+	case len(*announcements) == 0:
+		fmt.Println("There are no announcements right now.")
+	default:
+		for _, announcement := range *announcements {
+			if announcement.Read {
+				printAnnouncement(announcement.Announcement)
+				continue
+			}
+			fmt.Print(formatting.Colorize("\nNEW", "green", "bold"))
+			printAnnouncement(announcement.Announcement)
+			_ = ui.announcements.MarkAnnouncementRead(ui.session.UserID, announcement.Announcement.ID)
+		}
+	}
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func printAnnouncement(announcement models.Announcement) {
+	title := announcement.Title
+	if announcement.Pinned {
+		title = "[pinned] " + title
+	}
+	fmt.Println(formatting.Colorize("\n"+title, "yellow", "bold"))
+	fmt.Println(announcement.Body)
+	fmt.Printf("- %s, %s\n", announcement.AuthorName, announcement.CreatedAt.Local().Format("02 Jan 2006 15:04"))
+}
+
+// ManageAnnouncements lets admins post, edit, expire and pin announcements, and see who read them
+func (ui *UI) ManageAnnouncements() {
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("        MANAGE ANNOUNCEMENTS        ", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+		announcements, err := ui.announcements.GetAllAnnouncements()
+		if err != nil {
+			fmt.Println(formatting.Colorize("Failed to load announcements:", "red", "bold"), err)
+			return
+		}
+		renderAnnouncementTable(*announcements)
+
+		fmt.Println(formatting.Colorize("1. Post announcement", "", ""))
+		fmt.Println(formatting.Colorize("2. Edit announcement", "", ""))
+		fmt.Println(formatting.Colorize("3. Expire announcement", "", ""))
+		fmt.Println(formatting.Colorize("4. Pin or unpin announcement", "", ""))
+		fmt.Println(formatting.Colorize("5. View read receipts", "", ""))
+		fmt.Println(formatting.Colorize("6. Go back", "", ""))
+
+		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
+		choice, _ := ui.reader.ReadString('\n')
+
+		switch strings.TrimSpace(choice) {
+		case "1":
+			ui.PostAnnouncement()
+		case "2":
+			if announcement := ui.pickAnnouncement(*announcements); announcement != nil {
+				ui.editAnnouncement(announcement)
+			}
+		case "3":
+			if announcement := ui.pickAnnouncement(*announcements); announcement != nil {
+				ui.reportAnnouncementChange(ui.announcements.ExpireAnnouncement(ui.session.UserID, announcement.ID), "Announcement expired")
+			}
+		case "4":
+			if announcement := ui.pickAnnouncement(*announcements); announcement != nil {
+				message := "Announcement pinned"
+				if announcement.Pinned {
+					message = "Announcement unpinned"
+				}
+				ui.reportAnnouncementChange(ui.announcements.SetAnnouncementPinned(ui.session.UserID, announcement.ID, !announcement.Pinned), message)
+			}
+		case "5":
+			if announcement := ui.pickAnnouncement(*announcements); announcement != nil {
+				ui.viewReadReceipts(announcement)
+			}
+		case "6":
+			return
+		default:
+			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
+		}
+	}
+}
+
+// PostAnnouncement asks for a new announcement and publishes it
+func (ui *UI) PostAnnouncement() {
+	draft := ui.readAnnouncementDraft(nil)
+
+	announcement, err := ui.announcements.CreateAnnouncement(ui.session.UserID, draft)
+	if err != nil {
+		ui.reportAnnouncementChange(err, "")
+		return
+	}
+	ui.reportAnnouncementChange(nil, fmt.Sprintf("Announcement %q posted to %s", announcement.Title, describeAudience(*announcement)))
+}
+
+func (ui *UI) editAnnouncement(announcement *models.Announcement) {
+	fmt.Println("Press enter to keep the current value.")
+	draft := ui.readAnnouncementDraft(announcement)
+	_, err := ui.announcements.UpdateAnnouncement(ui.session.UserID, announcement.ID, draft)
+	ui.reportAnnouncementChange(err, "Announcement updated")
+}
+
+// readAnnouncementDraft asks for each part of an announcement, empty answers keep the values of current
+func (ui *UI) readAnnouncementDraft(current *models.Announcement) models.AnnouncementDraft {
+	var draft models.AnnouncementDraft
+	if current != nil {
+		draft = models.AnnouncementDraft{
+			Title:     current.Title,
+			Body:      current.Body,
+			Audience:  current.Audience,
+			Target:    current.Target,
+			Pinned:    current.Pinned,
+			ExpiresAt: current.ExpiresAt,
+		}
+	}
+
+	if title := ui.readAnnouncementField("Title: "); title != "" {
+		draft.Title = title
+	}
+	if body := ui.readAnnouncementField("Message: "); body != "" {
+		draft.Body = body
+	}
+
+	for {
+		input := ui.readAnnouncementField("Audience - everyone, organisation or country: ")
+		if input == "" && current != nil {
+			break
+		}
+		audience, err := validation.ValidateAnnouncementAudience(input)
+		if err != nil {
+			fmt.Println(emojis.Error, err)
+			continue
+		}
+		draft.Audience, draft.Target = audience, ""
+		if audience != models.AudienceEveryone {
+			draft.Target = ui.readAnnouncementField(fmt.Sprintf("Which %s: ", audience))
+		}
+		break
+	}
+
+	for {
+		input := ui.readAnnouncementField("Expires - a date like 2006-01-02, 7d, 36h or never (press enter to skip): ")
+		if input == "" {
+			break
+		}
+		expiresAt, err := validation.ValidateExpiry(input, time.Now())
+		if err != nil {
+			fmt.Println(emojis.Error, err)
+			continue
+		}
+		draft.ExpiresAt = expiresAt
+		break
+	}
+
+	if current == nil {
+		draft.Pinned = ui.confirmFilter("Pin it above the other announcements? (y/n): ")
+	}
+	return draft
+}
+
+func (ui *UI) readAnnouncementField(prompt string) string {
+	fmt.Print(formatting.Colorize(prompt, "yellow", ""))
+	input, _ := ui.reader.ReadString('\n')
+	return strings.TrimSpace(input)
+}
+
+// pickAnnouncement asks for the number of an announcement in the table
+func (ui *UI) pickAnnouncement(announcements []models.Announcement) *models.Announcement {
+	if len(announcements) == 0 {
+		fmt.Println(formatting.Colorize("There are no announcements yet", "yellow", "bold"))
+		return nil
+	}
+
+	fmt.Print("Enter the announcement number: ")
+	input, _ := ui.reader.ReadString('\n')
+	number, err := strconv.Atoi(data_cleaning.CleanString(input))
+	if err != nil || number < 1 || number > len(announcements) {
+		fmt.Println(formatting.Colorize("Invalid announcement number", "red", "bold"))
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
+		return nil
+	}
+	return &announcements[number-1]
+}
+
+func (ui *UI) viewReadReceipts(announcement *models.Announcement) {
+	fmt.Println(formatting.Colorize(fmt.Sprintf("\n%s - read by %d", announcement.Title, len(announcement.Reads)), "cyan", "bold"))
+
+	if len(announcement.Reads) > 0 {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Username", "Read At"})
+		for _, read := range announcement.Reads {
+			table.Append([]string{read.Username, read.ReadAt.Local().Format("02 Jan 2006 15:04")})
+		}
+		table.Render()
+	}
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// reportAnnouncementChange prints the outcome of a change and waits for the admin to go back
+func (ui *UI) reportAnnouncementChange(err error, message string) {
+	if err != nil {
+		fmt.Println(emojis.Error, err)
+	} else {
+		fmt.Println(emojis.Success, message)
+	}
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func renderAnnouncementTable(announcements []models.Announcement) {
+	if len(announcements) == 0 {
+		fmt.Println("No announcements posted yet.")
+		return
+	}
+
+	now := time.Now()
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "Title", "Audience", "Pinned", "Posted", "Expires", "Read By"})
+	for i, announcement := range announcements {
+		pinned := ""
+		if announcement.Pinned {
+			pinned = emojis.Success
+		}
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			announcement.Title,
+			describeAudience(announcement),
+			pinned,
+			announcement.CreatedAt.Local().Format("02 Jan 2006"),
+			describeExpiry(announcement, now),
+			strconv.Itoa(len(announcement.Reads)),
+		})
+	}
+	table.SetAutoWrapText(true)
+	table.SetRowLine(true)
+	table.Render()
+}
+
+func describeAudience(announcement models.Announcement) string {
+	if announcement.Audience == models.AudienceEveryone {
+		return "everyone"
+	}
+	return fmt.Sprintf("%s: %s", announcement.Audience, announcement.Target)
+}
+
+func describeExpiry(announcement models.Announcement, now time.Time) string {
+	switch {
+	case announcement.ExpiresAt.IsZero():
+		return "never"
+	case !announcement.ExpiresAt.After(now):
+		return formatting.Colorize("expired", "red", "")
+	default:
+		return announcement.ExpiresAt.Local().Format("02 Jan 2006 15:04")
+	}
+}
@@ -0,0 +1,149 @@
+package ui
+
+import (
+	"bytes"
+	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
+	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
+	"encoding/json"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ViewAuditLog pages through the privileged actions taken by admins, the newest first
+func (ui *UI) ViewAuditLog() {
+	// Clear the screen
+	fmt.Print("\033[H\033[2J")
+
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("             AUDIT LOG              ", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+	filter := ui.readAuditFilter()
+	request := models.PageRequest{Page: 1, PageSize: config.PAGE_SIZE}
+	for {
+		page, err := ui.audit.GetAuditLog(ui.session.UserID, filter, request)
+		if err != nil {
+			fmt.Println(emojis.Error, formatting.Colorize("Failed to load the audit log:", "red", "bold"), err)
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		renderAuditTable(page)
+
+		next, command := ui.readPageCommand(page.PageInfo, "[d] details  [f] filter")
+		switch {
+		case next > 0:
+			request.Page = next
+		case command == "d":
+			ui.viewAuditEntry(page.Entries)
+		case command == "f":
+			filter = ui.readAuditFilter()
+			request.Page = 1
+		default:
+			return
+		}
+	}
+}
+
+// readAuditFilter asks for each filter in turn, an empty answer leaves it out
+func (ui *UI) readAuditFilter() models.AuditFilter {
+	fmt.Println(formatting.Colorize("Filter the audit log, press Enter to skip a filter.", "cyan", ""))
+
+	var filter models.AuditFilter
+	filter.Actor = ui.readAuditField("Admin username: ")
+	filter.Action = ui.readAuditField("Action (e.g. user.ban, or user for every user action): ")
+	filter.Target = ui.readAuditField("Target (question ID, username or file): ")
+
+	for {
+		since := ui.readAuditField("From date (2006-01-02): ")
+		until := ui.readAuditField("To date (2006-01-02): ")
+		var err error
+		if filter.Since, filter.Until, err = validation.ValidateDateRange(since, until); err != nil {
+			fmt.Println(formatting.Colorize(err.Error(), "red", ""))
+			continue
+		}
+		return filter
+	}
+}
+
+func (ui *UI) readAuditField(prompt string) string {
+	fmt.Print(formatting.Colorize(prompt, "yellow", ""))
+	input, _ := ui.reader.ReadString('\n')
+	return strings.TrimSpace(input)
+}
+
+// viewAuditEntry asks for an entry number on the page and shows its before and after values
+func (ui *UI) viewAuditEntry(entries []models.AuditEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	fmt.Print("Enter the entry number: ")
+	input, _ := ui.reader.ReadString('\n')
+	number, err := strconv.Atoi(data_cleaning.CleanString(input))
+	if err != nil || number < 1 || number > len(entries) {
+		fmt.Println(formatting.Colorize("Invalid entry number", "red", "bold"))
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
+		return
+	}
+
+	entry := entries[number-1]
+	fmt.Println(formatting.Colorize(fmt.Sprintf("\n%s on %s by %s at %s", entry.Action, entry.Target, auditActor(entry), entry.Timestamp.Local().Format("02 Jan 2006 15:04:05")), "cyan", "bold"))
+	fmt.Println(formatting.Colorize("Before:", "yellow", "bold"))
+	fmt.Println(indentAuditSnapshot(entry.Before))
+	fmt.Println(formatting.Colorize("After:", "yellow", "bold"))
+	fmt.Println(indentAuditSnapshot(entry.After))
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func renderAuditTable(page *models.AuditPage) {
+	if page.Total == 0 {
+		fmt.Println("No audit entries found.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "Time", "Admin", "Action", "Target"})
+	for i, entry := range page.Entries {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			entry.Timestamp.Local().Format("02 Jan 2006 15:04"),
+			auditActor(entry),
+			entry.Action,
+			entry.Target,
+		})
+	}
+	table.SetAutoWrapText(true)
+	table.SetRowLine(true)
+	table.Render()
+}
+
+// auditActor falls back to the ID when the admin's username could not be found when the action was recorded
+func auditActor(entry models.AuditEntry) string {
+	if entry.ActorName != "" {
+		return entry.ActorName
+	}
+	return entry.ActorID
+}
+
+func indentAuditSnapshot(snapshot string) string {
+	if snapshot == "" {
+		return "(nothing)"
+	}
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, []byte(snapshot), "", "  "); err != nil {
+		return snapshot
+	}
+	return indented.String()
+}
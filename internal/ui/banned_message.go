@@ -1,7 +1,11 @@
 package ui
 
 import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/emojis"
 	"cli-project/pkg/utils/formatting"
+	"errors"
 	"fmt"
 )
 
@@ -15,7 +19,63 @@ func (ui *UI) ShowBannedMessage() {
 	fmt.Println(formatting.Colorize("            THE PLATFORM            ", "red", "bold"))
 	fmt.Println(formatting.Colorize("====================================", "red", "bold"))
 
+	ban, err := ui.userService.GetActiveBan(ui.session.UserID)
+	if err != nil {
+		// Bans issued before reasons were recorded only get the generic screen
+		if !errors.Is(err, services.ErrBanNotFound) {
+			fmt.Println(emojis.Error, "Could not load the ban details:", err)
+		}
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
+		return
+	}
+
+	ui.printBanDetails(ban)
+
+	if ban.Appeal == nil {
+		appeal, err := ui.readConfirm("\nDo you want to appeal this ban? (y/n): ")
+		if err != nil {
+			return
+		}
+		if appeal {
+			message, err := ui.readLine("Your appeal: ")
+			if err != nil {
+				return
+			}
+			if _, err := ui.userService.SubmitBanAppeal(ui.session.UserID, message); err != nil {
+				fmt.Println(emojis.Error, err)
+			} else {
+				fmt.Println(emojis.Success, "Your appeal has been sent to the admins")
+			}
+		}
+	}
+
 	fmt.Println("\nPress any key to go back...")
 
 	_, _ = ui.reader.ReadString('\n')
 }
+
+// printBanDetails shows why and until when a user is banned, and how their appeal stands
+func (ui *UI) printBanDetails(ban *models.Ban) {
+	fmt.Println(formatting.Colorize("Reason:    ", "yellow", "bold") + ban.Reason)
+	if ban.IssuedByName != "" {
+		fmt.Println(formatting.Colorize("Issued by: ", "yellow", "bold") + ban.IssuedByName)
+	}
+	fmt.Println(formatting.Colorize("Since:     ", "yellow", "bold") + ban.StartedAt.Local().Format("02 Jan 2006 15:04"))
+	fmt.Println(formatting.Colorize("Until:     ", "yellow", "bold") + banExpiry(ban))
+
+	if ban.Appeal == nil {
+		return
+	}
+	fmt.Println(formatting.Colorize("Appeal:    ", "yellow", "bold") + ban.Appeal.Status)
+	if ban.Appeal.Response != "" {
+		fmt.Println(formatting.Colorize("Response:  ", "yellow", "bold") + ban.Appeal.Response)
+	}
+}
+
+func banExpiry(ban *models.Ban) string {
+	if ban.ExpiresAt.IsZero() {
+		return "lifted by an admin"
+	}
+	return ban.ExpiresAt.Local().Format("02 Jan 2006 15:04")
+}
@@ -0,0 +1,168 @@
+package ui
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
+	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// recallOptions are the ratings offered after solving or revising a question
+var recallOptions = []struct {
+	label  string
+	rating int
+}{
+	{"Again - could not recall the solution", models.RecallAgain},
+	{"Hard - recalled with serious effort", models.RecallHard},
+	{"Good - recalled after some thought", models.RecallGood},
+	{"Easy - recalled immediately", models.RecallEasy},
+}
+
+// ShowDueReviewsPage lists the questions due for revision and lets the user revise them
+func (ui *UI) ShowDueReviewsPage() {
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("          DUE FOR REVISION          ", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+		cards, err := ui.reviewService.GetDueReviews(ui.session.UserID)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error fetching revisions: ", "red", "bold"), err)
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		if len(*cards) == 0 {
+			fmt.Println(emojis.Success, "Nothing due for revision. Come back tomorrow!")
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		ui.renderDueReviews(*cards)
+
+		fmt.Print(formatting.Colorize("\nEnter the ID of a question you revised (or press Enter to go back): ", "yellow", "bold"))
+		questionID, _ := ui.reader.ReadString('\n')
+		questionID = data_cleaning.CleanString(questionID)
+		if questionID == "" {
+			return
+		}
+
+		if !isDue(*cards, questionID) {
+			fmt.Println(formatting.Colorize("That question is not due for revision.", "red", "bold"))
+			fmt.Println("\nPress any key to continue...")
+			_, _ = ui.reader.ReadString('\n')
+			continue
+		}
+
+		// A revision counts as another solve in the history
+		if _, err := ui.userService.UpdateUserProgress(ui.session.UserID, models.SolveEvent{QuestionID: questionID}); err != nil {
+			fmt.Println(formatting.Colorize("Failed to update progress: ", "red", "bold"), err)
+		}
+
+		ui.rateRecall(questionID)
+
+		fmt.Println("\nPress any key to continue...")
+		_, _ = ui.reader.ReadString('\n')
+	}
+}
+
+func (ui *UI) renderDueReviews(cards []models.ReviewCard) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"ID", "Title", "Difficulty", "Due", "Last Rating", "Interval"})
+	table.SetAutoWrapText(false)
+
+	today := time.Now()
+	for _, card := range cards {
+		title, difficulty := "(question removed)", ""
+		if question, err := ui.questionService.GetQuestionByID(card.QuestionID); err == nil {
+			title = fmt.Sprintf("%s (%s)", question.QuestionTitle, question.QuestionLink)
+			difficulty = question.Difficulty
+		}
+
+		due := "today"
+		if overdue := int(today.Sub(card.DueAt).Hours() / 24); overdue >= 1 {
+			due = formatting.Colorize(fmt.Sprintf("%d days overdue", overdue), "red", "")
+		}
+
+		table.Append([]string{
+			card.QuestionID,
+			title,
+			difficulty,
+			due,
+			recallLabel(card.LastRating),
+			fmt.Sprintf("%d days", card.Interval),
+		})
+	}
+
+	table.Render()
+}
+
+// rateRecall asks how hard the question was to recall and schedules its next revision
+func (ui *UI) rateRecall(questionID string) {
+	fmt.Println(formatting.Colorize("\nHow hard was it to recall the solution?", "cyan", "bold"))
+	for i, option := range recallOptions {
+		fmt.Printf("%d. %s\n", i+1, option.label)
+	}
+
+	var rating int
+	for {
+		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
+		choice, _ := ui.reader.ReadString('\n')
+		choice = strings.TrimSpace(choice)
+
+		index, err := strconv.Atoi(choice)
+		if err != nil || index < 1 || index > len(recallOptions) {
+			// Names are accepted too, matching the CLI --rating flag
+			if value, err := validation.ValidateRecallRating(choice); err == nil {
+				rating = value
+				break
+			}
+			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
+			continue
+		}
+		rating = recallOptions[index-1].rating
+		break
+	}
+
+	card, err := ui.reviewService.RecordReview(ui.session.UserID, questionID, rating)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to schedule revision: ", "red", "bold"), err)
+		return
+	}
+
+	fmt.Println(formatting.Colorize(fmt.Sprintf("Next revision on %s", card.DueAt.Local().Format("Mon 02 Jan 2006")), "green", "bold"))
+}
+
+func recallLabel(rating int) string {
+	switch {
+	case rating >= models.RecallEasy:
+		return "easy"
+	case rating >= models.RecallGood:
+		return "good"
+	case rating >= models.RecallHard:
+		return "hard"
+	default:
+		return "again"
+	}
+}
+
+func isDue(cards []models.ReviewCard, questionID string) bool {
+	for _, card := range cards {
+		if card.QuestionID == questionID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/formatting"
+	"fmt"
+	"strings"
+)
+
+// readLine asks for one line of input and returns it without surrounding whitespace.
+// It returns the read error, so prompts that repeat until they get an answer stop once input runs out.
+func (ui *UI) readLine(prompt string) (string, error) {
+	fmt.Print(formatting.Colorize(prompt, "yellow", ""))
+	input, err := ui.reader.ReadString('\n')
+	if err != nil {
+		fmt.Println(formatting.Colorize("error reading input:", "red", "bold"), err)
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
+// readConfirm asks a yes or no question, only "y" counts as yes
+func (ui *UI) readConfirm(prompt string) (bool, error) {
+	input, err := ui.readLine(prompt)
+	if err != nil {
+		return false, err
+	}
+	return data_cleaning.CleanString(input) == "y", nil
+}
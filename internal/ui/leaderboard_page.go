@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/formatting"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+)
+
+func (ui *UI) ShowLeaderboardPage() {
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("            LEADERBOARD             ", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("1. Global", "", ""))
+		fmt.Println(formatting.Colorize("2. My organisation", "", ""))
+		fmt.Println(formatting.Colorize("3. My country", "", ""))
+		fmt.Println(formatting.Colorize("4. Go back", "", ""))
+
+		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
+		choice, err := ui.reader.ReadString('\n')
+		choice = strings.TrimSpace(choice)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error reading input:", "red", "bold"), err)
+			return
+		}
+
+		switch choice {
+		case "1":
+			ui.viewLeaderboard(models.GlobalScope)
+		case "2":
+			ui.viewLeaderboard(models.OrganisationScope)
+		case "3":
+			ui.viewLeaderboard(models.CountryScope)
+		case "4":
+			return
+		default:
+			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
+		}
+	}
+}
+
+func (ui *UI) viewLeaderboard(scope string) {
+
+	user, err := ui.userService.GetUserByID(ui.session.UserID)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to load user profile.", "red", "bold"))
+		return
+	}
+
+	scopeValue := ""
+	switch scope {
+	case models.OrganisationScope:
+		scopeValue = user.StandardUser.Organisation
+	case models.CountryScope:
+		scopeValue = user.StandardUser.Country
+	}
+
+	board, err := ui.leaderboardService.GetLeaderboard(scope, scopeValue)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to load leaderboard:", "red", "bold"), err)
+		return
+	}
+
+	title := "Global standings"
+	if board.ScopeValue != "" {
+		title = fmt.Sprintf("Standings in %s", board.ScopeValue)
+	}
+	fmt.Println(formatting.Colorize(title, "cyan", "bold"))
+
+	if len(board.Entries) == 0 {
+		fmt.Println(formatting.Colorize("No users to rank yet.", "yellow", "bold"))
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Rank", "Username", "Organisation", "Country", "Easy", "Medium", "Hard", "Score"})
+
+		appendRow := func(entry models.LeaderboardEntry) {
+			row := []string{
+				strconv.Itoa(entry.Rank),
+				entry.Username,
+				entry.Organisation,
+				entry.Country,
+				strconv.Itoa(entry.EasySolved),
+				strconv.Itoa(entry.MediumSolved),
+				strconv.Itoa(entry.HardSolved),
+				strconv.Itoa(entry.Score),
+			}
+			// Highlight the row of the active user
+			if entry.UserID == ui.session.UserID {
+				for i := range row {
+					row[i] = formatting.Colorize(row[i], "green", "bold")
+				}
+			}
+			table.Append(row)
+		}
+
+		for i, entry := range board.Entries {
+			if i == config.LEADERBOARD_SIZE {
+				break
+			}
+			appendRow(entry)
+		}
+
+		// Always show where the active user stands, even outside the top spots
+		standing, err := ui.leaderboardService.GetUserStanding(board, ui.session.UserID)
+		if err == nil && standing.Rank > config.LEADERBOARD_SIZE {
+			appendRow(*standing)
+		}
+
+		table.Render()
+	}
+
+	fmt.Println("\nPress any key to go back...")
+
+	_, _ = ui.reader.ReadString('\n')
+}
@@ -2,8 +2,6 @@ package ui
 
 import (
 	"cli-project/internal/app/services"
-	"cli-project/internal/config/roles"
-	"cli-project/pkg/globals"
 	"cli-project/pkg/utils/data_cleaning"
 	"cli-project/pkg/utils/emojis"
 	"cli-project/pkg/utils/formatting"
@@ -53,7 +51,7 @@ func (ui *UI) ShowLoginPage() {
 		fmt.Println()
 
 		// Attempt to log in
-		err := ui.userService.Login(username, password)
+		session, err := ui.userService.Login(username, password)
 		if err != nil {
 
 			var choice string
@@ -97,27 +95,7 @@ func (ui *UI) ShowLoginPage() {
 		} else {
 			fmt.Println(emojis.Success, "Login successful!")
 
-			globals.ActiveUserID, err = ui.userService.GetUserID(username)
-
-			if err != nil {
-				fmt.Println(emojis.Error, "Failed to get user ID:", err)
-				return
-			}
-
-			role, err := ui.userService.GetUserRole(globals.ActiveUserID)
-			banned, err := ui.userService.IsUserBanned(globals.ActiveUserID)
-			if err != nil {
-				fmt.Println("Unexpected Error:", err)
-			}
-
-			if banned {
-				ui.ShowBannedMessage()
-			} else if role == roles.USER {
-				ui.ShowUserMenu()
-			} else if role == roles.ADMIN {
-				ui.ShowAdminMenu()
-			}
-
+			ui.startSession(session)
 		}
 		return
 	}
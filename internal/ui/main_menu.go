@@ -9,6 +9,9 @@ import (
 
 // ShowMainMenu displays the main menu and handles user input
 func (ui *UI) ShowMainMenu() {
+	// Pick up where the last run left off when a session was remembered
+	ui.ResumeStoredSession()
+
 	for {
 		// Clear the screen
 		fmt.Print("\033[H\033[2J")
@@ -1,11 +1,18 @@
 package ui
 
 import (
+	"bytes"
 	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
 	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
 	"fmt"
+	"github.com/olekukonko/tablewriter"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -17,9 +24,11 @@ func (ui *UI) ManageQuestions() {
 		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
 		fmt.Println(formatting.Colorize("          MANAGE QUESTIONS          ", "cyan", "bold"))
 		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("1. Add questions", "", ""))
-		fmt.Println(formatting.Colorize("2. Remove question", "", ""))
-		fmt.Println(formatting.Colorize("3. Go back", "", ""))
+		fmt.Println(formatting.Colorize("1. Import questions from CSV", "", ""))
+		fmt.Println(formatting.Colorize("2. Edit question", "", ""))
+		fmt.Println(formatting.Colorize("3. Remove question", "", ""))
+		fmt.Println(formatting.Colorize("4. Export questions", "", ""))
+		fmt.Println(formatting.Colorize("5. Go back", "", ""))
 
 		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
 		choice, err := ui.reader.ReadString('\n')
@@ -34,8 +43,12 @@ func (ui *UI) ManageQuestions() {
 		case "1":
 			ui.AddQuestions()
 		case "2":
-			ui.RemoveQuestion()
+			ui.EditQuestion()
 		case "3":
+			ui.RemoveQuestion()
+		case "4":
+			ui.ExportQuestions()
+		case "5":
 			return
 		default:
 			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
@@ -64,12 +77,12 @@ func (ui *UI) AddQuestions() {
 	}
 
 	// Ask admin to select a file
-	var choice int
 	var fileName string
 	for {
 
 		fmt.Print(formatting.Colorize("Enter the file number: ", "yellow", "bold"))
-		_, err = fmt.Scan(&choice)
+		input, _ := ui.reader.ReadString('\n')
+		choice, err := strconv.Atoi(strings.TrimSpace(input))
 		if err != nil || choice < 1 || choice > len(files) {
 			fmt.Println(formatting.Colorize("Invalid choice.", "red", "bold"))
 			continue
@@ -89,26 +102,181 @@ func (ui *UI) AddQuestions() {
 	// Construct the full path to the selected file
 	fullFilePath := filepath.Join(config.CSV_DIR, fileName)
 
-	// Call the service method to add questions from the selected file
-	newQuestionsAdded, err := ui.questionService.AddQuestionsFromFile(fullFilePath)
+	// Preview the import before anything is written
+	report, err := ui.questionService.ImportQuestionsFromFile(ui.session.UserID, fullFilePath, true)
 	if err != nil {
-		fmt.Println(formatting.Colorize("Error adding questions from file:", "red", "bold"), fileName, err)
+		fmt.Println(formatting.Colorize("Error reading questions from file:", "red", "bold"), fileName, err)
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
 		return
-	} else if !newQuestionsAdded {
-		fmt.Println(formatting.Colorize("No new questions in the file:", "yellow", "bold"), fileName)
+	}
+
+	ui.renderImportReport(report)
+
+	if report.New+report.Changed == 0 {
+		fmt.Println(formatting.Colorize("No new or changed questions in the file:", "yellow", "bold"), fileName)
+	} else {
+		fmt.Printf("Add %d new and update %d changed questions? (y/n): ", report.New, report.Changed)
+		answer, _ := ui.reader.ReadString('\n')
+		if data_cleaning.CleanString(answer) != "y" {
+			fmt.Println("Import cancelled, nothing was changed.")
+		} else if _, err := ui.questionService.ImportQuestionsFromFile(ui.session.UserID, fullFilePath, false); err != nil {
+			fmt.Println(formatting.Colorize("Error importing questions from file:", "red", "bold"), fileName, err)
+		} else {
+			fmt.Println(formatting.Colorize("Questions successfully imported from file:", "green", "bold"), fileName)
+		}
+	}
+
+	fmt.Println("\nPress any key to go back...")
+
+	_, _ = ui.reader.ReadString('\n')
+
+}
+
+// renderImportReport lists every row that is not unchanged, followed by the totals
+func (ui *UI) renderImportReport(report *models.ImportReport) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"Row", "ID", "Status", "Details"})
+	table.SetAutoWrapText(false)
+
+	for _, row := range report.Rows {
+		var details, status string
+		switch row.Status {
+		case models.ImportRowUnchanged:
+			continue
+		case models.ImportRowNew:
+			status, details = formatting.Colorize(row.Status, "green", ""), row.Question.QuestionTitle
+		case models.ImportRowChanged:
+			status, details = formatting.Colorize(row.Status, "yellow", ""), strings.Join(row.Changes, "; ")
+		case models.ImportRowInvalid:
+			status, details = formatting.Colorize(row.Status, "red", ""), row.Reason
+		}
+		table.Append([]string{strconv.Itoa(row.Row), row.QuestionID, status, details})
+	}
+
+	if table.NumLines() > 0 {
+		table.Render()
+	}
+	fmt.Printf("%d new, %d changed, %d unchanged, %d invalid\n", report.New, report.Changed, report.Unchanged, report.Invalid)
+}
+
+// ExportQuestions writes the bank, or a filtered part of it, to a CSV, JSON or Markdown file
+func (ui *UI) ExportQuestions() {
+	var format string
+	for {
+		fmt.Print("Format - csv, json or markdown (default csv): ")
+		input, _ := ui.reader.ReadString('\n')
+		if strings.TrimSpace(input) == "" {
+			input = models.ExportFormatCSV
+		}
+		valid, err := validation.ValidateExportFormat(input)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		format = valid
+		break
+	}
+
+	fmt.Println(formatting.Colorize("Leave the filters empty to export every question.", "cyan", ""))
+	fmt.Print("Difficulty: ")
+	difficulty, _ := ui.reader.ReadString('\n')
+	fmt.Print("Company: ")
+	company, _ := ui.reader.ReadString('\n')
+	fmt.Print("Topic: ")
+	topic, _ := ui.reader.ReadString('\n')
+
+	extension := format
+	if format == models.ExportFormatMarkdown {
+		extension = "md"
+	}
+	defaultPath := "questions." + extension
+	fmt.Printf("File to write (default %s): ", defaultPath)
+	path, _ := ui.reader.ReadString('\n')
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = defaultPath
+	}
+
+	var buffer bytes.Buffer
+	count, err := ui.questionService.ExportQuestions(ui.session.UserID, &buffer, format, strings.TrimSpace(difficulty), strings.TrimSpace(company), strings.TrimSpace(topic))
+	if err == nil {
+		err = os.WriteFile(path, buffer.Bytes(), 0o644)
+	}
+
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to export questions:", "red", "bold"), err)
 	} else {
-		fmt.Println(formatting.Colorize("Questions successfully added from file:", "green", "bold"), fileName)
+		fmt.Println(emojis.Success, formatting.Colorize(fmt.Sprintf("Exported %d questions to %s", count, path), "green", "bold"))
 	}
 
 	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// EditQuestion prompts for each editable field, keeping the current value when the admin presses Enter
+func (ui *UI) EditQuestion() {
 
+	ui.browseQuestions(false)
+
+	questionID := ui.readQuestionID()
+	question, err := ui.questionService.GetQuestionByID(questionID)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to fetch the question:", "red", "bold"), err)
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
+		return
+	}
+
+	fmt.Println(formatting.Colorize("Press Enter to keep the current value, enter - to clear a tag list.", "cyan", ""))
+
+	var update models.QuestionUpdate
+	update.QuestionTitle = ui.readQuestionField("Title", question.QuestionTitle)
+	update.Difficulty = ui.readQuestionField("Difficulty", question.Difficulty)
+	update.QuestionLink = ui.readQuestionField("Link", question.QuestionLink)
+	update.TopicTags = ui.readQuestionTags("Topic tags", question.TopicTags)
+	update.CompanyTags = ui.readQuestionTags("Company tags", question.CompanyTags)
+
+	updated, err := ui.questionService.UpdateQuestion(ui.session.UserID, questionID, update)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to update the question:", "red", "bold"), err)
+	} else {
+		fmt.Println(emojis.Success, formatting.Colorize("Question updated: "+updated.QuestionTitle, "green", "bold"))
+	}
+
+	fmt.Println("\nPress any key to go back...")
 	_, _ = ui.reader.ReadString('\n')
+}
 
+// readQuestionField returns nil when the answer is empty or the same as the current value
+func (ui *UI) readQuestionField(label, current string) *string {
+	fmt.Printf("%s [%s]: ", label, current)
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	if input == "" || input == current {
+		return nil
+	}
+	return &input
+}
+
+// readQuestionTags reads a comma separated tag list in the same format as the CSV import
+func (ui *UI) readQuestionTags(label string, current []string) *[]string {
+	fmt.Printf("%s [%s]: ", label, strings.Join(current, ", "))
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	switch input {
+	case "":
+		return nil
+	case "-":
+		return &[]string{}
+	}
+	tags := data_cleaning.CleanTags(input)
+	return &tags
 }
 
 func (ui *UI) RemoveQuestion() {
 
-	ui.ViewQuestions()
+	ui.browseQuestions(false)
 	// Placeholder for the remove question logic
 	// Prompt admin to enter the Question ID
 	var questionID string
@@ -125,7 +293,7 @@ func (ui *UI) RemoveQuestion() {
 		break
 	}
 	// Call the QuestionService to remove the question
-	err = ui.questionService.RemoveQuestionByID(questionID)
+	err = ui.questionService.RemoveQuestionByID(ui.session.UserID, questionID)
 	if err != nil {
 		fmt.Println(formatting.Colorize("Failed to remove the question:", "red", "bold"), err)
 		return
@@ -1,99 +1,105 @@
 package ui
 
 import (
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
 	"cli-project/pkg/utils"
 	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
 	"cli-project/pkg/utils/formatting"
 	"cli-project/pkg/validation"
+	"errors"
 	"fmt"
 	"github.com/olekukonko/tablewriter"
+	"go.mongodb.org/mongo-driver/mongo"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
+// ManageUsers lets moderators ban and unban users, role changes are only listed for roles that may make them
 func (ui *UI) ManageUsers() {
-	for {
-		// Clear the screen
-		fmt.Print("\033[H\033[2J")
+	_ = ui.showMenu("MANAGE USERS", "Go back", []menuItem{
+		{"View all users", "", ui.viewAllUsers},
+		{"Ban a user", roles.USERS_BAN, ui.banUser},
+		{"Unban a user", roles.USERS_BAN, ui.unbanUser},
+		{"Review ban appeals", roles.USERS_BAN, ui.reviewBanAppeals},
+		{"Promote a user to admin", roles.USERS_ROLES, ui.promoteUser},
+		{"Demote a user to a standard user", roles.USERS_ROLES, ui.demoteUser},
+		{"Change a user's role", roles.USERS_ROLES, ui.changeUserRole},
+	})
+}
 
-		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("           MANAGE USERS             ", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
-		fmt.Println(formatting.Colorize("1. View all users", "", ""))
-		fmt.Println(formatting.Colorize("2. Ban a user", "", ""))
-		fmt.Println(formatting.Colorize("3. Unban a user", "", ""))
-		fmt.Println(formatting.Colorize("4. Go back", "", ""))
+// viewAllUsers pages through the standard users, it returns when the admin goes back
+func (ui *UI) viewAllUsers() {
+	ui.viewUsers(roles.USER)
+}
 
-		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
-		choice, err := ui.reader.ReadString('\n')
-		choice = strings.TrimSpace(choice)
+// viewUsers pages through the users with the role, or through everyone with their role when role is empty
+func (ui *UI) viewUsers(role string) {
 
+	request := models.PageRequest{Page: 1, PageSize: config.PAGE_SIZE}
+	for {
+		page, err := ui.userService.GetUsersPage(role, request)
 		if err != nil {
-			fmt.Println(formatting.Colorize("error reading input:", "red", "bold"), err)
+			fmt.Println("Failed to load users.")
 			return
 		}
 
-		switch choice {
-		case "1":
-			ui.viewAllUsers()
-		case "2":
-			ui.banUser()
-		case "3":
-			ui.unbanUser()
-		case "4":
-			return // Go back to the previous menu
-		default:
-			fmt.Println(formatting.Colorize("invalid choice", "red", "bold"))
+		// If no users found, notify the admin
+		if page.Total == 0 {
+			fmt.Println("No users found.")
+			return
 		}
-	}
-}
-
-func (ui *UI) viewAllUsers() {
-	// Get all users from the user service
-	users, err := ui.userService.GetAllUsers()
-	if err != nil {
-		fmt.Println("Failed to load users.")
-		return
-	}
 
-	// If no users found, notify the admin
-	if len(*users) == 0 {
-		fmt.Println("No users found.")
-		return
-	}
-
-	// Create a new table writer to format the output as a table
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"Username", "Name", "Email", "Leetcode ID", "Organisation", "Country", "IsBlocked", "Last Seen (IST)"})
-
-	// Enable column wrapping and row lines for better readability
-	table.SetAutoWrapText(true)
-	table.SetRowLine(true)
-
-	// Print table rows, excluding admin users
-	for _, user := range *users {
-		if user.StandardUser.Role != "user" {
-			continue
+		// Create a new table writer to format the output as a table
+		table := tablewriter.NewWriter(os.Stdout)
+		header := []string{"Username", "Name", "Email", "Leetcode ID", "Organisation", "Country", "IsBlocked", "Last Seen (IST)"}
+		if role == "" {
+			header = append(header, "Role")
+		}
+		table.SetHeader(header)
+
+		// Enable column wrapping and row lines for better readability
+		table.SetAutoWrapText(true)
+		table.SetRowLine(true)
+
+		for _, user := range page.Users {
+			// Convert Last Seen time to IST and format it
+			lastSeenIST := utils.ConvertToIST(user.LastSeen)
+
+			// Add the row to the table
+			row := []string{
+				user.StandardUser.Username,
+				user.StandardUser.Name,
+				user.StandardUser.Email,
+				user.LeetcodeID,
+				user.StandardUser.Organisation,
+				user.StandardUser.Country,
+				fmt.Sprintf("%t", user.StandardUser.IsBanned),
+				lastSeenIST,
+			}
+			if role == "" {
+				row = append(row, user.StandardUser.Role)
+			}
+			table.Append(row)
 		}
 
-		// Convert Last Seen time to IST and format it
-		lastSeenIST := utils.ConvertToIST(user.LastSeen)
+		// Render the table to the console
+		table.Render()
 
-		// Add the row to the table
-		table.Append([]string{
-			user.StandardUser.Username,
-			user.StandardUser.Name,
-			user.StandardUser.Email,
-			user.LeetcodeID,
-			user.StandardUser.Organisation,
-			user.StandardUser.Country,
-			fmt.Sprintf("%t", user.StandardUser.IsBanned),
-			lastSeenIST,
-		})
+		next, command := ui.readPageCommand(page.PageInfo, "[s] sort")
+		switch {
+		case next > 0:
+			request.Page = next
+		case command == "s":
+			request = ui.readSortOrder(request, models.SortByUsername, models.SortByLastSeen)
+		default:
+			return
+		}
 	}
-
-	// Render the table to the console
-	table.Render()
 }
 
 func (ui *UI) banUser() {
@@ -122,11 +128,34 @@ func (ui *UI) banUser() {
 		break
 	}
 
+	// The banned user sees the reason and expiry when they log in
+	var reason string
+	for reason == "" {
+		if reason, err = ui.readLine("Reason for the ban: "); err != nil {
+			return
+		}
+	}
+
+	var expiresAt time.Time
+	for {
+		input, err := ui.readLine("Ban until - a date like 2006-01-02, 7d, 36h or never (press enter for never): ")
+		if err != nil {
+			return
+		}
+		expiresAt, err = validation.ValidateExpiry(input, time.Now())
+		if err != nil {
+			fmt.Println(emojis.Error, err)
+			continue
+		}
+		break
+	}
+
 	// banning logic
-	alreadyBanned, err := ui.userService.BanUser(username)
-	if err != nil {
+	alreadyBanned, err := ui.userService.BanUser(ui.session.UserID, username, reason, expiresAt)
+	if errors.Is(err, mongo.ErrNoDocuments) {
 		fmt.Println(formatting.Colorize("user does not exist", "red", "bold"))
-		return
+	} else if err != nil {
+		fmt.Println(emojis.Error, err)
 	} else if alreadyBanned {
 		fmt.Println(formatting.Colorize("user already banned", "yellow", "bold"))
 	} else {
@@ -139,6 +168,103 @@ func (ui *UI) banUser() {
 
 }
 
+func (ui *UI) promoteUser() {
+
+	// Staff can be promoted too, so list every role
+	ui.viewUsers("")
+
+	username, err := ui.readUsername("Enter the username to promote: ")
+	if err != nil {
+		return
+	}
+	alreadyAdmin, err := ui.userService.PromoteUser(ui.session.UserID, username)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		fmt.Println(formatting.Colorize("user does not exist", "red", "bold"))
+	} else if err != nil {
+		fmt.Println(emojis.Error, err)
+	} else if alreadyAdmin {
+		fmt.Println(formatting.Colorize("user is already an admin", "yellow", "bold"))
+	} else {
+		fmt.Println(formatting.Colorize("user promoted to admin", "green", "bold"))
+	}
+
+	fmt.Println("\nPress any key to go back...")
+
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func (ui *UI) demoteUser() {
+
+	// Staff are not in the standard users list, so list every role
+	ui.viewUsers("")
+
+	username, err := ui.readUsername("Enter the username of the staff member to demote: ")
+	if err != nil {
+		return
+	}
+	alreadyUser, err := ui.userService.DemoteUser(ui.session.UserID, username)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		fmt.Println(formatting.Colorize("user does not exist", "red", "bold"))
+	} else if err != nil {
+		fmt.Println(emojis.Error, err)
+	} else if alreadyUser {
+		fmt.Println(formatting.Colorize("user is already a standard user", "yellow", "bold"))
+	} else {
+		fmt.Println(formatting.Colorize("user demoted to a standard user", "green", "bold"))
+	}
+
+	fmt.Println("\nPress any key to go back...")
+
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// changeUserRole gives a user any of the built in roles
+func (ui *UI) changeUserRole() {
+	ui.viewUsers("")
+
+	username, err := ui.readUsername("Enter the username: ")
+	if err != nil {
+		return
+	}
+	role, err := ui.readLine(fmt.Sprintf("New role (%s): ", strings.Join(roles.All, ", ")))
+	if err != nil {
+		return
+	}
+	role = data_cleaning.CleanString(role)
+	if !roles.IsValid(role) {
+		fmt.Println(formatting.Colorize(fmt.Sprintf("%q is not a role, it must be one of %s", role, strings.Join(roles.All, ", ")), "red", "bold"))
+	} else if alreadyHad, err := ui.userService.ChangeUserRole(ui.session.UserID, username, role); errors.Is(err, mongo.ErrNoDocuments) {
+		fmt.Println(formatting.Colorize("user does not exist", "red", "bold"))
+	} else if err != nil {
+		fmt.Println(emojis.Error, err)
+	} else if alreadyHad {
+		fmt.Println(formatting.Colorize("user already has the "+role+" role", "yellow", "bold"))
+	} else {
+		fmt.Println(formatting.Colorize("user is now a "+role, "green", "bold"))
+	}
+
+	fmt.Println("\nPress any key to go back...")
+
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// readUsername asks until a valid username is entered
+func (ui *UI) readUsername(prompt string) (string, error) {
+	for {
+		fmt.Print(prompt)
+		username, err := ui.reader.ReadString('\n')
+		if err != nil {
+			fmt.Println(formatting.Colorize("error reading input:", "red", "bold"), err)
+			return "", err
+		}
+		username = data_cleaning.CleanString(username)
+		if validation.ValidateUsername(username) {
+			return username, nil
+		}
+		fmt.Println(formatting.Colorize("enter a valid username", "yellow", "bold"))
+	}
+}
+
 func (ui *UI) unbanUser() {
 
 	// View all users
@@ -166,10 +292,11 @@ func (ui *UI) unbanUser() {
 	}
 
 	// Unbanning logic
-	alreadyUnbanned, err := ui.userService.UnbanUser(username)
-	if err != nil {
-		fmt.Println(formatting.Colorize("user does not exist", "red", "bold"), err)
-		return
+	alreadyUnbanned, err := ui.userService.UnbanUser(ui.session.UserID, username)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		fmt.Println(formatting.Colorize("user does not exist", "red", "bold"))
+	} else if err != nil {
+		fmt.Println(emojis.Error, err)
 	} else if alreadyUnbanned {
 		fmt.Println(formatting.Colorize("user already unbanned", "yellow", "bold"))
 	} else {
@@ -181,3 +308,63 @@ func (ui *UI) unbanUser() {
 	_, _ = ui.reader.ReadString('\n')
 
 }
+
+// reviewBanAppeals lists the pending appeals and lets the admin accept or reject them one at a time
+func (ui *UI) reviewBanAppeals() {
+	for {
+		appeals, err := ui.userService.GetBanAppeals(ui.session.UserID, models.AppealPending)
+		if err != nil {
+			fmt.Println(emojis.Error, "Failed to load ban appeals:", err)
+			return
+		}
+		if len(*appeals) == 0 {
+			fmt.Println(formatting.Colorize("There are no appeals waiting for review", "yellow", "bold"))
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"#", "Username", "Reason", "Banned Until", "Appeal", "Submitted"})
+		table.SetAutoWrapText(true)
+		table.SetRowLine(true)
+		for i, ban := range *appeals {
+			table.Append([]string{
+				strconv.Itoa(i + 1),
+				ban.Username,
+				ban.Reason,
+				banExpiry(&ban),
+				ban.Appeal.Message,
+				ban.Appeal.SubmittedAt.Local().Format("02 Jan 2006 15:04"),
+			})
+		}
+		table.Render()
+
+		input, err := ui.readLine("Enter the appeal number to resolve (press enter to go back): ")
+		if err != nil || input == "" {
+			return
+		}
+		number, err := strconv.Atoi(input)
+		if err != nil || number < 1 || number > len(*appeals) {
+			fmt.Println(formatting.Colorize("Invalid appeal number", "red", "bold"))
+			continue
+		}
+		ban := (*appeals)[number-1]
+
+		accept, err := ui.readConfirm(fmt.Sprintf("Lift the ban on %s? (y to accept, n to reject): ", ban.Username))
+		if err != nil {
+			return
+		}
+		response, err := ui.readLine("Response to the user (press enter to skip): ")
+		if err != nil {
+			return
+		}
+		if _, err := ui.userService.ResolveBanAppeal(ui.session.UserID, ban.ID, accept, response); err != nil {
+			fmt.Println(emojis.Error, err)
+		} else if accept {
+			fmt.Println(emojis.Success, "Appeal accepted, the ban is lifted")
+		} else {
+			fmt.Println(emojis.Success, "Appeal rejected")
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package ui
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/pkg/utils/formatting"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// menuItem is one option of a staff menu, shown only when the role grants its permission.
+// An empty permission shows the option to everyone.
+type menuItem struct {
+	label      string
+	permission string
+	action     func()
+}
+
+// showMenu numbers the options the role may use, followed by the exit option, and runs them until
+// the exit option is chosen. It returns an error if the input could not be read.
+func (ui *UI) showMenu(title, exitLabel string, items []menuItem) error {
+	allowed := make([]menuItem, 0, len(items))
+	for _, item := range items {
+		if item.permission == "" || roles.Has(ui.role, item.permission) {
+			allowed = append(allowed, item)
+		}
+	}
+
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize(strings.Repeat(" ", max(0, (36-len(title))/2))+title, "cyan", "bold"))
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		for i, item := range allowed {
+			fmt.Println(formatting.Colorize(fmt.Sprintf("%d. %s", i+1, item.label), "", ""))
+		}
+		fmt.Println(formatting.Colorize(fmt.Sprintf("%d. %s", len(allowed)+1, exitLabel), "", ""))
+
+		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
+		choice, err := ui.reader.ReadString('\n')
+		choice = strings.TrimSpace(choice)
+
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error reading input:", "red", "bold"), err)
+			return err
+		}
+
+		number, err := strconv.Atoi(choice)
+		switch {
+		case err != nil || number < 1 || number > len(allowed)+1:
+			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
+		case number == len(allowed)+1:
+			return nil
+		default:
+			allowed[number-1].action()
+		}
+	}
+}
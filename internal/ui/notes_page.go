@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/editor"
+	"cli-project/pkg/utils/formatting"
+	"errors"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strings"
+)
+
+// ViewNotesPage lists the user's notes, from where a note can be opened or the notes searched
+func (ui *UI) ViewNotesPage() {
+
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("              MY NOTES              ", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+		notes, err := ui.noteService.GetNotes(ui.session.UserID)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Failed to load your notes:", "red", "bold"), err)
+			return
+		}
+
+		if len(*notes) == 0 {
+			fmt.Println("You have no notes yet. Open a question's note from the question list to write one.")
+		} else {
+			table := tablewriter.NewWriter(os.Stdout)
+			table.SetHeader([]string{"Question", "First Line", "Snippets", "Updated"})
+			for _, note := range *notes {
+				table.Append([]string{
+					note.QuestionID,
+					noteFirstLine(note.Body),
+					fmt.Sprint(len(note.Snippets)),
+					note.UpdatedAt.Local().Format("02 Jan 2006"),
+				})
+			}
+			table.SetAutoWrapText(true)
+			table.Render()
+		}
+
+		fmt.Println(formatting.Colorize("[o] open a note  [s] search notes  [Enter] go back", "cyan", ""))
+		fmt.Print("> ")
+		input, _ := ui.reader.ReadString('\n')
+
+		switch data_cleaning.CleanString(input) {
+		case "o":
+			ui.openNote(ui.readQuestionID())
+		case "s":
+			ui.SearchNotes()
+		default:
+			return
+		}
+	}
+}
+
+// SearchNotes shows the user's notes containing every word of a query
+func (ui *UI) SearchNotes() {
+
+	fmt.Print("Search your notes: ")
+	query, _ := ui.reader.ReadString('\n')
+
+	matches, err := ui.noteService.SearchNotes(ui.session.UserID, query)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Error searching notes:", "red", "bold"), err)
+	} else if len(*matches) == 0 {
+		fmt.Println(formatting.Colorize("No notes match the search", "yellow", "bold"))
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"Question", "Match", "Updated"})
+		for _, match := range *matches {
+			table.Append([]string{match.Note.QuestionID, match.Excerpt, match.Note.UpdatedAt.Local().Format("02 Jan 2006")})
+		}
+		table.SetAutoWrapText(true)
+		table.Render()
+	}
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// openNote shows the user's note on a question, and lets them edit it in their editor or delete it
+func (ui *UI) openNote(questionID string) {
+	if questionID == "" {
+		return
+	}
+
+	exists, err := ui.questionService.QuestionExists(questionID)
+	if err != nil || !exists {
+		fmt.Println(formatting.Colorize(fmt.Sprintf("Question with ID %s does not exist", questionID), "red", "bold"))
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
+		return
+	}
+
+	for {
+		body := ""
+		note, err := ui.noteService.GetNote(ui.session.UserID, questionID)
+		if err == nil {
+			body = note.Body
+		} else if !errors.Is(err, services.ErrNoteNotFound) {
+			fmt.Println(formatting.Colorize("Failed to load your note:", "red", "bold"), err)
+			return
+		}
+
+		fmt.Println(formatting.Colorize(fmt.Sprintf("\nNote on question %s", questionID), "cyan", "bold"))
+		if body == "" {
+			fmt.Println("You have no note on this question yet.")
+		} else {
+			fmt.Println(body)
+			if len(note.Snippets) > 0 {
+				languages := make([]string, 0, len(note.Snippets))
+				for _, snippet := range note.Snippets {
+					if snippet.Language == "" {
+						languages = append(languages, "plain")
+					} else {
+						languages = append(languages, snippet.Language)
+					}
+				}
+				fmt.Println(formatting.Colorize("Snippets: "+strings.Join(languages, ", "), "yellow", ""))
+			}
+			fmt.Println("Last edited " + note.UpdatedAt.Local().Format("02 Jan 2006 15:04"))
+		}
+
+		actions := "[e] edit in " + editor.Command()[0]
+		if body != "" {
+			actions += "  [d] delete"
+		}
+		fmt.Println(formatting.Colorize(actions+"  [Enter] go back", "cyan", ""))
+		fmt.Print("> ")
+		input, _ := ui.reader.ReadString('\n')
+
+		switch data_cleaning.CleanString(input) {
+		case "e":
+			ui.editNote(questionID, body)
+		case "d":
+			if body == "" {
+				return
+			}
+			if err := ui.noteService.DeleteNote(ui.session.UserID, questionID); err != nil {
+				fmt.Println(formatting.Colorize("Failed to delete the note:", "red", "bold"), err)
+			} else {
+				fmt.Println(formatting.Colorize("Note deleted", "green", "bold"))
+			}
+		default:
+			return
+		}
+	}
+}
+
+// editNote opens the note in the user's editor and saves what they write, an emptied note is deleted
+func (ui *UI) editNote(questionID, body string) {
+	if body != "" {
+		body += "\n"
+	}
+
+	edited, err := editor.Edit(body, ".md", os.Stdin, os.Stdout, os.Stderr)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Could not open the editor:", "red", "bold"), err)
+		return
+	}
+	edited = strings.TrimSpace(edited)
+
+	switch {
+	case edited == strings.TrimSpace(body):
+		fmt.Println("Note unchanged")
+	case edited == "":
+		if err := ui.noteService.DeleteNote(ui.session.UserID, questionID); err != nil {
+			fmt.Println(formatting.Colorize("Failed to delete the note:", "red", "bold"), err)
+			return
+		}
+		fmt.Println(formatting.Colorize("Note deleted", "green", "bold"))
+	default:
+		if _, err := ui.noteService.SaveNote(ui.session.UserID, questionID, edited); err != nil {
+			fmt.Println(formatting.Colorize("Failed to save the note:", "red", "bold"), err)
+			return
+		}
+		fmt.Println(formatting.Colorize("Note saved", "green", "bold"))
+	}
+}
+
+// noteFirstLine returns the first non-blank line of a note, without Markdown heading marks
+func noteFirstLine(body string) string {
+	for _, line := range strings.Split(body, "\n") {
+		if line = strings.TrimSpace(strings.TrimLeft(line, "#")); line != "" {
+			return line
+		}
+	}
+	return ""
+}
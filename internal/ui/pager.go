@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// readPageCommand shows where the user is in a paged table and reads what to do next. It returns the
+// page to show next, or 0 together with the command when the input is not a paging command.
+func (ui *UI) readPageCommand(info models.PageInfo, actions string) (int, string) {
+	lastPage := max(info.TotalPages, 1)
+	fmt.Printf("Page %d of %d, %d in total\n", info.Page, lastPage, info.Total)
+	fmt.Println(formatting.Colorize("[n] next  [p] previous  [page number] jump  "+actions+"  [Enter] go back", "cyan", ""))
+	fmt.Print("> ")
+
+	input, _ := ui.reader.ReadString('\n')
+	command := data_cleaning.CleanString(input)
+
+	switch command {
+	case "n":
+		return min(info.Page+1, lastPage), ""
+	case "p":
+		return max(info.Page-1, 1), ""
+	}
+	if page, err := strconv.Atoi(command); err == nil {
+		return min(max(page, 1), lastPage), ""
+	}
+	return 0, command
+}
+
+// readSortOrder asks which field to sort by, a trailing "desc" sorts in descending order. The listing
+// goes back to its first page, and the request is left as it is when the answer is not a valid field.
+func (ui *UI) readSortOrder(request models.PageRequest, fields ...string) models.PageRequest {
+	fmt.Printf("Sort by %s (add desc for descending order): ", strings.Join(fields, ", "))
+	input, _ := ui.reader.ReadString('\n')
+
+	words := strings.Fields(data_cleaning.CleanString(input))
+	descending := len(words) > 1 && words[len(words)-1] == "desc"
+	if descending {
+		words = words[:len(words)-1]
+	}
+
+	sortBy, err := validation.ValidateSortField(strings.Join(words, " "), fields...)
+	if err != nil {
+		fmt.Println(formatting.Colorize(err.Error(), "red", ""))
+		return request
+	}
+
+	request.Page, request.SortBy, request.Descending = 1, sortBy, descending
+	return request
+}
@@ -54,7 +54,7 @@ func (ui *UI) DisplayPlatformStats() {
 	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
 
 	// Fetch the number of active users in the last 24 hours
-	activeUsers, err := ui.userService.CountActiveUserInLast24Hours()
+	activeUsers, err := ui.userService.CountActiveUserInLast24Hours(ui.session.UserID)
 	if err != nil {
 		fmt.Println(formatting.Colorize("Error fetching active users count: ", "red", "bold"), err)
 		return
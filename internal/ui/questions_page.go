@@ -1,12 +1,17 @@
 package ui
 
 import (
+	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
 	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
 	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
 	"fmt"
 	"github.com/olekukonko/tablewriter"
 	"os"
 	"strings"
+	"time"
 )
 
 func (ui *UI) ViewQuestionsPage() {
@@ -19,7 +24,9 @@ func (ui *UI) ViewQuestionsPage() {
 		fmt.Println(formatting.Colorize("              QUESTIONS             ", "cyan", "bold"))
 		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
 		fmt.Println("1. View questions")
-		fmt.Println("2. Go back")
+		fmt.Println("2. Search questions")
+		fmt.Println("3. My notes")
+		fmt.Println("4. Go back")
 		fmt.Print("Enter your choice : ")
 
 		// Read user input
@@ -31,6 +38,10 @@ func (ui *UI) ViewQuestionsPage() {
 		case "1":
 			ui.ViewQuestions()
 		case "2":
+			ui.SearchQuestions()
+		case "3":
+			ui.ViewNotesPage()
+		case "4":
 			return
 		default:
 			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
@@ -40,27 +51,130 @@ func (ui *UI) ViewQuestionsPage() {
 
 }
 
+// ViewQuestions pages through the question bank, marking the questions the user has solved or should revise
 func (ui *UI) ViewQuestions() {
+	ui.browseQuestions(true)
+}
+
+// browseQuestions pages through the question bank until the user goes back. With progress it adds the
+// user's solve status to every row, completion per difficulty in the footer, a toggle to hide solved rows
+// and a way to open the user's note on a question.
+func (ui *UI) browseQuestions(progress bool) {
+
+	var status *questionStatus
+	if progress {
+		var err error
+		if status, err = ui.loadQuestionStatus(); err != nil {
+			fmt.Println(formatting.Colorize("Failed to load your progress:", "red", "bold"), err)
+			return
+		}
+	}
+
+	request := models.PageRequest{Page: 1, PageSize: config.PAGE_SIZE}
+	hideSolved := false
+	for {
+		var excludeIDs []string
+		if hideSolved {
+			excludeIDs = status.solvedIDs
+		}
+
+		page, err := ui.questionService.GetQuestionsPage(request, excludeIDs)
+		if err != nil {
+			fmt.Println("Failed to load questions")
+			return
+		}
+
+		// If no questions found, notify the user
+		if page.Total == 0 && !hideSolved {
+			fmt.Println("Trouble loading questions. Try again later.")
+			return
+		}
+
+		actions := "[s] sort  [f] filter"
+		if progress {
+			actions += "  [o] open note"
+			if hideSolved {
+				actions += "  [h] show solved"
+			} else {
+				actions += "  [h] hide solved"
+			}
+			renderQuestionProgressTable(page.Questions, status)
+		} else {
+			renderQuestionTable(page.Questions)
+		}
+
+		next, command := ui.readPageCommand(page.PageInfo, actions)
+		switch {
+		case next > 0:
+			request.Page = next
+		case command == "s":
+			request = ui.readSortOrder(request, models.SortByID, models.SortByTitle, models.SortByDifficulty)
+		case command == "o" && progress:
+			ui.openNote(ui.readQuestionID())
+		case command == "h" && progress:
+			hideSolved = !hideSolved
+			request.Page = 1
+		case command == "f":
+			ui.ViewFilteredQuestions()
+			return
+		default:
+			return
+		}
+	}
+}
 
-	// Load all questions in the db
-	questionsList, err := ui.questionService.GetAllQuestions()
+// questionStatus is the signed in user's progress on the question bank
+type questionStatus struct {
+	solvedIDs  []string
+	solvedAt   map[string]time.Time
+	due        map[string]bool
+	completion []models.DifficultyCompletion
+}
+
+// loadQuestionStatus collects when each question was last solved and which ones are due for revision
+func (ui *UI) loadQuestionStatus() (*questionStatus, error) {
+	user, err := ui.userService.GetUserByID(ui.session.UserID)
 	if err != nil {
-		fmt.Println("Failed to load questions")
-		return
+		return nil, err
+	}
+	cards, err := ui.reviewService.GetDueReviews(ui.session.UserID)
+	if err != nil {
+		return nil, err
+	}
+	completion, err := ui.questionService.GetCompletionByDifficulty(user.QuestionsSolved)
+	if err != nil {
+		return nil, err
 	}
 
-	// If no questions found, notify the user
-	if len(*questionsList) == 0 {
-		fmt.Println("Trouble loading questions. Try again later.")
-		return
+	status := &questionStatus{
+		solvedIDs:  user.QuestionsSolved,
+		solvedAt:   make(map[string]time.Time, len(user.QuestionsSolved)),
+		due:        make(map[string]bool, len(*cards)),
+		completion: *completion,
+	}
+	for _, questionID := range user.QuestionsSolved {
+		status.solvedAt[questionID] = time.Time{}
+	}
+	for _, event := range user.SolveHistory {
+		if event.SolvedAt.After(status.solvedAt[event.QuestionID]) {
+			status.solvedAt[event.QuestionID] = event.SolvedAt
+		}
+	}
+	for _, card := range *cards {
+		status.due[card.QuestionID] = true
 	}
+	return status, nil
+}
+
+// renderQuestionTable prints questions with their link next to the title
+func renderQuestionTable(questions []models.Question) {
 
 	// Create a new table writer to format the output as a table
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader([]string{"ID", "Title", "Difficulty", "Topic-Tags", "Company-Tags"})
 
 	// Print table rows
-	for _, question := range *questionsList {
+	for _, question := range questions {
 		// Convert slices to comma-separated strings for display
 		topicTags := strings.Join(question.TopicTags, ", ")
 		companyTags := strings.Join(question.CompanyTags, ", ")
@@ -84,43 +198,144 @@ func (ui *UI) ViewQuestions() {
 
 	// Render the table to the console
 	table.Render()
+}
+
+// renderQuestionProgressTable prints questions with the user's solve status, and the share of each
+// difficulty they have solved in the footer
+func renderQuestionProgressTable(questions []models.Question, status *questionStatus) {
+
+	header := []string{"ID", "Title", "Difficulty", "Topic-Tags", "Company-Tags", "Solved", "Solved On", "Revise"}
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader(header)
+
+	for _, question := range questions {
+		solved, solvedOn, revise := "", "", ""
+		if solvedAt, ok := status.solvedAt[question.QuestionID]; ok {
+			solved = emojis.Success
+			solvedOn = "-"
+			if !solvedAt.IsZero() {
+				solvedOn = solvedAt.Local().Format("02 Jan 2006")
+			}
+		}
+		if status.due[question.QuestionID] {
+			revise = formatting.Colorize("due", "yellow", "bold")
+		}
+
+		table.Append([]string{
+			question.QuestionID,
+			fmt.Sprintf("%s (%s)", question.QuestionTitle, question.QuestionLink),
+			question.Difficulty,
+			strings.Join(question.TopicTags, ", "),
+			strings.Join(question.CompanyTags, ", "),
+			solved,
+			solvedOn,
+			revise,
+		})
+	}
 
-	// Prompt user for input
-	fmt.Println("Press 'f' to view filtered questions or any other key to return")
-	var input string
-	_, err = fmt.Scanln(&input)
+	// One completion figure per difficulty, from easy to hard, under the first columns
+	footer := []string{"Completed"}
+	for _, level := range status.completion {
+		percent := 0
+		if level.Total > 0 {
+			percent = level.Solved * 100 / level.Total
+		}
+		footer = append(footer, fmt.Sprintf("%s %d%% (%d/%d)", level.Difficulty, percent, level.Solved, level.Total))
+	}
+	for len(footer) < len(header) {
+		footer = append(footer, " ")
+	}
+	table.SetFooter(footer)
+
+	table.SetAutoWrapText(true)
+	table.SetRowLine(true)
+	table.Render()
+}
+
+// SearchQuestions shows the questions whose title or tags best match a free text query
+func (ui *UI) SearchQuestions() {
+
+	fmt.Print("Search by title, topic or company: ")
+	query, _ := ui.reader.ReadString('\n')
+	query = strings.TrimSpace(query)
+
+	results, err := ui.questionService.SearchQuestions(query, config.SEARCH_LIMIT)
 	if err != nil {
+		fmt.Println(formatting.Colorize("Error searching questions:", "red", "bold"), err)
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
 		return
 	}
 
-	// Check the input and call ViewFilteredQuestions if input is 'f'
-	if strings.ToLower(input) == "f" {
-		ui.ViewFilteredQuestions()
+	if len(*results) == 0 {
+		fmt.Println(formatting.Colorize("No questions match the search", "yellow", "bold"))
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ID", "Title", "Difficulty", "Matched On", "Topic-Tags", "Company-Tags"})
+
+		for _, result := range *results {
+			question := result.Question
+			table.Append([]string{
+				question.QuestionID,
+				fmt.Sprintf("%s (%s)", question.QuestionTitle, question.QuestionLink),
+				question.Difficulty,
+				result.MatchedOn,
+				strings.Join(question.TopicTags, ", "),
+				strings.Join(question.CompanyTags, ", "),
+			})
+		}
+
+		table.SetAutoWrapText(true)
+		table.SetRowLine(true)
+		table.Render()
 	}
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
 }
 
+// ViewFilteredQuestions asks for each part of a question filter, skipped prompts match every question
 func (ui *UI) ViewFilteredQuestions() {
 
-	// Prompt for difficulty
-	fmt.Print("Enter difficulty (press enter to skip): ")
-	difficulty, _ := ui.reader.ReadString('\n')
-	difficulty = strings.TrimSuffix(difficulty, "\n")
-	difficulty = data_cleaning.CleanString(difficulty)
+	var filter models.QuestionFilter
+	filter.Difficulties = ui.readFilterList("Enter difficulties, comma separated (press enter to skip): ")
+
+	filter.Topics = ui.readFilterList("Enter topics, comma separated (press enter to skip): ")
+	if len(filter.Topics) > 1 {
+		filter.MatchAllTopics = ui.confirmFilter("Only show questions with all of these topics? (y/n): ")
+	}
+
+	filter.Companies = ui.readFilterList("Enter companies, comma separated (press enter to skip): ")
+	if len(filter.Companies) > 1 {
+		filter.MatchAllCompanies = ui.confirmFilter("Only show questions asked by all of these companies? (y/n): ")
+	}
 
-	// Prompt for topic
-	fmt.Print("Enter topic (press enter to skip): ")
-	topic, _ := ui.reader.ReadString('\n')
-	topic = strings.TrimSuffix(topic, "\n")
-	topic = data_cleaning.CleanString(topic)
+	filter.ExcludeTopics = ui.readFilterList("Enter topics to leave out (press enter to skip): ")
+	filter.ExcludeCompanies = ui.readFilterList("Enter companies to leave out (press enter to skip): ")
 
-	// Prompt for company
-	fmt.Print("Enter company (press enter to skip): ")
-	company, _ := ui.reader.ReadString('\n')
-	company = strings.TrimSuffix(company, "\n")
-	company = data_cleaning.CleanString(company)
+	for {
+		fmt.Print("Enter status - solved or unsolved (press enter to skip): ")
+		input, _ := ui.reader.ReadString('\n')
+		status, err := validation.ValidateSolveStatus(input)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		filter.Status = status
+		break
+	}
+
+	if filter.Status != "" {
+		user, err := ui.userService.GetUserByID(ui.session.UserID)
+		if err != nil {
+			fmt.Printf("Error fetching your progress: %v\n", err)
+			return
+		}
+		filter.SolvedQuestionIDs = user.QuestionsSolved
+	}
 
 	// Fetch filtered questions
-	filteredQuestions, err := ui.questionService.GetQuestionsByFilters(difficulty, topic, company)
+	filteredQuestions, err := ui.questionService.FilterQuestions(filter)
 	if err != nil {
 		fmt.Printf("Error fetching filtered questions: %v\n", err)
 		return
@@ -132,38 +347,26 @@ func (ui *UI) ViewFilteredQuestions() {
 		return
 	}
 
-	// Create a new table writer to format the output as a table
-	table := tablewriter.NewWriter(os.Stdout)
-	table.SetHeader([]string{"ID", "Title", "Difficulty", "Topic-Tags", "Company-Tags"})
-
-	// Print table rows
-	for _, question := range *filteredQuestions {
-		// Convert slices to comma-separated strings for display
-		topicTags := strings.Join(question.TopicTags, ", ")
-		companyTags := strings.Join(question.CompanyTags, ", ")
-
-		// Create a title with a hyperlink
-		titleWithLink := fmt.Sprintf("%s (%s)", question.QuestionTitle, question.QuestionLink)
-
-		// Add the row to the table
-		table.Append([]string{
-			question.QuestionID,
-			titleWithLink,
-			question.Difficulty,
-			topicTags,
-			companyTags,
-		})
-	}
-
-	// Enable column wrapping
-	table.SetAutoWrapText(true)
-	table.SetRowLine(true)
-
-	// Render the table to the console
-	table.Render()
+	renderQuestionTable(*filteredQuestions)
 
 	// Prompt the user to go back
 	fmt.Println("\nPress any key to go back...")
 
 	_, _ = ui.reader.ReadString('\n')
 }
+
+// readFilterList reads a comma separated list of filter values, an empty answer gives an empty list
+func (ui *UI) readFilterList(prompt string) []string {
+	fmt.Print(prompt)
+	input, _ := ui.reader.ReadString('\n')
+	if strings.TrimSpace(input) == "" {
+		return nil
+	}
+	return data_cleaning.CleanTags(input)
+}
+
+func (ui *UI) confirmFilter(prompt string) bool {
+	fmt.Print(prompt)
+	input, _ := ui.reader.ReadString('\n')
+	return data_cleaning.CleanString(input) == "y"
+}
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/emojis"
+	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ShowRecommendationsPage asks what the user is preparing for and recommends what to solve next
+func (ui *UI) ShowRecommendationsPage() {
+	// Clear the screen
+	fmt.Print("\033[H\033[2J")
+
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("     WHAT SHOULD I SOLVE NEXT?      ", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+	var options models.RecommendationOptions
+
+	fmt.Print("Target company (optional): ")
+	options.TargetCompany, _ = ui.reader.ReadString('\n')
+
+	for {
+		fmt.Print("Difficulty progression - gradual, easy, medium or hard (default gradual): ")
+		progression, _ := ui.reader.ReadString('\n')
+		progression, err := validation.ValidateProgression(progression)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		options.Progression = progression
+		break
+	}
+
+	report, err := ui.recommender.RecommendQuestions(ui.session.UserID, options)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Could not recommend questions: ", "red", "bold"), err)
+		fmt.Println("\nPress any key to go back...")
+		_, _ = ui.reader.ReadString('\n')
+		return
+	}
+
+	ui.renderRecommendations(report)
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func (ui *UI) renderRecommendations(report *models.RecommendationReport) {
+	fmt.Println(formatting.Colorize("\nYour level: ", "cyan", "bold") + report.Level)
+
+	if len(report.WeakTopics) > 0 {
+		weak := make([]string, 0, len(report.WeakTopics))
+		for _, topic := range report.WeakTopics {
+			weak = append(weak, fmt.Sprintf("%s (%d/%d)", topic.Tag, topic.Solved, topic.Total))
+		}
+		fmt.Println(formatting.Colorize("Weakest topics: ", "cyan", "bold") + strings.Join(weak, ", "))
+	}
+
+	if len(report.Recommendations) == 0 {
+		fmt.Println(emojis.Success, "You have solved every question in the bank!")
+		return
+	}
+
+	fmt.Println()
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "ID", "Title", "Difficulty", "Why"})
+	table.SetAutoWrapText(false)
+
+	for i, recommendation := range report.Recommendations {
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			recommendation.Question.QuestionID,
+			fmt.Sprintf("%s (%s)", recommendation.Question.QuestionTitle, recommendation.Question.QuestionLink),
+			recommendation.Question.Difficulty,
+			strings.Join(recommendation.Reasons, "; "),
+		})
+	}
+
+	table.Render()
+}
@@ -0,0 +1,88 @@
+package ui
+
+import (
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/credentials"
+	"cli-project/pkg/utils/emojis"
+	"cli-project/pkg/utils/formatting"
+	"errors"
+	"fmt"
+)
+
+// ResumeStoredSession continues a session saved by a previous run, if it is still valid.
+func (ui *UI) ResumeStoredSession() {
+	creds, err := credentials.Load(credentials.DefaultPath())
+	if err != nil {
+		return
+	}
+
+	session, err := ui.userService.ResumeSession(creds.Token)
+	if err != nil {
+		// Stale tokens are dropped so the user is simply asked to log in
+		_ = credentials.Remove(credentials.DefaultPath())
+		return
+	}
+
+	fmt.Printf("%s Welcome back, %s!\n", emojis.Success, session.Username)
+	ui.startSession(session)
+}
+
+// startSession remembers the session and routes the user to the menu for their role
+func (ui *UI) startSession(session *models.Session) {
+	ui.session = session
+
+	err := credentials.Save(credentials.DefaultPath(), &credentials.Credentials{
+		Username:  session.Username,
+		Token:     session.Token,
+		ExpiresAt: session.ExpiresAt,
+	})
+	if err != nil {
+		fmt.Println(formatting.Colorize("Could not remember session: ", "yellow", ""), err)
+	}
+
+	role, err := ui.userService.GetUserRole(session.UserID)
+	if err != nil {
+		fmt.Println("Unexpected Error:", err)
+		return
+	}
+
+	banned, err := ui.userService.IsUserBanned(session.UserID)
+	if err != nil {
+		fmt.Println("Unexpected Error:", err)
+		return
+	}
+
+	if banned {
+		ui.ShowBannedMessage()
+		if err := ui.endSession(); err != nil {
+			fmt.Println(formatting.Colorize("Error logging out: ", "red", "bold"), err)
+		}
+	} else if roles.IsStaff(role) {
+		ui.role = role
+		ui.ShowUnreadAnnouncements()
+		ui.ShowAdminMenu()
+	} else {
+		ui.role = role
+		ui.ShowUnreadAnnouncements()
+		ui.ShowUserMenu()
+	}
+}
+
+// endSession logs the active session out and forgets the stored credentials
+func (ui *UI) endSession() error {
+	if ui.session == nil {
+		return errors.New("no active session")
+	}
+
+	err := ui.userService.Logout(ui.session)
+	ui.session = nil
+	ui.role = ""
+
+	// Remove the stored token even if the server side logout failed
+	if removeErr := credentials.Remove(credentials.DefaultPath()); removeErr != nil && err == nil {
+		err = removeErr
+	}
+
+	return err
+}
@@ -0,0 +1,310 @@
+package ui
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
+	"cli-project/pkg/utils/formatting"
+	"cli-project/pkg/validation"
+	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// ShowStudyListsPage lists the user's own and shared study lists and lets the user open or create one
+func (ui *UI) ShowStudyListsPage() {
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("            STUDY LISTS             ", "cyan", "bold"))
+		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+
+		lists, err := ui.studyListService.GetStudyLists(ui.session.UserID)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error fetching study lists: ", "red", "bold"), err)
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		if len(*lists) == 0 {
+			fmt.Println("No study lists yet. Create one to group questions, e.g. \"Blind 75\".")
+		} else {
+			ui.renderStudyLists(*lists)
+		}
+
+		fmt.Println(formatting.Colorize("\n1. Open a list", "", ""))
+		fmt.Println(formatting.Colorize("2. Create a list", "", ""))
+		fmt.Println(formatting.Colorize("3. Go back", "", ""))
+
+		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
+		choice, err := ui.reader.ReadString('\n')
+		choice = strings.TrimSpace(choice)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error reading input:", "red", "bold"), err)
+			return
+		}
+
+		switch choice {
+		case "1":
+			if list := ui.chooseStudyList(*lists); list != nil {
+				ui.ShowStudyListPage(list.ID)
+			}
+		case "2":
+			ui.createStudyList()
+		case "3":
+			return
+		default:
+			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
+		}
+	}
+}
+
+func (ui *UI) renderStudyLists(lists []models.StudyList) {
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "Name", "Owner", "Visibility", "Questions", "Description"})
+	table.SetAutoWrapText(false)
+
+	for i, list := range lists {
+		visibility := "private"
+		if list.Shared {
+			visibility = "shared"
+		}
+		owner := list.OwnerName
+		if list.OwnerID == ui.session.UserID {
+			owner = "you"
+		}
+		table.Append([]string{
+			strconv.Itoa(i + 1),
+			list.Name,
+			owner,
+			visibility,
+			strconv.Itoa(len(list.QuestionIDs)),
+			list.Description,
+		})
+	}
+
+	table.Render()
+}
+
+// chooseStudyList asks for a list by its number in the table
+func (ui *UI) chooseStudyList(lists []models.StudyList) *models.StudyList {
+	if len(lists) == 0 {
+		return nil
+	}
+
+	fmt.Print("Enter the list number: ")
+	choice, _ := ui.reader.ReadString('\n')
+	index, err := strconv.Atoi(strings.TrimSpace(choice))
+	if err != nil || index < 1 || index > len(lists) {
+		fmt.Println(formatting.Colorize("Invalid list number.", "red", "bold"))
+		fmt.Println("\nPress any key to continue...")
+		_, _ = ui.reader.ReadString('\n')
+		return nil
+	}
+	return &lists[index-1]
+}
+
+func (ui *UI) createStudyList() {
+	var name string
+	for {
+		fmt.Print("List name: ")
+		input, _ := ui.reader.ReadString('\n')
+		valid, err := validation.ValidateStudyListName(input)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		name = valid
+		break
+	}
+
+	fmt.Print("Description (optional): ")
+	description, _ := ui.reader.ReadString('\n')
+
+	fmt.Print("Share this list with everyone? (y/n): ")
+	answer, _ := ui.reader.ReadString('\n')
+	shared := data_cleaning.CleanString(answer) == "y"
+
+	list, err := ui.studyListService.CreateStudyList(ui.session.UserID, name, description, shared)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to create list: ", "red", "bold"), err)
+		fmt.Println("\nPress any key to continue...")
+		_, _ = ui.reader.ReadString('\n')
+		return
+	}
+
+	fmt.Println(emojis.Success, formatting.Colorize("Created "+list.Name, "green", "bold"))
+	ui.ShowStudyListPage(list.ID)
+}
+
+// ShowStudyListPage shows one list with the user's progress through it
+func (ui *UI) ShowStudyListPage(listID string) {
+	for {
+		// Clear the screen
+		fmt.Print("\033[H\033[2J")
+
+		progress, err := ui.studyListService.GetStudyListProgress(ui.session.UserID, listID)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error fetching study list: ", "red", "bold"), err)
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		ui.renderStudyList(progress)
+
+		if !progress.CanEdit {
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+
+		share := "Share with everyone"
+		if progress.List.Shared {
+			share = "Make private"
+		}
+		fmt.Println(formatting.Colorize("\n1. Add a question", "", ""))
+		fmt.Println(formatting.Colorize("2. Remove a question", "", ""))
+		fmt.Println(formatting.Colorize("3. Move a question", "", ""))
+		fmt.Println(formatting.Colorize("4. "+share, "", ""))
+		fmt.Println(formatting.Colorize("5. Delete list", "", ""))
+		fmt.Println(formatting.Colorize("6. Go back", "", ""))
+
+		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
+		choice, err := ui.reader.ReadString('\n')
+		choice = strings.TrimSpace(choice)
+		if err != nil {
+			fmt.Println(formatting.Colorize("Error reading input:", "red", "bold"), err)
+			return
+		}
+
+		switch choice {
+		case "1":
+			questionID := ui.readQuestionID()
+			fmt.Printf("Position (1-%d, press Enter to add at the end): ", len(progress.List.QuestionIDs)+1)
+			position, ok := ui.readPosition()
+			if ok {
+				ui.reportListChange(ui.studyListService.AddQuestionToList(ui.session.UserID, listID, questionID, position))
+			}
+		case "2":
+			questionID := ui.readQuestionID()
+			ui.reportListChange(ui.studyListService.RemoveQuestionFromList(ui.session.UserID, listID, questionID))
+		case "3":
+			questionID := ui.readQuestionID()
+			fmt.Printf("New position (1-%d): ", len(progress.List.QuestionIDs))
+			position, ok := ui.readPosition()
+			if ok {
+				ui.reportListChange(ui.studyListService.MoveQuestionInList(ui.session.UserID, listID, questionID, position))
+			}
+		case "4":
+			ui.reportListChange(ui.studyListService.SetStudyListShared(ui.session.UserID, listID, !progress.List.Shared))
+		case "5":
+			fmt.Printf("Delete %s? This cannot be undone (y/n): ", progress.List.Name)
+			answer, _ := ui.reader.ReadString('\n')
+			if data_cleaning.CleanString(answer) != "y" {
+				continue
+			}
+			if err := ui.studyListService.DeleteStudyList(ui.session.UserID, listID); err != nil {
+				ui.reportListChange(err)
+				continue
+			}
+			fmt.Println(emojis.Success, "List deleted")
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		case "6":
+			return
+		default:
+			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
+		}
+	}
+}
+
+func (ui *UI) renderStudyList(progress *models.StudyListProgress) {
+	list := progress.List
+
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	fmt.Println(formatting.Colorize("  "+strings.ToUpper(list.Name), "cyan", "bold"))
+	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
+	if list.Description != "" {
+		fmt.Println(list.Description)
+	}
+
+	percent := 0.0
+	if progress.Total > 0 {
+		percent = float64(progress.Solved) / float64(progress.Total) * 100
+	}
+	fmt.Println(formatting.Colorize(fmt.Sprintf("Progress: %d/%d solved (%.0f%%)", progress.Solved, progress.Total, percent), "green", "bold"))
+
+	if len(progress.Items) == 0 {
+		fmt.Println("\nThis list has no questions yet.")
+		return
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetHeader([]string{"#", "ID", "Title", "Difficulty", "Solved"})
+	table.SetAutoWrapText(false)
+
+	for _, item := range progress.Items {
+		title, solved := fmt.Sprintf("%s (%s)", item.Question.QuestionTitle, item.Question.QuestionLink), ""
+		if item.Missing {
+			title = "(question removed)"
+		}
+		if item.Solved {
+			solved = formatting.Colorize("✔", "green", "bold")
+		}
+		table.Append([]string{
+			strconv.Itoa(item.Position),
+			item.Question.QuestionID,
+			title,
+			item.Question.Difficulty,
+			solved,
+		})
+	}
+
+	table.Render()
+}
+
+func (ui *UI) readQuestionID() string {
+	for {
+		fmt.Print("Enter the ID of the question: ")
+		questionID, _ := ui.reader.ReadString('\n')
+		questionID = data_cleaning.CleanString(questionID)
+		if valid, err := validation.ValidateQuestionID(questionID); !valid {
+			fmt.Println(err)
+			continue
+		}
+		return questionID
+	}
+}
+
+// readPosition reads an optional 1-based position, an empty answer means 0
+func (ui *UI) readPosition() (int, bool) {
+	input, _ := ui.reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, true
+	}
+
+	position, err := strconv.Atoi(input)
+	if err != nil || position < 1 {
+		ui.reportListChange(fmt.Errorf("invalid position: must be a positive number"))
+		return 0, false
+	}
+	return position, true
+}
+
+func (ui *UI) reportListChange(err error) {
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to update list: ", "red", "bold"), err)
+	} else {
+		fmt.Println(emojis.Success, formatting.Colorize("List updated", "green", "bold"))
+	}
+	fmt.Println("\nPress any key to continue...")
+	_, _ = ui.reader.ReadString('\n')
+}
@@ -3,22 +3,39 @@ package ui
 import (
 	"bufio"
 	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
 )
 
 // UI struct holds the UserService, bufio.Reader, and other dependencies
 type UI struct {
-	authService     interfaces.AuthService
-	userService     interfaces.UserService
-	questionService interfaces.QuestionService
-	reader          *bufio.Reader
+	authService        interfaces.AuthService
+	userService        interfaces.UserService
+	questionService    interfaces.QuestionService
+	leaderboardService interfaces.LeaderboardService
+	reviewService      interfaces.ReviewService
+	recommender        interfaces.RecommendationService
+	studyListService   interfaces.StudyListService
+	noteService        interfaces.NoteService
+	announcements      interfaces.AnnouncementService
+	audit              interfaces.AuditService
+	reader             *bufio.Reader
+	session            *models.Session
+	role               string
 }
 
 // NewUI initializes the UI with the provided services and a bufio.Reader
-func NewUI(authService interfaces.AuthService, userService interfaces.UserService, questionService interfaces.QuestionService, reader *bufio.Reader) *UI {
+func NewUI(authService interfaces.AuthService, userService interfaces.UserService, questionService interfaces.QuestionService, leaderboardService interfaces.LeaderboardService, reviewService interfaces.ReviewService, recommender interfaces.RecommendationService, studyListService interfaces.StudyListService, noteService interfaces.NoteService, announcements interfaces.AnnouncementService, audit interfaces.AuditService, reader *bufio.Reader) *UI {
 	return &UI{
-		authService:     authService,
-		userService:     userService,
-		questionService: questionService,
-		reader:          reader, // Initialize the reader to read from standard input
+		authService:        authService,
+		userService:        userService,
+		questionService:    questionService,
+		leaderboardService: leaderboardService,
+		reviewService:      reviewService,
+		recommender:        recommender,
+		studyListService:   studyListService,
+		noteService:        noteService,
+		announcements:      announcements,
+		audit:              audit,
+		reader:             reader, // Initialize the reader to read from standard input
 	}
 }
@@ -1,10 +1,15 @@
 package ui
 
 import (
+	"bytes"
+	"cli-project/internal/domain/models"
 	"cli-project/pkg/utils/data_cleaning"
+	"cli-project/pkg/utils/emojis"
 	"cli-project/pkg/utils/formatting"
 	"cli-project/pkg/validation"
 	"fmt"
+	"github.com/olekukonko/tablewriter"
+	"os"
 	"strings"
 )
 
@@ -17,7 +22,10 @@ func (ui *UI) UpdateProgressPage() {
 		fmt.Println(formatting.Colorize("           UPDATE PROGRESS          ", "cyan", "bold"))
 		fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
 		fmt.Println(formatting.Colorize("1. Update progress", "", ""))
-		fmt.Println(formatting.Colorize("2. Go back", "", ""))
+		fmt.Println(formatting.Colorize("2. Sync from Leetcode", "", ""))
+		fmt.Println(formatting.Colorize("3. Export progress", "", ""))
+		fmt.Println(formatting.Colorize("4. Import progress", "", ""))
+		fmt.Println(formatting.Colorize("5. Go back", "", ""))
 
 		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
 		choice, err := ui.reader.ReadString('\n')
@@ -32,6 +40,12 @@ func (ui *UI) UpdateProgressPage() {
 		case "1":
 			ui.updateProgress()
 		case "2":
+			ui.syncLeetcodeProgress()
+		case "3":
+			ui.exportProgress()
+		case "4":
+			ui.importProgress()
+		case "5":
 			return
 		default:
 			fmt.Println(formatting.Colorize("Invalid choice. Please select a valid option.", "red", "bold"))
@@ -57,19 +71,174 @@ func (ui *UI) updateProgress() {
 		}
 		break
 	}
+
+	event := models.SolveEvent{QuestionID: questionID}
+
+	// The remaining details are optional
+	for {
+		fmt.Print("Time taken in minutes (optional): ")
+		minutes, _ := ui.reader.ReadString('\n')
+		minutes = strings.TrimSpace(minutes)
+		if minutes == "" {
+			break
+		}
+		timeTaken, err := validation.ValidateMinutes(minutes)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		event.TimeTaken = timeTaken
+		break
+	}
+
+	fmt.Print("Language (optional): ")
+	event.Language, _ = ui.reader.ReadString('\n')
+
+	fmt.Print("Notes (optional): ")
+	event.Notes, _ = ui.reader.ReadString('\n')
+
 	// Update the user's progress by marking the selected question as done
-	progressUpdated, err := ui.userService.UpdateUserProgress(questionID)
+	progressUpdated, err := ui.userService.UpdateUserProgress(ui.session.UserID, event)
 
 	if err != nil {
 		fmt.Println(formatting.Colorize("Failed to update progress: ", "red", "bold"), err)
 		return
 	} else if !progressUpdated {
-		fmt.Println(formatting.Colorize("Question already marked as done", "yellow", "bold"))
+		fmt.Println(formatting.Colorize("Question already marked as done, revisit recorded", "yellow", "bold"))
 	} else {
 		fmt.Println(formatting.Colorize("Updated progress successfully", "green", "bold"))
 	}
 
+	ui.rateRecall(questionID)
+
+	fmt.Println("\nPress any key to go back...")
+
+	_, _ = ui.reader.ReadString('\n')
+}
+
+// syncLeetcodeProgress marks recent accepted Leetcode submissions as solved and reports what was added
+func (ui *UI) syncLeetcodeProgress() {
+	fmt.Println(formatting.Colorize("Fetching recent accepted submissions from Leetcode...", "cyan", ""))
+
+	report, err := ui.userService.SyncLeetcodeProgress(ui.session.UserID)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to sync progress: ", "red", "bold"), err)
+		if report == nil {
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+	}
+
+	if len(report.Added) == 0 {
+		fmt.Println(formatting.Colorize("Nothing new to add, your progress is up to date", "yellow", "bold"))
+	} else {
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetHeader([]string{"ID", "Title", "Solved On", "Status"})
+		table.SetAutoWrapText(false)
+
+		for _, solve := range report.Added {
+			status := "revisit recorded"
+			if solve.FirstSolve {
+				status = formatting.Colorize("marked as done", "green", "")
+			}
+			table.Append([]string{solve.QuestionID, solve.QuestionTitle, solve.SolvedAt.Local().Format("02 Jan 2006 15:04"), status})
+
+			// Synced solves have no recall rating, so schedule them as recalled well
+			if _, err := ui.reviewService.RecordReview(ui.session.UserID, solve.QuestionID, models.RecallGood); err != nil {
+				fmt.Println(formatting.Colorize("Failed to schedule revision: ", "red", "bold"), err)
+			}
+		}
+		table.Render()
+		fmt.Println(formatting.Colorize(fmt.Sprintf("Added %d solve(s) from Leetcode", len(report.Added)), "green", "bold"))
+	}
+
+	if report.Skipped > 0 {
+		fmt.Printf("%d submission(s) were already synced\n", report.Skipped)
+	}
+	if len(report.Unmatched) > 0 {
+		titles := make([]string, 0, len(report.Unmatched))
+		for _, submission := range report.Unmatched {
+			titles = append(titles, submission.Title)
+		}
+		fmt.Println(formatting.Colorize("Not in the question bank: ", "yellow", "") + strings.Join(titles, ", "))
+	}
+
 	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func (ui *UI) exportProgress() {
+	defaultPath := "codesage-progress.json"
+	fmt.Printf("File to write (default %s): ", defaultPath)
+	path, _ := ui.reader.ReadString('\n')
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = defaultPath
+	}
+
+	var buffer bytes.Buffer
+	err := ui.userService.ExportProgress(ui.session.UserID, &buffer)
+	if err == nil {
+		err = os.WriteFile(path, buffer.Bytes(), 0o600)
+	}
+
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to export progress: ", "red", "bold"), err)
+	} else {
+		fmt.Println(emojis.Success, formatting.Colorize("Progress exported to "+path, "green", "bold"))
+	}
 
+	fmt.Println("\nPress any key to go back...")
 	_, _ = ui.reader.ReadString('\n')
 }
+
+func (ui *UI) importProgress() {
+	fmt.Print("Progress file to import: ")
+	path, _ := ui.reader.ReadString('\n')
+	path = strings.TrimSpace(path)
+
+	report, err := ui.importProgressFile(path)
+	if err != nil {
+		fmt.Println(formatting.Colorize("Failed to import progress: ", "red", "bold"), err)
+		if report == nil {
+			fmt.Println("\nPress any key to go back...")
+			_, _ = ui.reader.ReadString('\n')
+			return
+		}
+	}
+
+	for _, solve := range report.Added {
+		// Imported solves have no recall rating, so schedule the newly solved ones as recalled well
+		if !solve.FirstSolve {
+			continue
+		}
+		if _, err := ui.reviewService.RecordReview(ui.session.UserID, solve.QuestionID, models.RecallGood); err != nil {
+			fmt.Println(formatting.Colorize("Failed to schedule revision: ", "red", "bold"), err)
+		}
+	}
+
+	fmt.Println(formatting.Colorize(fmt.Sprintf("Added %d solve(s)", len(report.Added)), "green", "bold"))
+	if report.Skipped > 0 {
+		fmt.Printf("%d solve(s) were already recorded\n", report.Skipped)
+	}
+	if len(report.Unmatched) > 0 {
+		fmt.Println(formatting.Colorize("Not in the question bank: ", "yellow", "") + strings.Join(report.Unmatched, ", "))
+	}
+	for _, conflict := range report.Conflicts {
+		fmt.Println(formatting.Colorize("Skipped: ", "yellow", "") + conflict)
+	}
+
+	fmt.Println("\nPress any key to go back...")
+	_, _ = ui.reader.ReadString('\n')
+}
+
+func (ui *UI) importProgressFile(path string) (*models.ProgressImportReport, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return ui.userService.ImportProgress(ui.session.UserID, file)
+}
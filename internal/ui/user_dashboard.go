@@ -1,9 +1,10 @@
 package ui
 
 import (
-	"cli-project/pkg/globals"
+	"cli-project/internal/config"
 	"cli-project/pkg/utils/formatting"
 	"fmt"
+	"strings"
 )
 
 // ShowUserDashboard displays the user's Leetcode stats on the dashboard.
@@ -16,25 +17,48 @@ func (ui *UI) ShowUserDashboard() {
 	fmt.Println(formatting.Colorize("====================================", "cyan", "bold"))
 
 	// Fetch Leetcode stats (assuming you have a method to get these stats)
-	stats, err := ui.userService.GetLeetcodeStats(globals.ActiveUserID)
+	stats, err := ui.userService.GetLeetcodeStats(ui.session.UserID)
 	if err != nil {
 		fmt.Println("Error fetching stats:", err)
-		return
-	}
-
-	// Display stats with color coding
-	fmt.Println(formatting.Colorize("Questions solved", "cyan", "bold"))
-	fmt.Println(formatting.Colorize(fmt.Sprintf("Easy : %d/%d", stats.EasyDoneCount, stats.TotalEasyCount), "green", ""))
-	fmt.Println(formatting.Colorize(fmt.Sprintf("Medium : %d/%d", stats.MediumDoneCount, stats.TotalMediumCount), "yellow", ""))
-	fmt.Println(formatting.Colorize(fmt.Sprintf("Hard : %d/%d", stats.HardDoneCount, stats.TotalHardCount), "red", ""))
+	} else {
+		// Display stats with color coding
+		fmt.Println(formatting.Colorize("Questions solved", "cyan", "bold"))
+		fmt.Println(formatting.Colorize(fmt.Sprintf("Easy : %d/%d", stats.EasyDoneCount, stats.TotalEasyCount), "green", ""))
+		fmt.Println(formatting.Colorize(fmt.Sprintf("Medium : %d/%d", stats.MediumDoneCount, stats.TotalMediumCount), "yellow", ""))
+		fmt.Println(formatting.Colorize(fmt.Sprintf("Hard : %d/%d", stats.HardDoneCount, stats.TotalHardCount), "red", ""))
 
-	// Display recent AC submissions
-	fmt.Println(formatting.Colorize("\nRecent Accepted Submissions", "cyan", "bold"))
-	for _, submission := range stats.RecentACSubmissions {
-		fmt.Println("- " + submission)
+		// Display recent AC submissions
+		fmt.Println(formatting.Colorize("\nRecent Accepted Submissions", "cyan", "bold"))
+		for _, submission := range stats.RecentACSubmissions {
+			fmt.Println("- " + submission)
+		}
 	}
 
+	ui.showStreaks()
+	ui.showActivityHeatmap()
+	ui.showDailyActivity()
+
 	fmt.Println("\nPress any key to go back...")
 
 	_, _ = ui.reader.ReadString('\n')
 }
+
+// showDailyActivity prints how many questions were solved on each of the last few days
+func (ui *UI) showDailyActivity() {
+	activity, err := ui.userService.GetDailyActivity(ui.session.UserID, config.ACTIVITY_HISTORY_DAYS)
+	if err != nil {
+		fmt.Println("Error fetching activity:", err)
+		return
+	}
+
+	fmt.Println(formatting.Colorize(fmt.Sprintf("\nActivity (last %d days)", config.ACTIVITY_HISTORY_DAYS), "cyan", "bold"))
+	for _, day := range *activity {
+		date := day.Date.Format("Mon 02 Jan")
+		if day.Solves == 0 {
+			fmt.Printf("%s  -\n", date)
+			continue
+		}
+		bar := formatting.Colorize(strings.Repeat("■", day.Solves), "green", "")
+		fmt.Printf("%s  %s %d (%s)\n", date, bar, day.Solves, strings.Join(day.Questions, ", "))
+	}
+}
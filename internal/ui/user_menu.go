@@ -19,7 +19,12 @@ func (ui *UI) ShowUserMenu() {
 		fmt.Println(formatting.Colorize("2. View dashboard", "", ""))
 		fmt.Println(formatting.Colorize("3. Update progress", "", ""))
 		fmt.Println(formatting.Colorize("4. View profile", "", ""))
-		fmt.Println(formatting.Colorize("5. Logout", "", ""))
+		fmt.Println(formatting.Colorize("5. View leaderboard", "", ""))
+		fmt.Println(formatting.Colorize("6. Due for revision", "", ""))
+		fmt.Println(formatting.Colorize("7. What should I solve next?", "", ""))
+		fmt.Println(formatting.Colorize("8. Study lists", "", ""))
+		fmt.Println(formatting.Colorize("9. Announcements", "", ""))
+		fmt.Println(formatting.Colorize("10. Logout", "", ""))
 
 		fmt.Print(formatting.Colorize("Enter your choice: ", "yellow", "bold"))
 		choice, err := ui.reader.ReadString('\n')
@@ -40,7 +45,17 @@ func (ui *UI) ShowUserMenu() {
 		case "4":
 			ui.ShowUserProfile()
 		case "5":
-			err := ui.userService.Logout()
+			ui.ShowLeaderboardPage()
+		case "6":
+			ui.ShowDueReviewsPage()
+		case "7":
+			ui.ShowRecommendationsPage()
+		case "8":
+			ui.ShowStudyListsPage()
+		case "9":
+			ui.ShowAnnouncementsPage()
+		case "10":
+			err := ui.endSession()
 			if err != nil {
 				fmt.Println(formatting.Colorize("Error logging out: ", "red", "bold"), err)
 			} else {
@@ -1,7 +1,6 @@
 package ui
 
 import (
-	"cli-project/pkg/globals"
 	"cli-project/pkg/utils/formatting"
 	"fmt"
 )
@@ -11,7 +10,7 @@ func (ui *UI) ShowUserProfile() {
 	fmt.Print("\033[H\033[2J")
 
 	// Fetch the user profile details (assuming `ui.userService.GetUserProfile` returns the user's profile)
-	user, err := ui.userService.GetUserByID(globals.ActiveUserID)
+	user, err := ui.userService.GetUserByID(ui.session.UserID)
 	if err != nil {
 		fmt.Println(formatting.Colorize("Failed to load user profile.", "red", "bold"))
 		return
@@ -1,3 +0,0 @@
-package globals
-
-var ActiveUserID string
@@ -0,0 +1,84 @@
+package credentials
+
+import (
+	"cli-project/internal/config"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+var ErrNoCredentials = errors.New("no stored session")
+
+// Credentials is the session remembered between runs
+type Credentials struct {
+	Username  string    `json:"username"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Dir returns the directory CodeSage keeps its local files in.
+func Dir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return config.CONFIG_DIR
+	}
+	return filepath.Join(home, config.CONFIG_DIR)
+}
+
+// DefaultPath returns the credentials file location, which can be overridden from the environment.
+func DefaultPath() string {
+	if path := os.Getenv(config.CREDENTIALS_ENV); path != "" {
+		return path
+	}
+	return filepath.Join(Dir(), config.CREDENTIALS_FILE)
+}
+
+// Save writes the credentials to path, readable by the current user only.
+func Save(path string, creds *Credentials) error {
+	data, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return fmt.Errorf("could not encode credentials: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("could not create credentials directory: %v", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("could not save credentials: %v", err)
+	}
+	return nil
+}
+
+// Load reads the credentials at path, expired credentials are removed and reported as missing.
+func Load(path string) (*Credentials, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNoCredentials
+		}
+		return nil, fmt.Errorf("could not read credentials: %v", err)
+	}
+
+	var creds Credentials
+	if err := json.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("could not decode credentials: %v", err)
+	}
+
+	if creds.Token == "" || time.Now().After(creds.ExpiresAt) {
+		_ = Remove(path)
+		return nil, ErrNoCredentials
+	}
+
+	return &creds, nil
+}
+
+// Remove deletes the credentials file, a missing file is not an error.
+func Remove(path string) error {
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("could not remove credentials: %v", err)
+	}
+	return nil
+}
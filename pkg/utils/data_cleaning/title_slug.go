@@ -0,0 +1,43 @@
+package data_cleaning
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+)
+
+// TitleSlugFromLink returns the problem slug of a Leetcode link such as
+// https://leetcode.com/problems/two-sum/, or an empty string when the link has none.
+func TitleSlugFromLink(link string) string {
+	parsedURL, err := url.Parse(strings.TrimSpace(link))
+	if err != nil {
+		return ""
+	}
+
+	parts := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
+	for i := 0; i+1 < len(parts); i++ {
+		if parts[i] == "problems" {
+			return strings.ToLower(parts[i+1])
+		}
+	}
+	return ""
+}
+
+// Slugify builds a Leetcode style slug from a question title, e.g. "Pow(x, n)" becomes "powx-n".
+func Slugify(title string) string {
+	var slug strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(strings.TrimSpace(title)) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if dash && slug.Len() > 0 {
+				slug.WriteRune('-')
+			}
+			dash = false
+			slug.WriteRune(r)
+		case unicode.IsSpace(r) || r == '-' || r == '_':
+			dash = true
+		}
+	}
+	return slug.String()
+}
@@ -0,0 +1,48 @@
+package editor
+
+import (
+	"cli-project/internal/config"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Command returns the editor to open files in, taken from the environment and split into its arguments.
+func Command() []string {
+	if command := strings.Fields(os.Getenv(config.EDITOR_ENV)); len(command) > 0 {
+		return command
+	}
+	return []string{config.DEFAULT_EDITOR}
+}
+
+// Edit writes text to a temporary file ending in suffix, opens it in the editor and returns what was saved.
+func Edit(text, suffix string, stdin io.Reader, stdout, stderr io.Writer) (string, error) {
+	file, err := os.CreateTemp("", "codesage-*"+suffix)
+	if err != nil {
+		return "", fmt.Errorf("could not create temporary file: %v", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return "", fmt.Errorf("could not write temporary file: %v", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("could not write temporary file: %v", err)
+	}
+
+	command := Command()
+	cmd := exec.Command(command[0], append(command[1:], file.Name())...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = stdin, stdout, stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor %s failed: %v", command[0], err)
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("could not read edited file: %v", err)
+	}
+	return string(edited), nil
+}
@@ -0,0 +1,128 @@
+package fuzzy
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Normalize lowercases the text and turns every run of punctuation or spaces into one space,
+// so "Two-Sum", "two sum" and " TWO_SUM " all read the same
+func Normalize(text string) string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(fields, " ")
+}
+
+// Score rates how well the query matches the text, from 0 for no match to 1 for the same words.
+// Whole-text and substring matches score highest, then each query word is matched to its closest
+// word in the text, allowing a typo in short words and two in longer ones.
+func Score(query, text string) float64 {
+	query, text = Normalize(query), Normalize(text)
+	if query == "" || text == "" {
+		return 0
+	}
+	if query == text {
+		return 1
+	}
+
+	best := 0.0
+	switch {
+	case strings.HasPrefix(text, query):
+		best = 0.95
+	case strings.Contains(text, query):
+		best = 0.9
+	}
+
+	// Compare without spaces as well, so "twosum" still finds "two sum"
+	if compact := 0.97 * similarity(strings.ReplaceAll(query, " ", ""), strings.ReplaceAll(text, " ", "")); compact > best {
+		best = compact
+	}
+
+	if words := wordScore(strings.Fields(query), strings.Fields(text)); words > best {
+		best = words
+	}
+	return best
+}
+
+// wordScore averages, over the query words, how close each is to its best match in the text.
+// Extra words in the text cost a little so shorter, closer texts rank first.
+func wordScore(queryWords, textWords []string) float64 {
+	total := 0.0
+	for _, queryWord := range queryWords {
+		best := 0.0
+		for _, textWord := range textWords {
+			var score float64
+			switch {
+			case queryWord == textWord:
+				score = 1
+			case len(queryWord) >= 3 && strings.HasPrefix(textWord, queryWord):
+				score = 0.85
+			default:
+				score = similarity(queryWord, textWord)
+			}
+			if score > best {
+				best = score
+			}
+		}
+		total += best
+	}
+
+	score := total / float64(len(queryWords))
+	if extra := len(textWords) - len(queryWords); extra > 0 {
+		score -= 0.02 * float64(extra)
+	}
+	if score < 0 {
+		return 0
+	}
+	return score * 0.9
+}
+
+// similarity is 1 minus the share of characters that have to change, or 0 when more typos
+// than allowed for a word of that length are needed
+func similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+	longest := len([]rune(a))
+	if n := len([]rune(b)); n > longest {
+		longest = n
+	}
+
+	allowed := 2
+	if longest <= 4 {
+		allowed = 1
+	}
+	if longest <= 2 {
+		allowed = 0
+	}
+
+	distance := Levenshtein(a, b)
+	if distance > allowed {
+		return 0
+	}
+	return 1 - float64(distance)/float64(longest)
+}
+
+// Levenshtein counts the single character insertions, deletions and substitutions that turn a into b
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
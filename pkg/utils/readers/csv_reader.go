@@ -22,6 +22,8 @@ func ReadCSV(filePath string) ([][]string, error) {
 	}(file)
 
 	reader := csv.NewReader(file)
+	// Rows may have different lengths, callers check the columns they need
+	reader.FieldsPerRecord = -1
 	records, err := reader.ReadAll()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,35 @@
+package sorting
+
+import (
+	"strconv"
+	"strings"
+)
+
+// DifficultyOrder lists the difficulties from easiest to hardest
+var DifficultyOrder = []string{"easy", "medium", "hard"}
+
+// CompareQuestionIDs orders numeric question IDs by value, so "2" comes before "10", and other IDs as text.
+// It returns a negative number when a comes first, a positive one when b does and 0 when they are equal.
+func CompareQuestionIDs(a, b string) int {
+	x, errA := strconv.Atoi(a)
+	y, errB := strconv.Atoi(b)
+	if errA == nil && errB == nil {
+		return x - y
+	}
+	return strings.Compare(a, b)
+}
+
+// LessQuestionID reports whether question ID a comes before b
+func LessQuestionID(a, b string) bool {
+	return CompareQuestionIDs(a, b) < 0
+}
+
+// DifficultyRank orders difficulties from easy to hard, unknown difficulties rank after hard
+func DifficultyRank(difficulty string) int {
+	for i, level := range DifficultyOrder {
+		if level == difficulty {
+			return i
+		}
+	}
+	return len(DifficultyOrder)
+}
@@ -0,0 +1,72 @@
+package tokens
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const secretSize = 32
+
+var ErrInvalidToken = errors.New("invalid token")
+
+// Sign returns the payload followed by its HMAC-SHA256 signature, both base64url encoded and joined by a dot.
+func Sign(secret, payload []byte) string {
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(signature(secret, encodedPayload))
+}
+
+// Verify checks the signature of a token created by Sign and returns its payload.
+func Verify(secret []byte, token string) ([]byte, error) {
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, ErrInvalidToken
+	}
+
+	tokenSignature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(tokenSignature, signature(secret, encodedPayload)) {
+		return nil, ErrInvalidToken
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	return payload, nil
+}
+
+// LoadOrCreateSecret reads the signing key stored at path, generating and saving a new one the first time.
+func LoadOrCreateSecret(path string) ([]byte, error) {
+	secret, err := os.ReadFile(path)
+	if err == nil && len(secret) >= secretSize {
+		return secret, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("could not read signing key: %v", err)
+	}
+
+	secret = make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("could not generate signing key: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("could not create signing key directory: %v", err)
+	}
+	if err := os.WriteFile(path, secret, 0600); err != nil {
+		return nil, fmt.Errorf("could not save signing key: %v", err)
+	}
+	return secret, nil
+}
+
+func signature(secret []byte, encodedPayload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(encodedPayload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+)
+
+// ValidateAnnouncementAudience accepts everyone, organisation or country, an empty audience means everyone
+func ValidateAnnouncementAudience(audience string) (string, error) {
+	switch data_cleaning.CleanString(audience) {
+	case "", "all", models.AudienceEveryone:
+		return models.AudienceEveryone, nil
+	case models.AudienceOrganisation, "organization", "org":
+		return models.AudienceOrganisation, nil
+	case models.AudienceCountry:
+		return models.AudienceCountry, nil
+	default:
+		return "", errors.New("invalid audience : must be 'everyone', 'organisation' or 'country'")
+	}
+}
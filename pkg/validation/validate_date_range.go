@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// ValidateDateRange parses the bounds of a period, each either a date (2006-01-02, in local time) or an
+// RFC 3339 timestamp. A date for since starts at the beginning of that day and a date for until includes
+// the whole day, so until is returned as the first instant after the period. Empty bounds give the zero time.
+func ValidateDateRange(since, until string) (time.Time, time.Time, error) {
+	from, err := parseRangeBound(since, false)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parseRangeBound(until, true)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if !from.IsZero() && !to.IsZero() && !from.Before(to) {
+		return time.Time{}, time.Time{}, errors.New("invalid date range : the start must be before the end")
+	}
+	return from, to, nil
+}
+
+func parseRangeBound(bound string, end bool) (time.Time, error) {
+	bound = strings.TrimSpace(bound)
+	if bound == "" {
+		return time.Time{}, nil
+	}
+	if timestamp, err := time.Parse(time.RFC3339, bound); err == nil {
+		return timestamp.UTC(), nil
+	}
+	date, err := time.ParseInLocation("2006-01-02", bound, time.Local)
+	if err != nil {
+		return time.Time{}, errors.New("invalid date : must be a date like 2006-01-02 or a timestamp like 2006-01-02T15:04:05Z")
+	}
+	if end {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date.UTC(), nil
+}
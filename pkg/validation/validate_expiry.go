@@ -0,0 +1,35 @@
+package validation
+
+import (
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidateExpiry parses when something expires, either a date (2006-01-02, expiring at the end of that
+// local day), a number of days such as 7d or a duration such as 36h. An empty answer or never gives
+// the zero time, which never expires.
+func ValidateExpiry(expiry string, now time.Time) (time.Time, error) {
+	expiry = data_cleaning.CleanString(expiry)
+	if expiry == "" || expiry == "never" {
+		return time.Time{}, nil
+	}
+
+	var expiresAt time.Time
+	if date, err := time.ParseInLocation("2006-01-02", expiry, time.Local); err == nil {
+		expiresAt = date.AddDate(0, 0, 1)
+	} else if days, err := strconv.Atoi(strings.TrimSuffix(expiry, "d")); err == nil && strings.HasSuffix(expiry, "d") {
+		expiresAt = now.AddDate(0, 0, days)
+	} else if duration, err := time.ParseDuration(expiry); err == nil {
+		expiresAt = now.Add(duration)
+	} else {
+		return time.Time{}, errors.New("invalid expiry : must be a date like 2006-01-02, a number of days like 7d, a duration like 36h or never")
+	}
+
+	if !expiresAt.After(now) {
+		return time.Time{}, errors.New("invalid expiry : must be in the future")
+	}
+	return expiresAt.UTC(), nil
+}
@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+)
+
+// ValidateExportFormat accepts csv, json or markdown, with md as a short form of markdown
+func ValidateExportFormat(format string) (string, error) {
+	format = data_cleaning.CleanString(format)
+
+	switch format {
+	case models.ExportFormatCSV, models.ExportFormatJSON, models.ExportFormatMarkdown:
+		return format, nil
+	case "md":
+		return models.ExportFormatMarkdown, nil
+	default:
+		return "", errors.New("invalid export format : must be 'csv', 'json' or 'markdown'")
+	}
+}
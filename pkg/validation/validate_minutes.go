@@ -0,0 +1,17 @@
+package validation
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ValidateMinutes parses a time taken entered in whole minutes
+func ValidateMinutes(minutes string) (time.Duration, error) {
+	value, err := strconv.Atoi(strings.TrimSpace(minutes))
+	if err != nil || value <= 0 || value > 24*60 {
+		return 0, errors.New("invalid time taken : must be a number of minutes between 1 and 1440")
+	}
+	return time.Duration(value) * time.Minute, nil
+}
@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+)
+
+// ValidateProgression accepts gradual or a fixed difficulty, an empty progression means gradual
+func ValidateProgression(progression string) (string, error) {
+	progression = data_cleaning.CleanString(progression)
+
+	switch progression {
+	case "":
+		return models.ProgressionGradual, nil
+	case models.ProgressionGradual, models.ProgressionEasy, models.ProgressionMedium, models.ProgressionHard:
+		return progression, nil
+	default:
+		return "", errors.New("invalid progression : must be 'gradual', 'easy', 'medium' or 'hard'")
+	}
+}
@@ -1,17 +1,23 @@
 package validation
 
 import (
-	"cli-project/pkg/utils/data_cleaning"
 	"errors"
 	"net/url"
 	"strings"
 )
 
+// ValidateQuestionLink accepts absolute links to leetcode.com or one of its subdomains.
+// Only surrounding whitespace is removed, the path is case sensitive so the link is kept as given.
 func ValidateQuestionLink(link string) (string, error) {
-	lowerLink := data_cleaning.CleanString(link)
-	parsedURL, err := url.Parse(lowerLink)
-	if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" || !strings.Contains(parsedURL.Host, "Leetcode.com") {
+	link = strings.TrimSpace(link)
+	parsedURL, err := url.Parse(link)
+	if err != nil || parsedURL.Scheme == "" || parsedURL.Host == "" || !isLeetcodeHost(parsedURL.Hostname()) {
 		return "", errors.New("invalid question link: must be a valid Leetcode link")
 	}
-	return lowerLink, nil
+	return link, nil
+}
+
+func isLeetcodeHost(host string) bool {
+	host = strings.ToLower(host)
+	return host == "leetcode.com" || strings.HasSuffix(host, ".leetcode.com")
 }
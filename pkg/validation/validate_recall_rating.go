@@ -0,0 +1,23 @@
+package validation
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+)
+
+// ValidateRecallRating accepts again, hard, good or easy and returns the matching SM-2 rating
+func ValidateRecallRating(rating string) (int, error) {
+	ratings := map[string]int{
+		"again": models.RecallAgain,
+		"hard":  models.RecallHard,
+		"good":  models.RecallGood,
+		"easy":  models.RecallEasy,
+	}
+
+	value, ok := ratings[data_cleaning.CleanString(rating)]
+	if !ok {
+		return 0, errors.New("invalid rating : must be 'again', 'hard', 'good' or 'easy'")
+	}
+	return value, nil
+}
@@ -0,0 +1,21 @@
+package validation
+
+import (
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/data_cleaning"
+	"errors"
+)
+
+// ValidateSolveStatus accepts solved or unsolved, an empty status or "any" means both and returns ""
+func ValidateSolveStatus(status string) (string, error) {
+	status = data_cleaning.CleanString(status)
+
+	switch status {
+	case "", "any":
+		return "", nil
+	case models.SolveStatusSolved, models.SolveStatusUnsolved:
+		return status, nil
+	default:
+		return "", errors.New("invalid status : must be 'solved', 'unsolved' or 'any'")
+	}
+}
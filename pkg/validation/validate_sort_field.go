@@ -0,0 +1,19 @@
+package validation
+
+import (
+	"cli-project/pkg/utils/data_cleaning"
+	"fmt"
+	"strings"
+)
+
+// ValidateSortField accepts one of the fields a listing can be sorted by, spaces and dashes may stand in for underscores
+func ValidateSortField(field string, allowed ...string) (string, error) {
+	field = strings.NewReplacer(" ", "_", "-", "_").Replace(data_cleaning.CleanString(field))
+
+	for _, name := range allowed {
+		if field == name {
+			return field, nil
+		}
+	}
+	return "", fmt.Errorf("invalid sort field : must be one of %s", strings.Join(allowed, ", "))
+}
@@ -0,0 +1,23 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ValidateStudyListName trims the name and checks it is 2 to 60 printable characters
+func ValidateStudyListName(name string) (string, error) {
+	name = strings.Join(strings.Fields(name), " ")
+
+	if length := utf8.RuneCountInString(name); length < 2 || length > 60 {
+		return "", errors.New("invalid list name : must be between 2 and 60 characters")
+	}
+	for _, r := range name {
+		if !unicode.IsPrint(r) {
+			return "", errors.New("invalid list name : must only contain printable characters")
+		}
+	}
+	return name, nil
+}
@@ -0,0 +1,858 @@
+package cli_test
+
+import (
+	"bytes"
+	"cli-project/internal/app/services"
+	"cli-project/internal/cli"
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/credentials"
+	mock_services "cli-project/tests/mocks/services"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSession = &models.Session{ID: "session-id", UserID: "user-id", Username: "testuser", Token: "token", ExpiresAt: time.Now().Add(time.Hour)}
+
+// The remaining service mocks are recreated by newTestCLI for every test
+var (
+	mockReviewService      *mock_services.MockReviewService
+	mockRecommenderService *mock_services.MockRecommendationService
+	mockStudyListService   *mock_services.MockStudyListService
+	mockNoteService        *mock_services.MockNoteService
+	mockAnnouncements      *mock_services.MockAnnouncementService
+	mockAuditService       *mock_services.MockAuditService
+)
+
+func newTestCLI(t *testing.T) (*cli.CLI, *mock_services.MockUserService, *mock_services.MockQuestionService, *bytes.Buffer, *bytes.Buffer) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	// Make sure credentials never leak in from the environment running the tests
+	t.Setenv(config.USERNAME_ENV, "")
+	t.Setenv(config.PASSWORD_ENV, "")
+	t.Setenv(config.CREDENTIALS_ENV, filepath.Join(t.TempDir(), "credentials.json"))
+
+	mockUserService := mock_services.NewMockUserService(ctrl)
+	mockQuestionService := mock_services.NewMockQuestionService(ctrl)
+	mockReviewService = mock_services.NewMockReviewService(ctrl)
+	mockRecommenderService = mock_services.NewMockRecommendationService(ctrl)
+	mockStudyListService = mock_services.NewMockStudyListService(ctrl)
+	mockNoteService = mock_services.NewMockNoteService(ctrl)
+	mockAnnouncements = mock_services.NewMockAnnouncementService(ctrl)
+	mockAuditService = mock_services.NewMockAuditService(ctrl)
+	out, errOut := &bytes.Buffer{}, &bytes.Buffer{}
+
+	return cli.NewCLI(mockUserService, mockQuestionService, mockReviewService, mockRecommenderService, mockStudyListService, mockNoteService, mockAnnouncements, mockAuditService, strings.NewReader(""), out, errOut), mockUserService, mockQuestionService, out, errOut
+}
+
+func expectLogin(mockUserService *mock_services.MockUserService, role string, banned bool) {
+	mockUserService.EXPECT().Login("testuser", "Password@123").Return(testSession, nil)
+	mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", Username: "testuser", Role: role, IsBanned: banned},
+	}, nil)
+}
+
+func TestCLI_UnknownCommand(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"dance"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), `unknown command "dance"`)
+}
+
+func TestCLI_NoCredentials(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"questions", "list"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut.String(), "no credentials")
+}
+
+func TestCLI_CredentialsFromEnvironment(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+	t.Setenv(config.USERNAME_ENV, "TestUser")
+	t.Setenv(config.PASSWORD_ENV, "Password@123")
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockQuestionService.EXPECT().FilterQuestions(gomock.Any()).Return(&[]models.Question{
+		{QuestionID: "15", QuestionTitle: "3Sum", Difficulty: "medium"},
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"questions", "list", "--difficulty", "medium", "--company", "google"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "3Sum")
+}
+
+func TestCLI_QuestionsList_Filters(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockQuestionService.EXPECT().FilterQuestions(gomock.Any()).DoAndReturn(func(filter models.QuestionFilter) (*[]models.Question, error) {
+		assert.Equal(t, []string{"medium", "hard"}, filter.Difficulties)
+		assert.Equal(t, []string{"graph"}, filter.Topics)
+		assert.Equal(t, []string{"amazon", "meta"}, filter.Companies)
+		assert.False(t, filter.MatchAllCompanies)
+		assert.Equal(t, []string{"bfs"}, filter.ExcludeTopics)
+		assert.Equal(t, "unsolved", filter.Status)
+		return &[]models.Question{{QuestionID: "207", QuestionTitle: "Course Schedule", Difficulty: "medium"}}, nil
+	})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"questions", "list", "--difficulty", "medium,hard", "--topic", "graph", "--company", "Amazon, Meta",
+		"--exclude-topic", "bfs", "--status", "unsolved", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Course Schedule")
+}
+
+func TestCLI_QuestionsList_InvalidStatus(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"questions", "list", "--status", "attempted", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "invalid status")
+}
+
+func TestCLI_QuestionsSearch(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockQuestionService.EXPECT().SearchQuestions("two sum", 5).Return(&[]models.SearchResult{
+		{Question: models.Question{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy"}, Score: 1, MatchedOn: "title"},
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"questions", "search", "two", "sum", "--limit", "5", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "two sum")
+	assert.Contains(t, out.String(), "title")
+}
+
+func TestCLI_QuestionsSearch_MissingQuery(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"questions", "search"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "missing search query")
+}
+
+func TestCLI_QuestionsNext(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockRecommenderService.EXPECT().RecommendQuestions("user-id", models.RecommendationOptions{TargetCompany: "google", Progression: "medium", Limit: 3}).Return(&models.RecommendationReport{
+		Level:      "medium",
+		WeakTopics: []models.TagCoverage{{Tag: "graphs", Solved: 1, Total: 8}},
+		Recommendations: []models.Recommendation{
+			{Question: models.Question{QuestionID: "200", QuestionTitle: "Number of Islands", Difficulty: "medium"}, Reasons: []string{"asked by google"}},
+		},
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"questions", "next", "--company", "google", "--progression", "medium", "--limit", "3", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Weakest topics: graphs (1/8)")
+	assert.Contains(t, out.String(), "Number of Islands")
+	assert.Contains(t, out.String(), "asked by google")
+}
+
+func TestCLI_QuestionsNext_InvalidProgression(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"questions", "next", "--progression", "sideways"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "invalid progression")
+}
+
+func TestCLI_ListsAdd_ByName(t *testing.T) {
+	c, mockUserService, _, out, errOut := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockStudyListService.EXPECT().GetStudyLists("user-id").Return(&[]models.StudyList{
+		{ID: "shared-id", Name: "Blind 75", OwnerID: "someone-else", Shared: true},
+		{ID: "own-id", Name: "Blind 75", OwnerID: "user-id"},
+	}, nil)
+	// Explicit positions move along with each question so they keep their order
+	mockStudyListService.EXPECT().AddQuestionToList("user-id", "own-id", "1", 2).Return(nil)
+	mockStudyListService.EXPECT().AddQuestionToList("user-id", "own-id", "2", 3).Return(services.ErrQuestionAlreadyInList)
+	mockStudyListService.EXPECT().AddQuestionToList("user-id", "own-id", "3", 3).Return(nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"lists", "add", "blind 75", "1", "2", "3", "--position", "2", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, out.String(), "1: added to Blind 75")
+	assert.Contains(t, out.String(), "3: added to Blind 75")
+	assert.Contains(t, errOut.String(), "2: question is already in the list")
+}
+
+func TestCLI_ListsShow(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockStudyListService.EXPECT().GetStudyLists("user-id").Return(&[]models.StudyList{{ID: "list-id", Name: "Google onsite prep", Shared: true}}, nil)
+	mockStudyListService.EXPECT().GetStudyListProgress("user-id", "list-id").Return(&models.StudyListProgress{
+		List:   models.StudyList{ID: "list-id", Name: "Google onsite prep"},
+		Items:  []models.StudyListItem{{Position: 1, Question: models.Question{QuestionID: "1", QuestionTitle: "Two Sum"}, Solved: true}},
+		Solved: 1,
+		Total:  1,
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"lists", "list-id", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Google onsite prep: 1/1 solved")
+	assert.Contains(t, out.String(), "Two Sum")
+}
+
+func TestCLI_NotesEdit(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	// The editor appends a line to the existing note
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho '```go' >> \"$1\"\necho 'seen := map[int]int{}' >> \"$1\"\necho '```' >> \"$1\"\n"), 0700))
+	t.Setenv(config.EDITOR_ENV, script)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockQuestionService.EXPECT().QuestionExists("1").Return(true, nil)
+	mockNoteService.EXPECT().GetNote("user-id", "1").Return(&models.Note{QuestionID: "1", Body: "Hash map of complements"}, nil)
+	mockNoteService.EXPECT().SaveNote("user-id", "1", "Hash map of complements\n```go\nseen := map[int]int{}\n```").Return(&models.Note{
+		QuestionID: "1",
+		Snippets:   []models.CodeSnippet{{Language: "go", Code: "seen := map[int]int{}"}},
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"notes", "edit", "1", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Saved your note on question 1 with 1 code snippet(s)")
+}
+
+func TestCLI_NotesEdit_EmptiedDeletes(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	script := filepath.Join(t.TempDir(), "editor.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n: > \"$1\"\n"), 0700))
+	t.Setenv(config.EDITOR_ENV, script)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockQuestionService.EXPECT().QuestionExists("1").Return(true, nil)
+	mockNoteService.EXPECT().GetNote("user-id", "1").Return(&models.Note{QuestionID: "1", Body: "old"}, nil)
+	mockNoteService.EXPECT().DeleteNote("user-id", "1").Return(nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"notes", "edit", "1", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Deleted your note on question 1")
+}
+
+func TestCLI_NotesEdit_UnknownQuestion(t *testing.T) {
+	c, mockUserService, mockQuestionService, _, errOut := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockQuestionService.EXPECT().QuestionExists("999").Return(false, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"notes", "edit", "999", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut.String(), "question with ID 999 does not exist")
+}
+
+func TestCLI_NotesSearch(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockNoteService.EXPECT().SearchNotes("user-id", "two pointers").Return(&[]models.NoteMatch{
+		{Note: models.Note{QuestionID: "15"}, Excerpt: "Sort, then two pointers"},
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"notes", "search", "two", "pointers", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Sort, then two pointers")
+}
+
+func TestCLI_Announcements(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockAnnouncements.EXPECT().GetUserAnnouncements("user-id").Return(&[]models.UserAnnouncement{
+		{Announcement: models.Announcement{ID: "new-id", Title: "Contest on Sunday", Body: "Join us", Pinned: true, AuthorName: "admin"}},
+		{Announcement: models.Announcement{ID: "old-id", Title: "Welcome", Body: "Hello"}, Read: true},
+	}, nil)
+	mockAnnouncements.EXPECT().MarkAnnouncementRead("user-id", "new-id").Return(nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"announcements", "--unread", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "[new, pinned] Contest on Sunday")
+	assert.NotContains(t, out.String(), "Welcome")
+}
+
+func TestCLI_AdminAnnounce(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockAnnouncements.EXPECT().CreateAnnouncement("user-id", gomock.Any()).DoAndReturn(func(_ string, draft models.AnnouncementDraft) (*models.Announcement, error) {
+		assert.Equal(t, "Contest", draft.Title)
+		assert.Equal(t, models.AudienceCountry, draft.Audience)
+		assert.Equal(t, "india", draft.Target)
+		assert.True(t, draft.Pinned)
+		assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), draft.ExpiresAt, time.Minute)
+		return &models.Announcement{ID: "announcement-id"}, nil
+	})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "announce", "--title", "Contest", "--message", "Sunday 10am", "--country", "india", "--pin", "--expires", "7d", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Posted announcement announcement-id")
+}
+
+func TestCLI_AdminAnnounce_BothTargets(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"admin", "announce", "--title", "t", "--message", "m", "--country", "india", "--organisation", "acme"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "either --organisation or --country")
+}
+
+func TestCLI_ProgressAdd(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockUserService.EXPECT().UpdateUserProgress("user-id", models.SolveEvent{QuestionID: "202", TimeTaken: 25 * time.Minute, Language: "go"}).Return(true, nil)
+	mockUserService.EXPECT().UpdateUserProgress("user-id", models.SolveEvent{QuestionID: "20", TimeTaken: 25 * time.Minute, Language: "go"}).Return(false, nil)
+	dueAt := time.Date(2030, 1, 2, 12, 0, 0, 0, time.Local)
+	mockReviewService.EXPECT().RecordReview("user-id", "202", models.RecallHard).Return(&models.ReviewCard{DueAt: dueAt}, nil)
+	mockReviewService.EXPECT().RecordReview("user-id", "20", models.RecallHard).Return(&models.ReviewCard{DueAt: dueAt}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	// Flags are accepted after the positional arguments too
+	code := c.Run([]string{"progress", "add", "202", "20", "--minutes", "25", "--language", "go", "--rating", "hard", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "202: marked as done")
+	assert.Contains(t, out.String(), "20: already marked as done, revisit recorded")
+	assert.Contains(t, out.String(), "20: next revision on 02 Jan 2030")
+}
+
+func TestCLI_ProgressSync(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockUserService.EXPECT().SyncLeetcodeProgress("user-id").Return(&models.SyncReport{
+		Added:     []models.SyncedSolve{{QuestionID: "1", QuestionTitle: "Two Sum", SolvedAt: time.Now(), FirstSolve: true}},
+		Skipped:   2,
+		Unmatched: []models.LeetcodeSubmission{{Title: "Some New Problem", TitleSlug: "some-new-problem"}},
+	}, nil)
+	mockReviewService.EXPECT().RecordReview("user-id", "1", models.RecallGood).Return(&models.ReviewCard{}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"progress", "sync", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "1: marked as done (Two Sum")
+	assert.Contains(t, out.String(), `skipped "Some New Problem"`)
+	assert.Contains(t, out.String(), "added 1 solve(s), 2 already synced, 1 not in the question bank")
+}
+
+func TestCLI_ProgressImport(t *testing.T) {
+	c, mockUserService, _, out, errOut := newTestCLI(t)
+
+	path := filepath.Join(t.TempDir(), "progress.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version": 1}`), 0o600))
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockUserService.EXPECT().ImportProgress("user-id", gomock.Any()).Return(&models.ProgressImportReport{
+		Added: []models.SyncedSolve{
+			{QuestionID: "1", QuestionTitle: "two sum", FirstSolve: true},
+			{QuestionID: "1", QuestionTitle: "two sum"},
+		},
+		Skipped:   3,
+		Unmatched: []string{"999"},
+		Conflicts: []string{`question 15 is "3sum-closest" here but "3sum" in the export`},
+	}, nil)
+	// Only the first solve of a question gets a revision scheduled
+	mockReviewService.EXPECT().RecordReview("user-id", "1", models.RecallGood).Return(&models.ReviewCard{}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"progress", "import", path, "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "1: marked as done (two sum)")
+	assert.Contains(t, out.String(), "1: revisit recorded (two sum)")
+	assert.Contains(t, out.String(), "added 2 solve(s), 3 already recorded, 1 not in the question bank, 1 conflict(s)")
+	assert.Contains(t, errOut.String(), "skipped question 15")
+}
+
+func TestCLI_ProgressExport(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	path := filepath.Join(t.TempDir(), "progress.json")
+
+	expectLogin(mockUserService, roles.USER, false)
+	mockUserService.EXPECT().ExportProgress("user-id", gomock.Any()).DoAndReturn(func(userID string, writer io.Writer) error {
+		_, err := io.WriteString(writer, `{"version": 1}`)
+		return err
+	})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"progress", "export", "--output", path, "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "progress exported to "+path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `{"version": 1}`, string(data))
+}
+
+func TestCLI_ProgressAdd_InvalidRating(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"progress", "add", "202", "--rating", "meh"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "rating")
+}
+
+func TestCLI_ProgressAdd_InvalidMinutes(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"progress", "add", "202", "--minutes", "soon"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "invalid time taken")
+}
+
+func TestCLI_ProgressAdd_InvalidID(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"progress", "add", "two-sum"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "invalid question ID")
+}
+
+func TestCLI_BannedUser(t *testing.T) {
+	c, mockUserService, _, _, errOut := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, true)
+	mockUserService.EXPECT().GetActiveBan("user-id").Return(&models.Ban{Reason: "posting spam"}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"stats", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut.String(), cli.ErrBanned.Error())
+	assert.Contains(t, errOut.String(), "until an admin lifts it: posting spam")
+}
+
+func TestCLI_AdminBan_RequiresPermission(t *testing.T) {
+	for _, role := range []string{roles.USER, roles.CONTENT_EDITOR} {
+		t.Run(role, func(t *testing.T) {
+			c, mockUserService, _, _, errOut := newTestCLI(t)
+
+			expectLogin(mockUserService, role, false)
+			mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+			code := c.Run([]string{"admin", "ban", "someone", "--reason", "spam", "--username", "testuser", "--password", "Password@123"})
+
+			assert.Equal(t, 1, code)
+			assert.Contains(t, errOut.String(), cli.ErrForbidden.Error())
+			assert.Contains(t, errOut.String(), roles.USERS_BAN)
+		})
+	}
+}
+
+func TestCLI_AdminBan_Moderator(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.MODERATOR, false)
+	mockUserService.EXPECT().BanUser("user-id", "someone", "spam", time.Time{}).Return(false, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "ban", "someone", "--reason", "spam", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "user banned successfully")
+}
+
+func TestCLI_AdminBan(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockUserService.EXPECT().BanUser("user-id", "someone", "posting spam", gomock.Any()).DoAndReturn(
+		func(_, _, _ string, expiresAt time.Time) (bool, error) {
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, 7), expiresAt, time.Minute)
+			return false, nil
+		})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "ban", "someone", "--reason", "posting spam", "--expires", "7d", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "user banned successfully")
+}
+
+func TestCLI_AdminBan_InvalidFlags(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"no reason", []string{"admin", "ban", "someone"}, "--reason is required"},
+		{"expiry in the past", []string{"admin", "ban", "someone", "--reason", "spam", "--expires", "2001-01-01"}, "invalid expiry"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, _, _, errOut := newTestCLI(t)
+
+			code := c.Run(tt.args)
+
+			assert.Equal(t, 2, code)
+			assert.Contains(t, errOut.String(), tt.want)
+		})
+	}
+}
+
+func TestCLI_AdminImport(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockQuestionService.EXPECT().ImportQuestionsFromFile("user-id", "file.csv", false).Return(&models.ImportReport{
+		Rows: []models.ImportRow{
+			{Row: 2, QuestionID: "1", Status: models.ImportRowNew, Question: models.Question{QuestionTitle: "two sum"}},
+			{Row: 3, QuestionID: "15", Status: models.ImportRowUnchanged},
+		},
+		New:       1,
+		Unchanged: 1,
+		Applied:   true,
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "import", "file.csv", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "row 2: 1 new: two sum")
+	assert.Contains(t, out.String(), "1 new, 0 changed, 1 unchanged, 0 invalid")
+	assert.Contains(t, out.String(), "Questions successfully imported")
+}
+
+func TestCLI_AdminImport_DryRunWithInvalidRows(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, errOut := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockQuestionService.EXPECT().ImportQuestionsFromFile("user-id", "file.csv", true).Return(&models.ImportReport{
+		Rows: []models.ImportRow{
+			{Row: 2, QuestionID: "15", Status: models.ImportRowChanged, Changes: []string{`difficulty: "easy" -> "medium"`}},
+			{Row: 3, QuestionID: "abc", Status: models.ImportRowInvalid, Reason: "invalid question ID : must be a positive number"},
+		},
+		Changed: 1,
+		Invalid: 1,
+	}, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "import", "file.csv", "--dry-run", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, out.String(), `row 2: 15 changed: difficulty: "easy" -> "medium"`)
+	assert.Contains(t, out.String(), "dry run, nothing was changed")
+	assert.Contains(t, errOut.String(), "row 3: invalid: invalid question ID")
+}
+
+func TestCLI_AdminExport(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockQuestionService.EXPECT().ExportQuestions("user-id", gomock.Any(), "json", "easy", "", "").DoAndReturn(func(actorID string, writer io.Writer, format, difficulty, company, topic string) (int, error) {
+		_, err := io.WriteString(writer, "[]\n")
+		return 0, err
+	})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "export", "--format", "json", "--difficulty", "easy", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Equal(t, "[]\n", out.String())
+}
+
+func TestCLI_AdminExport_InvalidFormat(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"admin", "export", "--format", "xlsx", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "invalid export format")
+}
+
+func TestCLI_AdminEdit(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockQuestionService.EXPECT().UpdateQuestion("user-id", "15", gomock.Any()).DoAndReturn(func(actorID, questionID string, update models.QuestionUpdate) (*models.Question, error) {
+		assert.Equal(t, "hard", *update.Difficulty)
+		assert.NotNil(t, update.CompanyTags)
+		assert.Nil(t, update.QuestionTitle)
+		assert.Nil(t, update.TopicTags)
+		return &models.Question{QuestionID: "15", QuestionTitle: "3sum", Difficulty: "hard"}, nil
+	})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "edit", "15", "--difficulty", "hard", "--companies", "", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "updated 15: 3sum (hard)")
+}
+
+func TestCLI_AdminEdit_NothingToChange(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"admin", "edit", "15", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "nothing to change")
+}
+
+func TestCLI_AdminAudit(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+	output := filepath.Join(t.TempDir(), "audit.json")
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockAuditService.EXPECT().ExportAuditLog("user-id", gomock.Any(), "json", gomock.Any()).DoAndReturn(func(_ string, writer io.Writer, format string, filter models.AuditFilter) (int, error) {
+		assert.Equal(t, "admin", filter.Actor)
+		assert.Equal(t, "user.ban", filter.Action)
+		assert.True(t, filter.Since.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)))
+		assert.True(t, filter.Until.Equal(time.Date(2024, 5, 2, 0, 0, 0, 0, time.Local)))
+		_, err := io.WriteString(writer, "[]\n")
+		return 3, err
+	})
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "audit", "--actor", "admin", "--action", "user.ban", "--since", "2024-05-01", "--until", "2024-05-01",
+		"--format", "json", "--output", output, "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "exported 3 audit entries to "+output)
+	written, err := os.ReadFile(output)
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", string(written))
+}
+
+func TestCLI_AdminAudit_InvalidFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"invalid date", []string{"--since", "yesterday"}, "invalid date"},
+		{"markdown", []string{"--format", "markdown"}, "can only be exported as 'csv' or 'json'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, _, _, errOut := newTestCLI(t)
+
+			code := c.Run(append([]string{"admin", "audit", "--username", "testuser", "--password", "Password@123"}, tt.args...))
+
+			assert.Equal(t, 2, code)
+			assert.Contains(t, errOut.String(), tt.expected)
+		})
+	}
+}
+
+func TestCLI_Login_StoresSession(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.USER, false)
+
+	code := c.Run([]string{"login", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "logged in as testuser")
+
+	// The stored session is kept, so Logout must not have been called
+	stored, err := credentials.Load(credentials.DefaultPath())
+	require.NoError(t, err)
+	assert.Equal(t, "token", stored.Token)
+}
+
+func TestCLI_Login_Prompts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	t.Setenv(config.USERNAME_ENV, "")
+	t.Setenv(config.PASSWORD_ENV, "")
+	t.Setenv(config.CREDENTIALS_ENV, filepath.Join(t.TempDir(), "credentials.json"))
+
+	mockUserService := mock_services.NewMockUserService(ctrl)
+	out := &bytes.Buffer{}
+	c := cli.NewCLI(mockUserService, nil, nil, nil, nil, nil, nil, nil, strings.NewReader("testuser\nPassword@123\n"), out, &bytes.Buffer{})
+
+	expectLogin(mockUserService, roles.USER, false)
+
+	code := c.Run([]string{"login"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Username: ")
+}
+
+func TestCLI_StoredSession(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+	require.NoError(t, credentials.Save(credentials.DefaultPath(), &credentials.Credentials{
+		Username: "testuser", Token: "token", ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	mockUserService.EXPECT().ResumeSession("token").Return(testSession, nil)
+	mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", Username: "testuser", Role: roles.USER},
+	}, nil)
+	mockUserService.EXPECT().UpdateUserProgress("user-id", models.SolveEvent{QuestionID: "202"}).Return(true, nil)
+	mockReviewService.EXPECT().RecordReview("user-id", "202", models.RecallGood).Return(&models.ReviewCard{DueAt: time.Now().AddDate(0, 0, 1)}, nil)
+
+	// Stored sessions are resumed, not revoked, when the command ends
+	code := c.Run([]string{"progress", "add", "202"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "202: marked as done")
+}
+
+func TestCLI_Logout(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+	require.NoError(t, credentials.Save(credentials.DefaultPath(), &credentials.Credentials{
+		Username: "testuser", Token: "token", ExpiresAt: time.Now().Add(time.Hour),
+	}))
+
+	mockUserService.EXPECT().ResumeSession("token").Return(testSession, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"logout"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "logged out")
+
+	_, err := credentials.Load(credentials.DefaultPath())
+	assert.Equal(t, credentials.ErrNoCredentials, err)
+}
+
+func TestCLI_BootstrapAdmin(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	mockUserService.EXPECT().BootstrapAdmin(gomock.Any()).DoAndReturn(func(user *models.StandardUser) error {
+		assert.Equal(t, "root", user.StandardUser.Username)
+		assert.Equal(t, "root@gmail.com", user.StandardUser.Email)
+		assert.Equal(t, "Password@123", user.StandardUser.Password)
+		return nil
+	})
+
+	code := c.Run([]string{"bootstrap-admin", "--username", "Root", "--name", "Root Admin", "--email", "root@gmail.com", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "admin root created")
+}
+
+func TestCLI_BootstrapAdmin_Refused(t *testing.T) {
+	c, mockUserService, _, _, errOut := newTestCLI(t)
+
+	mockUserService.EXPECT().BootstrapAdmin(gomock.Any()).Return(services.ErrAdminExists)
+
+	code := c.Run([]string{"bootstrap-admin", "--username", "root", "--name", "Root Admin", "--email", "root@gmail.com", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut.String(), services.ErrAdminExists.Error())
+}
+
+func TestCLI_BootstrapAdmin_InvalidFlags(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"bootstrap-admin", "--username", "root", "--name", "Root Admin", "--email", "root@gmail.com", "--password", "weak"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), "invalid password")
+}
+
+func TestCLI_AdminDemote_LastAdmin(t *testing.T) {
+	c, mockUserService, _, _, errOut := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockUserService.EXPECT().DemoteUser("user-id", "testuser").Return(false, services.ErrLastAdmin)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "demote", "testuser", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut.String(), services.ErrLastAdmin.Error())
+}
+
+func TestCLI_AdminRole(t *testing.T) {
+	c, mockUserService, _, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.ADMIN, false)
+	mockUserService.EXPECT().ChangeUserRole("user-id", "someone", roles.CONTENT_EDITOR).Return(false, nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "role", "someone", "content_editor", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "user is now a content_editor")
+}
+
+func TestCLI_AdminRole_Invalid(t *testing.T) {
+	c, _, _, _, errOut := newTestCLI(t)
+
+	code := c.Run([]string{"admin", "role", "someone", "superuser", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 2, code)
+	assert.Contains(t, errOut.String(), `unknown role "superuser"`)
+}
+
+func TestCLI_AdminRole_Moderator(t *testing.T) {
+	c, mockUserService, _, _, errOut := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.MODERATOR, false)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "role", "someone", "admin", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 1, code)
+	assert.Contains(t, errOut.String(), cli.ErrForbidden.Error())
+}
+
+func TestCLI_AdminStats_ContentEditor(t *testing.T) {
+	c, mockUserService, mockQuestionService, out, _ := newTestCLI(t)
+
+	expectLogin(mockUserService, roles.CONTENT_EDITOR, false)
+	mockUserService.EXPECT().CountActiveUserInLast24Hours("user-id").Return(int64(4), nil)
+	mockQuestionService.EXPECT().GetTotalQuestionsCount().Return(int64(120), nil)
+	mockUserService.EXPECT().Logout(testSession).Return(nil)
+
+	code := c.Run([]string{"admin", "stats", "--username", "testuser", "--password", "Password@123"})
+
+	assert.Equal(t, 0, code)
+	assert.Contains(t, out.String(), "Active Users (Last 24 Hours) : 4")
+	assert.Contains(t, out.String(), "Total Questions on the Platform : 120")
+}
@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/announcement_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAnnouncementRepository is a mock of AnnouncementRepository interface.
+type MockAnnouncementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnouncementRepositoryMockRecorder
+}
+
+// MockAnnouncementRepositoryMockRecorder is the mock recorder for MockAnnouncementRepository.
+type MockAnnouncementRepositoryMockRecorder struct {
+	mock *MockAnnouncementRepository
+}
+
+// NewMockAnnouncementRepository creates a new mock instance.
+func NewMockAnnouncementRepository(ctrl *gomock.Controller) *MockAnnouncementRepository {
+	mock := &MockAnnouncementRepository{ctrl: ctrl}
+	mock.recorder = &MockAnnouncementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnouncementRepository) EXPECT() *MockAnnouncementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReadReceipt mocks base method.
+func (m *MockAnnouncementRepository) AddReadReceipt(announcementID string, receipt models.ReadReceipt) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReadReceipt", announcementID, receipt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReadReceipt indicates an expected call of AddReadReceipt.
+func (mr *MockAnnouncementRepositoryMockRecorder) AddReadReceipt(announcementID, receipt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReadReceipt", reflect.TypeOf((*MockAnnouncementRepository)(nil).AddReadReceipt), announcementID, receipt)
+}
+
+// CreateAnnouncement mocks base method.
+func (m *MockAnnouncementRepository) CreateAnnouncement(announcement *models.Announcement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnnouncement", announcement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAnnouncement indicates an expected call of CreateAnnouncement.
+func (mr *MockAnnouncementRepositoryMockRecorder) CreateAnnouncement(announcement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnouncement", reflect.TypeOf((*MockAnnouncementRepository)(nil).CreateAnnouncement), announcement)
+}
+
+// FetchAnnouncementByID mocks base method.
+func (m *MockAnnouncementRepository) FetchAnnouncementByID(announcementID string) (*models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAnnouncementByID", announcementID)
+	ret0, _ := ret[0].(*models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAnnouncementByID indicates an expected call of FetchAnnouncementByID.
+func (mr *MockAnnouncementRepositoryMockRecorder) FetchAnnouncementByID(announcementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAnnouncementByID", reflect.TypeOf((*MockAnnouncementRepository)(nil).FetchAnnouncementByID), announcementID)
+}
+
+// FetchAnnouncements mocks base method.
+func (m *MockAnnouncementRepository) FetchAnnouncements() (*[]models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAnnouncements")
+	ret0, _ := ret[0].(*[]models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAnnouncements indicates an expected call of FetchAnnouncements.
+func (mr *MockAnnouncementRepositoryMockRecorder) FetchAnnouncements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAnnouncements", reflect.TypeOf((*MockAnnouncementRepository)(nil).FetchAnnouncements))
+}
+
+// UpdateAnnouncement mocks base method.
+func (m *MockAnnouncementRepository) UpdateAnnouncement(announcement *models.Announcement) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnouncement", announcement)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnnouncement indicates an expected call of UpdateAnnouncement.
+func (mr *MockAnnouncementRepositoryMockRecorder) UpdateAnnouncement(announcement interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnouncement", reflect.TypeOf((*MockAnnouncementRepository)(nil).UpdateAnnouncement), announcement)
+}
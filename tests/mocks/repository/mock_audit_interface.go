@@ -0,0 +1,79 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/audit_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditRepository is a mock of AuditRepository interface.
+type MockAuditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditRepositoryMockRecorder
+}
+
+// MockAuditRepositoryMockRecorder is the mock recorder for MockAuditRepository.
+type MockAuditRepositoryMockRecorder struct {
+	mock *MockAuditRepository
+}
+
+// NewMockAuditRepository creates a new mock instance.
+func NewMockAuditRepository(ctrl *gomock.Controller) *MockAuditRepository {
+	mock := &MockAuditRepository{ctrl: ctrl}
+	mock.recorder = &MockAuditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditRepository) EXPECT() *MockAuditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AppendAuditEntry mocks base method.
+func (m *MockAuditRepository) AppendAuditEntry(entry *models.AuditEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendAuditEntry", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AppendAuditEntry indicates an expected call of AppendAuditEntry.
+func (mr *MockAuditRepositoryMockRecorder) AppendAuditEntry(entry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendAuditEntry", reflect.TypeOf((*MockAuditRepository)(nil).AppendAuditEntry), entry)
+}
+
+// FetchAllAuditEntries mocks base method.
+func (m *MockAuditRepository) FetchAllAuditEntries(filter models.AuditFilter) (*[]models.AuditEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAllAuditEntries", filter)
+	ret0, _ := ret[0].(*[]models.AuditEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAllAuditEntries indicates an expected call of FetchAllAuditEntries.
+func (mr *MockAuditRepositoryMockRecorder) FetchAllAuditEntries(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAllAuditEntries", reflect.TypeOf((*MockAuditRepository)(nil).FetchAllAuditEntries), filter)
+}
+
+// FetchAuditEntries mocks base method.
+func (m *MockAuditRepository) FetchAuditEntries(filter models.AuditFilter, page models.PageRequest) (*models.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchAuditEntries", filter, page)
+	ret0, _ := ret[0].(*models.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchAuditEntries indicates an expected call of FetchAuditEntries.
+func (mr *MockAuditRepositoryMockRecorder) FetchAuditEntries(filter, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAuditEntries", reflect.TypeOf((*MockAuditRepository)(nil).FetchAuditEntries), filter, page)
+}
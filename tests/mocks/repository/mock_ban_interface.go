@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/ban_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBanRepository is a mock of BanRepository interface.
+type MockBanRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBanRepositoryMockRecorder
+}
+
+// MockBanRepositoryMockRecorder is the mock recorder for MockBanRepository.
+type MockBanRepositoryMockRecorder struct {
+	mock *MockBanRepository
+}
+
+// NewMockBanRepository creates a new mock instance.
+func NewMockBanRepository(ctrl *gomock.Controller) *MockBanRepository {
+	mock := &MockBanRepository{ctrl: ctrl}
+	mock.recorder = &MockBanRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBanRepository) EXPECT() *MockBanRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBan mocks base method.
+func (m *MockBanRepository) CreateBan(ban *models.Ban) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBan", ban)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBan indicates an expected call of CreateBan.
+func (mr *MockBanRepositoryMockRecorder) CreateBan(ban interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBan", reflect.TypeOf((*MockBanRepository)(nil).CreateBan), ban)
+}
+
+// FetchActiveBan mocks base method.
+func (m *MockBanRepository) FetchActiveBan(userID string) (*models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchActiveBan", userID)
+	ret0, _ := ret[0].(*models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchActiveBan indicates an expected call of FetchActiveBan.
+func (mr *MockBanRepositoryMockRecorder) FetchActiveBan(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchActiveBan", reflect.TypeOf((*MockBanRepository)(nil).FetchActiveBan), userID)
+}
+
+// FetchBanByID mocks base method.
+func (m *MockBanRepository) FetchBanByID(banID string) (*models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBanByID", banID)
+	ret0, _ := ret[0].(*models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBanByID indicates an expected call of FetchBanByID.
+func (mr *MockBanRepositoryMockRecorder) FetchBanByID(banID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBanByID", reflect.TypeOf((*MockBanRepository)(nil).FetchBanByID), banID)
+}
+
+// FetchBansByAppealStatus mocks base method.
+func (m *MockBanRepository) FetchBansByAppealStatus(status string) (*[]models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchBansByAppealStatus", status)
+	ret0, _ := ret[0].(*[]models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchBansByAppealStatus indicates an expected call of FetchBansByAppealStatus.
+func (mr *MockBanRepositoryMockRecorder) FetchBansByAppealStatus(status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchBansByAppealStatus", reflect.TypeOf((*MockBanRepository)(nil).FetchBansByAppealStatus), status)
+}
+
+// UpdateBan mocks base method.
+func (m *MockBanRepository) UpdateBan(ban *models.Ban) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBan", ban)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateBan indicates an expected call of UpdateBan.
+func (mr *MockBanRepositoryMockRecorder) UpdateBan(ban interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBan", reflect.TypeOf((*MockBanRepository)(nil).UpdateBan), ban)
+}
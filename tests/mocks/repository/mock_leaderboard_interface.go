@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/leaderboard_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaderboardRepository is a mock of LeaderboardRepository interface.
+type MockLeaderboardRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderboardRepositoryMockRecorder
+}
+
+// MockLeaderboardRepositoryMockRecorder is the mock recorder for MockLeaderboardRepository.
+type MockLeaderboardRepositoryMockRecorder struct {
+	mock *MockLeaderboardRepository
+}
+
+// NewMockLeaderboardRepository creates a new mock instance.
+func NewMockLeaderboardRepository(ctrl *gomock.Controller) *MockLeaderboardRepository {
+	mock := &MockLeaderboardRepository{ctrl: ctrl}
+	mock.recorder = &MockLeaderboardRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaderboardRepository) EXPECT() *MockLeaderboardRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FetchSolveCounts mocks base method.
+func (m *MockLeaderboardRepository) FetchSolveCounts(organisation, country string) (*[]models.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchSolveCounts", organisation, country)
+	ret0, _ := ret[0].(*[]models.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchSolveCounts indicates an expected call of FetchSolveCounts.
+func (mr *MockLeaderboardRepositoryMockRecorder) FetchSolveCounts(organisation, country interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchSolveCounts", reflect.TypeOf((*MockLeaderboardRepository)(nil).FetchSolveCounts), organisation, country)
+}
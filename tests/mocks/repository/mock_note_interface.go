@@ -0,0 +1,93 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/note_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNoteRepository is a mock of NoteRepository interface.
+type MockNoteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteRepositoryMockRecorder
+}
+
+// MockNoteRepositoryMockRecorder is the mock recorder for MockNoteRepository.
+type MockNoteRepositoryMockRecorder struct {
+	mock *MockNoteRepository
+}
+
+// NewMockNoteRepository creates a new mock instance.
+func NewMockNoteRepository(ctrl *gomock.Controller) *MockNoteRepository {
+	mock := &MockNoteRepository{ctrl: ctrl}
+	mock.recorder = &MockNoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteRepository) EXPECT() *MockNoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteNote mocks base method.
+func (m *MockNoteRepository) DeleteNote(userID, questionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNote", userID, questionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNote indicates an expected call of DeleteNote.
+func (mr *MockNoteRepositoryMockRecorder) DeleteNote(userID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteRepository)(nil).DeleteNote), userID, questionID)
+}
+
+// FetchNote mocks base method.
+func (m *MockNoteRepository) FetchNote(userID, questionID string) (*models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchNote", userID, questionID)
+	ret0, _ := ret[0].(*models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchNote indicates an expected call of FetchNote.
+func (mr *MockNoteRepositoryMockRecorder) FetchNote(userID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNote", reflect.TypeOf((*MockNoteRepository)(nil).FetchNote), userID, questionID)
+}
+
+// FetchNotes mocks base method.
+func (m *MockNoteRepository) FetchNotes(userID string) (*[]models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchNotes", userID)
+	ret0, _ := ret[0].(*[]models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchNotes indicates an expected call of FetchNotes.
+func (mr *MockNoteRepositoryMockRecorder) FetchNotes(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchNotes", reflect.TypeOf((*MockNoteRepository)(nil).FetchNotes), userID)
+}
+
+// UpsertNote mocks base method.
+func (m *MockNoteRepository) UpsertNote(note *models.Note) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertNote", note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertNote indicates an expected call of UpsertNote.
+func (mr *MockNoteRepositoryMockRecorder) UpsertNote(note interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertNote", reflect.TypeOf((*MockNoteRepository)(nil).UpsertNote), note)
+}
@@ -122,6 +122,21 @@ func (mr *MockQuestionRepositoryMockRecorder) FetchQuestionsByFilters(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchQuestionsByFilters", reflect.TypeOf((*MockQuestionRepository)(nil).FetchQuestionsByFilters), arg0, arg1, arg2)
 }
 
+// FetchQuestionsPage mocks base method.
+func (m *MockQuestionRepository) FetchQuestionsPage(page models.PageRequest, excludeIDs []string) (*models.QuestionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchQuestionsPage", page, excludeIDs)
+	ret0, _ := ret[0].(*models.QuestionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchQuestionsPage indicates an expected call of FetchQuestionsPage.
+func (mr *MockQuestionRepositoryMockRecorder) FetchQuestionsPage(page, excludeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchQuestionsPage", reflect.TypeOf((*MockQuestionRepository)(nil).FetchQuestionsPage), page, excludeIDs)
+}
+
 // QuestionExists mocks base method.
 func (m *MockQuestionRepository) QuestionExists(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -150,3 +165,31 @@ func (mr *MockQuestionRepositoryMockRecorder) RemoveQuestionByID(arg0 interface{
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQuestionByID", reflect.TypeOf((*MockQuestionRepository)(nil).RemoveQuestionByID), arg0)
 }
+
+// UpdateQuestion mocks base method.
+func (m *MockQuestionRepository) UpdateQuestion(arg0 *models.Question) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuestion", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateQuestion indicates an expected call of UpdateQuestion.
+func (mr *MockQuestionRepositoryMockRecorder) UpdateQuestion(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockQuestionRepository)(nil).UpdateQuestion), arg0)
+}
+
+// UpsertQuestions mocks base method.
+func (m *MockQuestionRepository) UpsertQuestions(arg0 *[]models.Question) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertQuestions", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertQuestions indicates an expected call of UpsertQuestions.
+func (mr *MockQuestionRepositoryMockRecorder) UpsertQuestions(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertQuestions", reflect.TypeOf((*MockQuestionRepository)(nil).UpsertQuestions), arg0)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/review_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReviewRepository is a mock of ReviewRepository interface.
+type MockReviewRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepositoryMockRecorder
+}
+
+// MockReviewRepositoryMockRecorder is the mock recorder for MockReviewRepository.
+type MockReviewRepositoryMockRecorder struct {
+	mock *MockReviewRepository
+}
+
+// NewMockReviewRepository creates a new mock instance.
+func NewMockReviewRepository(ctrl *gomock.Controller) *MockReviewRepository {
+	mock := &MockReviewRepository{ctrl: ctrl}
+	mock.recorder = &MockReviewRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepository) EXPECT() *MockReviewRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FetchDueReviewCards mocks base method.
+func (m *MockReviewRepository) FetchDueReviewCards(userID string, dueBefore time.Time) (*[]models.ReviewCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchDueReviewCards", userID, dueBefore)
+	ret0, _ := ret[0].(*[]models.ReviewCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchDueReviewCards indicates an expected call of FetchDueReviewCards.
+func (mr *MockReviewRepositoryMockRecorder) FetchDueReviewCards(userID, dueBefore interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchDueReviewCards", reflect.TypeOf((*MockReviewRepository)(nil).FetchDueReviewCards), userID, dueBefore)
+}
+
+// FetchReviewCard mocks base method.
+func (m *MockReviewRepository) FetchReviewCard(userID, questionID string) (*models.ReviewCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchReviewCard", userID, questionID)
+	ret0, _ := ret[0].(*models.ReviewCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchReviewCard indicates an expected call of FetchReviewCard.
+func (mr *MockReviewRepositoryMockRecorder) FetchReviewCard(userID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchReviewCard", reflect.TypeOf((*MockReviewRepository)(nil).FetchReviewCard), userID, questionID)
+}
+
+// UpsertReviewCard mocks base method.
+func (m *MockReviewRepository) UpsertReviewCard(card *models.ReviewCard) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertReviewCard", card)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertReviewCard indicates an expected call of UpsertReviewCard.
+func (mr *MockReviewRepositoryMockRecorder) UpsertReviewCard(card interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertReviewCard", reflect.TypeOf((*MockReviewRepository)(nil).UpsertReviewCard), card)
+}
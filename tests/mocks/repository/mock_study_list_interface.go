@@ -0,0 +1,107 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/study_list_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStudyListRepository is a mock of StudyListRepository interface.
+type MockStudyListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStudyListRepositoryMockRecorder
+}
+
+// MockStudyListRepositoryMockRecorder is the mock recorder for MockStudyListRepository.
+type MockStudyListRepositoryMockRecorder struct {
+	mock *MockStudyListRepository
+}
+
+// NewMockStudyListRepository creates a new mock instance.
+func NewMockStudyListRepository(ctrl *gomock.Controller) *MockStudyListRepository {
+	mock := &MockStudyListRepository{ctrl: ctrl}
+	mock.recorder = &MockStudyListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStudyListRepository) EXPECT() *MockStudyListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateStudyList mocks base method.
+func (m *MockStudyListRepository) CreateStudyList(list *models.StudyList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStudyList", list)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStudyList indicates an expected call of CreateStudyList.
+func (mr *MockStudyListRepositoryMockRecorder) CreateStudyList(list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStudyList", reflect.TypeOf((*MockStudyListRepository)(nil).CreateStudyList), list)
+}
+
+// DeleteStudyList mocks base method.
+func (m *MockStudyListRepository) DeleteStudyList(listID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStudyList", listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStudyList indicates an expected call of DeleteStudyList.
+func (mr *MockStudyListRepositoryMockRecorder) DeleteStudyList(listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStudyList", reflect.TypeOf((*MockStudyListRepository)(nil).DeleteStudyList), listID)
+}
+
+// FetchStudyListByID mocks base method.
+func (m *MockStudyListRepository) FetchStudyListByID(listID string) (*models.StudyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchStudyListByID", listID)
+	ret0, _ := ret[0].(*models.StudyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchStudyListByID indicates an expected call of FetchStudyListByID.
+func (mr *MockStudyListRepositoryMockRecorder) FetchStudyListByID(listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStudyListByID", reflect.TypeOf((*MockStudyListRepository)(nil).FetchStudyListByID), listID)
+}
+
+// FetchVisibleStudyLists mocks base method.
+func (m *MockStudyListRepository) FetchVisibleStudyLists(userID string) (*[]models.StudyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchVisibleStudyLists", userID)
+	ret0, _ := ret[0].(*[]models.StudyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchVisibleStudyLists indicates an expected call of FetchVisibleStudyLists.
+func (mr *MockStudyListRepositoryMockRecorder) FetchVisibleStudyLists(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchVisibleStudyLists", reflect.TypeOf((*MockStudyListRepository)(nil).FetchVisibleStudyLists), userID)
+}
+
+// UpdateStudyList mocks base method.
+func (m *MockStudyListRepository) UpdateStudyList(list *models.StudyList) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStudyList", list)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStudyList indicates an expected call of UpdateStudyList.
+func (mr *MockStudyListRepositoryMockRecorder) UpdateStudyList(list interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStudyList", reflect.TypeOf((*MockStudyListRepository)(nil).UpdateStudyList), list)
+}
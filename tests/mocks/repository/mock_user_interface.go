@@ -63,6 +63,21 @@ func (mr *MockUserRepositoryMockRecorder) CountActiveUsersInLast24Hours() *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveUsersInLast24Hours", reflect.TypeOf((*MockUserRepository)(nil).CountActiveUsersInLast24Hours))
 }
 
+// CountUsersByRole mocks base method.
+func (m *MockUserRepository) CountUsersByRole(role string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUsersByRole", role)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUsersByRole indicates an expected call of CountUsersByRole.
+func (mr *MockUserRepositoryMockRecorder) CountUsersByRole(role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUsersByRole", reflect.TypeOf((*MockUserRepository)(nil).CountUsersByRole), role)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserRepository) CreateUser(arg0 *models.StandardUser) error {
 	m.ctrl.T.Helper()
@@ -122,6 +137,21 @@ func (mr *MockUserRepositoryMockRecorder) FetchUserByUsername(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUserByUsername", reflect.TypeOf((*MockUserRepository)(nil).FetchUserByUsername), arg0)
 }
 
+// FetchUsersPage mocks base method.
+func (m *MockUserRepository) FetchUsersPage(role string, page models.PageRequest) (*models.UserPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUsersPage", role, page)
+	ret0, _ := ret[0].(*models.UserPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUsersPage indicates an expected call of FetchUsersPage.
+func (mr *MockUserRepositoryMockRecorder) FetchUsersPage(role, page interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUsersPage", reflect.TypeOf((*MockUserRepository)(nil).FetchUsersPage), role, page)
+}
+
 // IsEmailUnique mocks base method.
 func (m *MockUserRepository) IsEmailUnique(arg0 string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +197,21 @@ func (mr *MockUserRepositoryMockRecorder) IsUsernameUnique(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUsernameUnique", reflect.TypeOf((*MockUserRepository)(nil).IsUsernameUnique), arg0)
 }
 
+// MigrateSolveHistory mocks base method.
+func (m *MockUserRepository) MigrateSolveHistory() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSolveHistory")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSolveHistory indicates an expected call of MigrateSolveHistory.
+func (mr *MockUserRepositoryMockRecorder) MigrateSolveHistory() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSolveHistory", reflect.TypeOf((*MockUserRepository)(nil).MigrateSolveHistory))
+}
+
 // UnbanUser mocks base method.
 func (m *MockUserRepository) UnbanUser(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -196,15 +241,29 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUserDetails(arg0 interface{}) *g
 }
 
 // UpdateUserProgress mocks base method.
-func (m *MockUserRepository) UpdateUserProgress(questionID string) error {
+func (m *MockUserRepository) UpdateUserProgress(userID string, event *models.SolveEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserProgress", questionID)
+	ret := m.ctrl.Call(m, "UpdateUserProgress", userID, event)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUserProgress indicates an expected call of UpdateUserProgress.
-func (mr *MockUserRepositoryMockRecorder) UpdateUserProgress(questionID interface{}) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) UpdateUserProgress(userID, event interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProgress", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserProgress), userID, event)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockUserRepository) UpdateUserRole(userID, role string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", userID, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserRole(userID, role interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProgress", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserProgress), questionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserRole), userID, role)
 }
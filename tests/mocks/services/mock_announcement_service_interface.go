@@ -0,0 +1,167 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/announcement_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAnnouncementService is a mock of AnnouncementService interface.
+type MockAnnouncementService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnnouncementServiceMockRecorder
+}
+
+// MockAnnouncementServiceMockRecorder is the mock recorder for MockAnnouncementService.
+type MockAnnouncementServiceMockRecorder struct {
+	mock *MockAnnouncementService
+}
+
+// NewMockAnnouncementService creates a new mock instance.
+func NewMockAnnouncementService(ctrl *gomock.Controller) *MockAnnouncementService {
+	mock := &MockAnnouncementService{ctrl: ctrl}
+	mock.recorder = &MockAnnouncementServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnnouncementService) EXPECT() *MockAnnouncementServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateAnnouncement mocks base method.
+func (m *MockAnnouncementService) CreateAnnouncement(authorID string, draft models.AnnouncementDraft) (*models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAnnouncement", authorID, draft)
+	ret0, _ := ret[0].(*models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAnnouncement indicates an expected call of CreateAnnouncement.
+func (mr *MockAnnouncementServiceMockRecorder) CreateAnnouncement(authorID, draft interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAnnouncement", reflect.TypeOf((*MockAnnouncementService)(nil).CreateAnnouncement), authorID, draft)
+}
+
+// ExpireAnnouncement mocks base method.
+func (m *MockAnnouncementService) ExpireAnnouncement(actorID, announcementID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAnnouncement", actorID, announcementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExpireAnnouncement indicates an expected call of ExpireAnnouncement.
+func (mr *MockAnnouncementServiceMockRecorder) ExpireAnnouncement(actorID, announcementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAnnouncement", reflect.TypeOf((*MockAnnouncementService)(nil).ExpireAnnouncement), actorID, announcementID)
+}
+
+// GetAllAnnouncements mocks base method.
+func (m *MockAnnouncementService) GetAllAnnouncements() (*[]models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllAnnouncements")
+	ret0, _ := ret[0].(*[]models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllAnnouncements indicates an expected call of GetAllAnnouncements.
+func (mr *MockAnnouncementServiceMockRecorder) GetAllAnnouncements() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAnnouncements", reflect.TypeOf((*MockAnnouncementService)(nil).GetAllAnnouncements))
+}
+
+// GetAnnouncement mocks base method.
+func (m *MockAnnouncementService) GetAnnouncement(announcementID string) (*models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnnouncement", announcementID)
+	ret0, _ := ret[0].(*models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnnouncement indicates an expected call of GetAnnouncement.
+func (mr *MockAnnouncementServiceMockRecorder) GetAnnouncement(announcementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnouncement", reflect.TypeOf((*MockAnnouncementService)(nil).GetAnnouncement), announcementID)
+}
+
+// GetUnreadAnnouncements mocks base method.
+func (m *MockAnnouncementService) GetUnreadAnnouncements(userID string) (*[]models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadAnnouncements", userID)
+	ret0, _ := ret[0].(*[]models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadAnnouncements indicates an expected call of GetUnreadAnnouncements.
+func (mr *MockAnnouncementServiceMockRecorder) GetUnreadAnnouncements(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadAnnouncements", reflect.TypeOf((*MockAnnouncementService)(nil).GetUnreadAnnouncements), userID)
+}
+
+// GetUserAnnouncements mocks base method.
+func (m *MockAnnouncementService) GetUserAnnouncements(userID string) (*[]models.UserAnnouncement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAnnouncements", userID)
+	ret0, _ := ret[0].(*[]models.UserAnnouncement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAnnouncements indicates an expected call of GetUserAnnouncements.
+func (mr *MockAnnouncementServiceMockRecorder) GetUserAnnouncements(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAnnouncements", reflect.TypeOf((*MockAnnouncementService)(nil).GetUserAnnouncements), userID)
+}
+
+// MarkAnnouncementRead mocks base method.
+func (m *MockAnnouncementService) MarkAnnouncementRead(userID, announcementID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAnnouncementRead", userID, announcementID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAnnouncementRead indicates an expected call of MarkAnnouncementRead.
+func (mr *MockAnnouncementServiceMockRecorder) MarkAnnouncementRead(userID, announcementID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAnnouncementRead", reflect.TypeOf((*MockAnnouncementService)(nil).MarkAnnouncementRead), userID, announcementID)
+}
+
+// SetAnnouncementPinned mocks base method.
+func (m *MockAnnouncementService) SetAnnouncementPinned(actorID, announcementID string, pinned bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAnnouncementPinned", actorID, announcementID, pinned)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAnnouncementPinned indicates an expected call of SetAnnouncementPinned.
+func (mr *MockAnnouncementServiceMockRecorder) SetAnnouncementPinned(actorID, announcementID, pinned interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAnnouncementPinned", reflect.TypeOf((*MockAnnouncementService)(nil).SetAnnouncementPinned), actorID, announcementID, pinned)
+}
+
+// UpdateAnnouncement mocks base method.
+func (m *MockAnnouncementService) UpdateAnnouncement(actorID, announcementID string, draft models.AnnouncementDraft) (*models.Announcement, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnouncement", actorID, announcementID, draft)
+	ret0, _ := ret[0].(*models.Announcement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAnnouncement indicates an expected call of UpdateAnnouncement.
+func (mr *MockAnnouncementServiceMockRecorder) UpdateAnnouncement(actorID, announcementID, draft interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnouncement", reflect.TypeOf((*MockAnnouncementService)(nil).UpdateAnnouncement), actorID, announcementID, draft)
+}
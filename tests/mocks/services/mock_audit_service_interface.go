@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/audit_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	io "io"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditService is a mock of AuditService interface.
+type MockAuditService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditServiceMockRecorder
+}
+
+// MockAuditServiceMockRecorder is the mock recorder for MockAuditService.
+type MockAuditServiceMockRecorder struct {
+	mock *MockAuditService
+}
+
+// NewMockAuditService creates a new mock instance.
+func NewMockAuditService(ctrl *gomock.Controller) *MockAuditService {
+	mock := &MockAuditService{ctrl: ctrl}
+	mock.recorder = &MockAuditServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditService) EXPECT() *MockAuditServiceMockRecorder {
+	return m.recorder
+}
+
+// ExportAuditLog mocks base method.
+func (m *MockAuditService) ExportAuditLog(actorID string, writer io.Writer, format string, filter models.AuditFilter) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportAuditLog", actorID, writer, format, filter)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportAuditLog indicates an expected call of ExportAuditLog.
+func (mr *MockAuditServiceMockRecorder) ExportAuditLog(actorID, writer, format, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportAuditLog", reflect.TypeOf((*MockAuditService)(nil).ExportAuditLog), actorID, writer, format, filter)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockAuditService) GetAuditLog(actorID string, filter models.AuditFilter, request models.PageRequest) (*models.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", actorID, filter, request)
+	ret0, _ := ret[0].(*models.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockAuditServiceMockRecorder) GetAuditLog(actorID, filter, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockAuditService)(nil).GetAuditLog), actorID, filter, request)
+}
+
+// Record mocks base method.
+func (m *MockAuditService) Record(actorID, action, target string, before, after interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", actorID, action, target, before, after)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockAuditServiceMockRecorder) Record(actorID, action, target, before, after interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockAuditService)(nil).Record), actorID, action, target, before, after)
+}
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/leaderboard_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockLeaderboardService is a mock of LeaderboardService interface.
+type MockLeaderboardService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderboardServiceMockRecorder
+}
+
+// MockLeaderboardServiceMockRecorder is the mock recorder for MockLeaderboardService.
+type MockLeaderboardServiceMockRecorder struct {
+	mock *MockLeaderboardService
+}
+
+// NewMockLeaderboardService creates a new mock instance.
+func NewMockLeaderboardService(ctrl *gomock.Controller) *MockLeaderboardService {
+	mock := &MockLeaderboardService{ctrl: ctrl}
+	mock.recorder = &MockLeaderboardServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaderboardService) EXPECT() *MockLeaderboardServiceMockRecorder {
+	return m.recorder
+}
+
+// GetLeaderboard mocks base method.
+func (m *MockLeaderboardService) GetLeaderboard(scope, scopeValue string) (*models.Leaderboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLeaderboard", scope, scopeValue)
+	ret0, _ := ret[0].(*models.Leaderboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLeaderboard indicates an expected call of GetLeaderboard.
+func (mr *MockLeaderboardServiceMockRecorder) GetLeaderboard(scope, scopeValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeaderboard", reflect.TypeOf((*MockLeaderboardService)(nil).GetLeaderboard), scope, scopeValue)
+}
+
+// GetUserStanding mocks base method.
+func (m *MockLeaderboardService) GetUserStanding(board *models.Leaderboard, userID string) (*models.LeaderboardEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserStanding", board, userID)
+	ret0, _ := ret[0].(*models.LeaderboardEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStanding indicates an expected call of GetUserStanding.
+func (mr *MockLeaderboardServiceMockRecorder) GetUserStanding(board, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStanding", reflect.TypeOf((*MockLeaderboardService)(nil).GetUserStanding), board, userID)
+}
@@ -34,49 +34,19 @@ func (m *MockLeetcodeAPI) EXPECT() *MockLeetcodeAPIMockRecorder {
 	return m.recorder
 }
 
-// FetchData mocks base method.
-func (m *MockLeetcodeAPI) FetchData(arg0 string, arg1 map[string]interface{}) (map[string]interface{}, error) {
+// GetRecentACSubmissions mocks base method.
+func (m *MockLeetcodeAPI) GetRecentACSubmissions(LeetcodeID string) (*[]models.LeetcodeSubmission, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FetchData", arg0, arg1)
-	ret0, _ := ret[0].(map[string]interface{})
+	ret := m.ctrl.Call(m, "GetRecentACSubmissions", LeetcodeID)
+	ret0, _ := ret[0].(*[]models.LeetcodeSubmission)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// FetchData indicates an expected call of FetchData.
-func (mr *MockLeetcodeAPIMockRecorder) FetchData(arg0, arg1 interface{}) *gomock.Call {
+// GetRecentACSubmissions indicates an expected call of GetRecentACSubmissions.
+func (mr *MockLeetcodeAPIMockRecorder) GetRecentACSubmissions(LeetcodeID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchData", reflect.TypeOf((*MockLeetcodeAPI)(nil).FetchData), arg0, arg1)
-}
-
-// GetRecentACSubmission mocks base method.
-func (m *MockLeetcodeAPI) GetRecentACSubmission(username string, limit int) ([]string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecentACSubmission", username, limit)
-	ret0, _ := ret[0].([]string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetRecentACSubmission indicates an expected call of GetRecentACSubmission.
-func (mr *MockLeetcodeAPIMockRecorder) GetRecentACSubmission(username, limit interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentACSubmission", reflect.TypeOf((*MockLeetcodeAPI)(nil).GetRecentACSubmission), username, limit)
-}
-
-// GetRecentACSubmissionQuestionIDs mocks base method.
-func (m *MockLeetcodeAPI) GetRecentACSubmissionQuestionIDs(username string) ([]int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetRecentACSubmissionQuestionIDs", username)
-	ret0, _ := ret[0].([]int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetRecentACSubmissionQuestionIDs indicates an expected call of GetRecentACSubmissionQuestionIDs.
-func (mr *MockLeetcodeAPIMockRecorder) GetRecentACSubmissionQuestionIDs(username interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentACSubmissionQuestionIDs", reflect.TypeOf((*MockLeetcodeAPI)(nil).GetRecentACSubmissionQuestionIDs), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecentACSubmissions", reflect.TypeOf((*MockLeetcodeAPI)(nil).GetRecentACSubmissions), LeetcodeID)
 }
 
 // GetStats mocks base method.
@@ -94,21 +64,6 @@ func (mr *MockLeetcodeAPIMockRecorder) GetStats(LeetcodeID interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockLeetcodeAPI)(nil).GetStats), LeetcodeID)
 }
 
-// GetUserProblemSolved mocks base method.
-func (m *MockLeetcodeAPI) GetUserProblemSolved(username string) (*models.LeetcodeStats, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserProblemSolved", username)
-	ret0, _ := ret[0].(*models.LeetcodeStats)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetUserProblemSolved indicates an expected call of GetUserProblemSolved.
-func (mr *MockLeetcodeAPIMockRecorder) GetUserProblemSolved(username interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProblemSolved", reflect.TypeOf((*MockLeetcodeAPI)(nil).GetUserProblemSolved), username)
-}
-
 // ValidateUsername mocks base method.
 func (m *MockLeetcodeAPI) ValidateUsername(username string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,109 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/note_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNoteService is a mock of NoteService interface.
+type MockNoteService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteServiceMockRecorder
+}
+
+// MockNoteServiceMockRecorder is the mock recorder for MockNoteService.
+type MockNoteServiceMockRecorder struct {
+	mock *MockNoteService
+}
+
+// NewMockNoteService creates a new mock instance.
+func NewMockNoteService(ctrl *gomock.Controller) *MockNoteService {
+	mock := &MockNoteService{ctrl: ctrl}
+	mock.recorder = &MockNoteServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteService) EXPECT() *MockNoteServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteNote mocks base method.
+func (m *MockNoteService) DeleteNote(userID, questionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNote", userID, questionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNote indicates an expected call of DeleteNote.
+func (mr *MockNoteServiceMockRecorder) DeleteNote(userID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteService)(nil).DeleteNote), userID, questionID)
+}
+
+// GetNote mocks base method.
+func (m *MockNoteService) GetNote(userID, questionID string) (*models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNote", userID, questionID)
+	ret0, _ := ret[0].(*models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNote indicates an expected call of GetNote.
+func (mr *MockNoteServiceMockRecorder) GetNote(userID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNote", reflect.TypeOf((*MockNoteService)(nil).GetNote), userID, questionID)
+}
+
+// GetNotes mocks base method.
+func (m *MockNoteService) GetNotes(userID string) (*[]models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotes", userID)
+	ret0, _ := ret[0].(*[]models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotes indicates an expected call of GetNotes.
+func (mr *MockNoteServiceMockRecorder) GetNotes(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotes", reflect.TypeOf((*MockNoteService)(nil).GetNotes), userID)
+}
+
+// SaveNote mocks base method.
+func (m *MockNoteService) SaveNote(userID, questionID, body string) (*models.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNote", userID, questionID, body)
+	ret0, _ := ret[0].(*models.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveNote indicates an expected call of SaveNote.
+func (mr *MockNoteServiceMockRecorder) SaveNote(userID, questionID, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNote", reflect.TypeOf((*MockNoteService)(nil).SaveNote), userID, questionID, body)
+}
+
+// SearchNotes mocks base method.
+func (m *MockNoteService) SearchNotes(userID, query string) (*[]models.NoteMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNotes", userID, query)
+	ret0, _ := ret[0].(*[]models.NoteMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchNotes indicates an expected call of SearchNotes.
+func (mr *MockNoteServiceMockRecorder) SearchNotes(userID, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNotes", reflect.TypeOf((*MockNoteService)(nil).SearchNotes), userID, query)
+}
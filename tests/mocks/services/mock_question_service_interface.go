@@ -6,6 +6,7 @@ package mocks
 
 import (
 	models "cli-project/internal/domain/models"
+	io "io"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -35,18 +36,48 @@ func (m *MockQuestionService) EXPECT() *MockQuestionServiceMockRecorder {
 }
 
 // AddQuestionsFromFile mocks base method.
-func (m *MockQuestionService) AddQuestionsFromFile(questionFilePath string) (bool, error) {
+func (m *MockQuestionService) AddQuestionsFromFile(actorID, questionFilePath string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddQuestionsFromFile", questionFilePath)
+	ret := m.ctrl.Call(m, "AddQuestionsFromFile", actorID, questionFilePath)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddQuestionsFromFile indicates an expected call of AddQuestionsFromFile.
-func (mr *MockQuestionServiceMockRecorder) AddQuestionsFromFile(questionFilePath interface{}) *gomock.Call {
+func (mr *MockQuestionServiceMockRecorder) AddQuestionsFromFile(actorID, questionFilePath interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddQuestionsFromFile", reflect.TypeOf((*MockQuestionService)(nil).AddQuestionsFromFile), questionFilePath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddQuestionsFromFile", reflect.TypeOf((*MockQuestionService)(nil).AddQuestionsFromFile), actorID, questionFilePath)
+}
+
+// ExportQuestions mocks base method.
+func (m *MockQuestionService) ExportQuestions(actorID string, writer io.Writer, format, difficulty, company, topic string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportQuestions", actorID, writer, format, difficulty, company, topic)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportQuestions indicates an expected call of ExportQuestions.
+func (mr *MockQuestionServiceMockRecorder) ExportQuestions(actorID, writer, format, difficulty, company, topic interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportQuestions", reflect.TypeOf((*MockQuestionService)(nil).ExportQuestions), actorID, writer, format, difficulty, company, topic)
+}
+
+// FilterQuestions mocks base method.
+func (m *MockQuestionService) FilterQuestions(filter models.QuestionFilter) (*[]models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterQuestions", filter)
+	ret0, _ := ret[0].(*[]models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterQuestions indicates an expected call of FilterQuestions.
+func (mr *MockQuestionServiceMockRecorder) FilterQuestions(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterQuestions", reflect.TypeOf((*MockQuestionService)(nil).FilterQuestions), filter)
 }
 
 // GetAllQuestions mocks base method.
@@ -64,6 +95,21 @@ func (mr *MockQuestionServiceMockRecorder) GetAllQuestions() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllQuestions", reflect.TypeOf((*MockQuestionService)(nil).GetAllQuestions))
 }
 
+// GetCompletionByDifficulty mocks base method.
+func (m *MockQuestionService) GetCompletionByDifficulty(solvedQuestionIDs []string) (*[]models.DifficultyCompletion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompletionByDifficulty", solvedQuestionIDs)
+	ret0, _ := ret[0].(*[]models.DifficultyCompletion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompletionByDifficulty indicates an expected call of GetCompletionByDifficulty.
+func (mr *MockQuestionServiceMockRecorder) GetCompletionByDifficulty(solvedQuestionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompletionByDifficulty", reflect.TypeOf((*MockQuestionService)(nil).GetCompletionByDifficulty), solvedQuestionIDs)
+}
+
 // GetQuestionByID mocks base method.
 func (m *MockQuestionService) GetQuestionByID(questionID string) (*models.Question, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +140,21 @@ func (mr *MockQuestionServiceMockRecorder) GetQuestionsByFilters(difficulty, com
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionsByFilters", reflect.TypeOf((*MockQuestionService)(nil).GetQuestionsByFilters), difficulty, company, topic)
 }
 
+// GetQuestionsPage mocks base method.
+func (m *MockQuestionService) GetQuestionsPage(request models.PageRequest, excludeIDs []string) (*models.QuestionPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionsPage", request, excludeIDs)
+	ret0, _ := ret[0].(*models.QuestionPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionsPage indicates an expected call of GetQuestionsPage.
+func (mr *MockQuestionServiceMockRecorder) GetQuestionsPage(request, excludeIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionsPage", reflect.TypeOf((*MockQuestionService)(nil).GetQuestionsPage), request, excludeIDs)
+}
+
 // GetTotalQuestionsCount mocks base method.
 func (m *MockQuestionService) GetTotalQuestionsCount() (int64, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +170,21 @@ func (mr *MockQuestionServiceMockRecorder) GetTotalQuestionsCount() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalQuestionsCount", reflect.TypeOf((*MockQuestionService)(nil).GetTotalQuestionsCount))
 }
 
+// ImportQuestionsFromFile mocks base method.
+func (m *MockQuestionService) ImportQuestionsFromFile(actorID, questionFilePath string, dryRun bool) (*models.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportQuestionsFromFile", actorID, questionFilePath, dryRun)
+	ret0, _ := ret[0].(*models.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportQuestionsFromFile indicates an expected call of ImportQuestionsFromFile.
+func (mr *MockQuestionServiceMockRecorder) ImportQuestionsFromFile(actorID, questionFilePath, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportQuestionsFromFile", reflect.TypeOf((*MockQuestionService)(nil).ImportQuestionsFromFile), actorID, questionFilePath, dryRun)
+}
+
 // QuestionExists mocks base method.
 func (m *MockQuestionService) QuestionExists(questionID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -125,15 +201,45 @@ func (mr *MockQuestionServiceMockRecorder) QuestionExists(questionID interface{}
 }
 
 // RemoveQuestionByID mocks base method.
-func (m *MockQuestionService) RemoveQuestionByID(questionID string) error {
+func (m *MockQuestionService) RemoveQuestionByID(actorID, questionID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RemoveQuestionByID", questionID)
+	ret := m.ctrl.Call(m, "RemoveQuestionByID", actorID, questionID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // RemoveQuestionByID indicates an expected call of RemoveQuestionByID.
-func (mr *MockQuestionServiceMockRecorder) RemoveQuestionByID(questionID interface{}) *gomock.Call {
+func (mr *MockQuestionServiceMockRecorder) RemoveQuestionByID(actorID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQuestionByID", reflect.TypeOf((*MockQuestionService)(nil).RemoveQuestionByID), actorID, questionID)
+}
+
+// SearchQuestions mocks base method.
+func (m *MockQuestionService) SearchQuestions(query string, limit int) (*[]models.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchQuestions", query, limit)
+	ret0, _ := ret[0].(*[]models.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchQuestions indicates an expected call of SearchQuestions.
+func (mr *MockQuestionServiceMockRecorder) SearchQuestions(query, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchQuestions", reflect.TypeOf((*MockQuestionService)(nil).SearchQuestions), query, limit)
+}
+
+// UpdateQuestion mocks base method.
+func (m *MockQuestionService) UpdateQuestion(actorID, questionID string, update models.QuestionUpdate) (*models.Question, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuestion", actorID, questionID, update)
+	ret0, _ := ret[0].(*models.Question)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuestion indicates an expected call of UpdateQuestion.
+func (mr *MockQuestionServiceMockRecorder) UpdateQuestion(actorID, questionID, update interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQuestionByID", reflect.TypeOf((*MockQuestionService)(nil).RemoveQuestionByID), questionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuestion", reflect.TypeOf((*MockQuestionService)(nil).UpdateQuestion), actorID, questionID, update)
 }
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/recommendation_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockRecommendationService is a mock of RecommendationService interface.
+type MockRecommendationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecommendationServiceMockRecorder
+}
+
+// MockRecommendationServiceMockRecorder is the mock recorder for MockRecommendationService.
+type MockRecommendationServiceMockRecorder struct {
+	mock *MockRecommendationService
+}
+
+// NewMockRecommendationService creates a new mock instance.
+func NewMockRecommendationService(ctrl *gomock.Controller) *MockRecommendationService {
+	mock := &MockRecommendationService{ctrl: ctrl}
+	mock.recorder = &MockRecommendationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecommendationService) EXPECT() *MockRecommendationServiceMockRecorder {
+	return m.recorder
+}
+
+// RecommendQuestions mocks base method.
+func (m *MockRecommendationService) RecommendQuestions(userID string, options models.RecommendationOptions) (*models.RecommendationReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecommendQuestions", userID, options)
+	ret0, _ := ret[0].(*models.RecommendationReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecommendQuestions indicates an expected call of RecommendQuestions.
+func (mr *MockRecommendationServiceMockRecorder) RecommendQuestions(userID, options interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecommendQuestions", reflect.TypeOf((*MockRecommendationService)(nil).RecommendQuestions), userID, options)
+}
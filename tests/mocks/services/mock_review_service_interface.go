@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/review_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockReviewService is a mock of ReviewService interface.
+type MockReviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewServiceMockRecorder
+}
+
+// MockReviewServiceMockRecorder is the mock recorder for MockReviewService.
+type MockReviewServiceMockRecorder struct {
+	mock *MockReviewService
+}
+
+// NewMockReviewService creates a new mock instance.
+func NewMockReviewService(ctrl *gomock.Controller) *MockReviewService {
+	mock := &MockReviewService{ctrl: ctrl}
+	mock.recorder = &MockReviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewService) EXPECT() *MockReviewServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDueReviews mocks base method.
+func (m *MockReviewService) GetDueReviews(userID string) (*[]models.ReviewCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueReviews", userID)
+	ret0, _ := ret[0].(*[]models.ReviewCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueReviews indicates an expected call of GetDueReviews.
+func (mr *MockReviewServiceMockRecorder) GetDueReviews(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueReviews", reflect.TypeOf((*MockReviewService)(nil).GetDueReviews), userID)
+}
+
+// RecordReview mocks base method.
+func (m *MockReviewService) RecordReview(userID, questionID string, rating int) (*models.ReviewCard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordReview", userID, questionID, rating)
+	ret0, _ := ret[0].(*models.ReviewCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordReview indicates an expected call of RecordReview.
+func (mr *MockReviewServiceMockRecorder) RecordReview(userID, questionID, rating interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordReview", reflect.TypeOf((*MockReviewService)(nil).RecordReview), userID, questionID, rating)
+}
@@ -0,0 +1,150 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/interfaces/study_list_service_interface.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	models "cli-project/internal/domain/models"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStudyListService is a mock of StudyListService interface.
+type MockStudyListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStudyListServiceMockRecorder
+}
+
+// MockStudyListServiceMockRecorder is the mock recorder for MockStudyListService.
+type MockStudyListServiceMockRecorder struct {
+	mock *MockStudyListService
+}
+
+// NewMockStudyListService creates a new mock instance.
+func NewMockStudyListService(ctrl *gomock.Controller) *MockStudyListService {
+	mock := &MockStudyListService{ctrl: ctrl}
+	mock.recorder = &MockStudyListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStudyListService) EXPECT() *MockStudyListServiceMockRecorder {
+	return m.recorder
+}
+
+// AddQuestionToList mocks base method.
+func (m *MockStudyListService) AddQuestionToList(userID, listID, questionID string, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddQuestionToList", userID, listID, questionID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddQuestionToList indicates an expected call of AddQuestionToList.
+func (mr *MockStudyListServiceMockRecorder) AddQuestionToList(userID, listID, questionID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddQuestionToList", reflect.TypeOf((*MockStudyListService)(nil).AddQuestionToList), userID, listID, questionID, position)
+}
+
+// CreateStudyList mocks base method.
+func (m *MockStudyListService) CreateStudyList(userID, name, description string, shared bool) (*models.StudyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStudyList", userID, name, description, shared)
+	ret0, _ := ret[0].(*models.StudyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStudyList indicates an expected call of CreateStudyList.
+func (mr *MockStudyListServiceMockRecorder) CreateStudyList(userID, name, description, shared interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStudyList", reflect.TypeOf((*MockStudyListService)(nil).CreateStudyList), userID, name, description, shared)
+}
+
+// DeleteStudyList mocks base method.
+func (m *MockStudyListService) DeleteStudyList(userID, listID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStudyList", userID, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStudyList indicates an expected call of DeleteStudyList.
+func (mr *MockStudyListServiceMockRecorder) DeleteStudyList(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStudyList", reflect.TypeOf((*MockStudyListService)(nil).DeleteStudyList), userID, listID)
+}
+
+// GetStudyListProgress mocks base method.
+func (m *MockStudyListService) GetStudyListProgress(userID, listID string) (*models.StudyListProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudyListProgress", userID, listID)
+	ret0, _ := ret[0].(*models.StudyListProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudyListProgress indicates an expected call of GetStudyListProgress.
+func (mr *MockStudyListServiceMockRecorder) GetStudyListProgress(userID, listID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudyListProgress", reflect.TypeOf((*MockStudyListService)(nil).GetStudyListProgress), userID, listID)
+}
+
+// GetStudyLists mocks base method.
+func (m *MockStudyListService) GetStudyLists(userID string) (*[]models.StudyList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStudyLists", userID)
+	ret0, _ := ret[0].(*[]models.StudyList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStudyLists indicates an expected call of GetStudyLists.
+func (mr *MockStudyListServiceMockRecorder) GetStudyLists(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStudyLists", reflect.TypeOf((*MockStudyListService)(nil).GetStudyLists), userID)
+}
+
+// MoveQuestionInList mocks base method.
+func (m *MockStudyListService) MoveQuestionInList(userID, listID, questionID string, position int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveQuestionInList", userID, listID, questionID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveQuestionInList indicates an expected call of MoveQuestionInList.
+func (mr *MockStudyListServiceMockRecorder) MoveQuestionInList(userID, listID, questionID, position interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveQuestionInList", reflect.TypeOf((*MockStudyListService)(nil).MoveQuestionInList), userID, listID, questionID, position)
+}
+
+// RemoveQuestionFromList mocks base method.
+func (m *MockStudyListService) RemoveQuestionFromList(userID, listID, questionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveQuestionFromList", userID, listID, questionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveQuestionFromList indicates an expected call of RemoveQuestionFromList.
+func (mr *MockStudyListServiceMockRecorder) RemoveQuestionFromList(userID, listID, questionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQuestionFromList", reflect.TypeOf((*MockStudyListService)(nil).RemoveQuestionFromList), userID, listID, questionID)
+}
+
+// SetStudyListShared mocks base method.
+func (m *MockStudyListService) SetStudyListShared(userID, listID string, shared bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStudyListShared", userID, listID, shared)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetStudyListShared indicates an expected call of SetStudyListShared.
+func (mr *MockStudyListServiceMockRecorder) SetStudyListShared(userID, listID, shared interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStudyListShared", reflect.TypeOf((*MockStudyListService)(nil).SetStudyListShared), userID, listID, shared)
+}
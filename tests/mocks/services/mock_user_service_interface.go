@@ -6,7 +6,9 @@ package mocks
 
 import (
 	models "cli-project/internal/domain/models"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 )
@@ -35,33 +37,106 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 }
 
 // BanUser mocks base method.
-func (m *MockUserService) BanUser(username string) (bool, error) {
+func (m *MockUserService) BanUser(actorID, username, reason string, expiresAt time.Time) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BanUser", username)
+	ret := m.ctrl.Call(m, "BanUser", actorID, username, reason, expiresAt)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // BanUser indicates an expected call of BanUser.
-func (mr *MockUserServiceMockRecorder) BanUser(username interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) BanUser(actorID, username, reason, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockUserService)(nil).BanUser), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockUserService)(nil).BanUser), actorID, username, reason, expiresAt)
+}
+
+// BootstrapAdmin mocks base method.
+func (m *MockUserService) BootstrapAdmin(user *models.StandardUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BootstrapAdmin", user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BootstrapAdmin indicates an expected call of BootstrapAdmin.
+func (mr *MockUserServiceMockRecorder) BootstrapAdmin(user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BootstrapAdmin", reflect.TypeOf((*MockUserService)(nil).BootstrapAdmin), user)
+}
+
+// ChangeUserRole mocks base method.
+func (m *MockUserService) ChangeUserRole(actorID, username, role string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeUserRole", actorID, username, role)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeUserRole indicates an expected call of ChangeUserRole.
+func (mr *MockUserServiceMockRecorder) ChangeUserRole(actorID, username, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeUserRole", reflect.TypeOf((*MockUserService)(nil).ChangeUserRole), actorID, username, role)
 }
 
 // CountActiveUserInLast24Hours mocks base method.
-func (m *MockUserService) CountActiveUserInLast24Hours() (int64, error) {
+func (m *MockUserService) CountActiveUserInLast24Hours(actorID string) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CountActiveUserInLast24Hours")
+	ret := m.ctrl.Call(m, "CountActiveUserInLast24Hours", actorID)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CountActiveUserInLast24Hours indicates an expected call of CountActiveUserInLast24Hours.
-func (mr *MockUserServiceMockRecorder) CountActiveUserInLast24Hours() *gomock.Call {
+func (mr *MockUserServiceMockRecorder) CountActiveUserInLast24Hours(actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveUserInLast24Hours", reflect.TypeOf((*MockUserService)(nil).CountActiveUserInLast24Hours), actorID)
+}
+
+// DemoteUser mocks base method.
+func (m *MockUserService) DemoteUser(actorID, username string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DemoteUser", actorID, username)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DemoteUser indicates an expected call of DemoteUser.
+func (mr *MockUserServiceMockRecorder) DemoteUser(actorID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DemoteUser", reflect.TypeOf((*MockUserService)(nil).DemoteUser), actorID, username)
+}
+
+// ExportProgress mocks base method.
+func (m *MockUserService) ExportProgress(userID string, writer io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportProgress", userID, writer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportProgress indicates an expected call of ExportProgress.
+func (mr *MockUserServiceMockRecorder) ExportProgress(userID, writer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveUserInLast24Hours", reflect.TypeOf((*MockUserService)(nil).CountActiveUserInLast24Hours))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportProgress", reflect.TypeOf((*MockUserService)(nil).ExportProgress), userID, writer)
+}
+
+// GetActiveBan mocks base method.
+func (m *MockUserService) GetActiveBan(userID string) (*models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveBan", userID)
+	ret0, _ := ret[0].(*models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveBan indicates an expected call of GetActiveBan.
+func (mr *MockUserServiceMockRecorder) GetActiveBan(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveBan", reflect.TypeOf((*MockUserService)(nil).GetActiveBan), userID)
 }
 
 // GetAllUsers mocks base method.
@@ -79,6 +154,36 @@ func (mr *MockUserServiceMockRecorder) GetAllUsers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllUsers", reflect.TypeOf((*MockUserService)(nil).GetAllUsers))
 }
 
+// GetBanAppeals mocks base method.
+func (m *MockUserService) GetBanAppeals(actorID, status string) (*[]models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBanAppeals", actorID, status)
+	ret0, _ := ret[0].(*[]models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBanAppeals indicates an expected call of GetBanAppeals.
+func (mr *MockUserServiceMockRecorder) GetBanAppeals(actorID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBanAppeals", reflect.TypeOf((*MockUserService)(nil).GetBanAppeals), actorID, status)
+}
+
+// GetDailyActivity mocks base method.
+func (m *MockUserService) GetDailyActivity(userID string, days int) (*[]models.DailyActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDailyActivity", userID, days)
+	ret0, _ := ret[0].(*[]models.DailyActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDailyActivity indicates an expected call of GetDailyActivity.
+func (mr *MockUserServiceMockRecorder) GetDailyActivity(userID, days interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDailyActivity", reflect.TypeOf((*MockUserService)(nil).GetDailyActivity), userID, days)
+}
+
 // GetLeetcodeStats mocks base method.
 func (m *MockUserService) GetLeetcodeStats(userID string) (*models.LeetcodeStats, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +199,36 @@ func (mr *MockUserServiceMockRecorder) GetLeetcodeStats(userID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLeetcodeStats", reflect.TypeOf((*MockUserService)(nil).GetLeetcodeStats), userID)
 }
 
+// GetSolveHistory mocks base method.
+func (m *MockUserService) GetSolveHistory(userID string) (*[]models.SolveEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSolveHistory", userID)
+	ret0, _ := ret[0].(*[]models.SolveEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSolveHistory indicates an expected call of GetSolveHistory.
+func (mr *MockUserServiceMockRecorder) GetSolveHistory(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolveHistory", reflect.TypeOf((*MockUserService)(nil).GetSolveHistory), userID)
+}
+
+// GetStreaks mocks base method.
+func (m *MockUserService) GetStreaks(userID string) (*models.Streaks, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreaks", userID)
+	ret0, _ := ret[0].(*models.Streaks)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreaks indicates an expected call of GetStreaks.
+func (mr *MockUserServiceMockRecorder) GetStreaks(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreaks", reflect.TypeOf((*MockUserService)(nil).GetStreaks), userID)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUserService) GetUserByID(userID string) (*models.StandardUser, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +289,36 @@ func (mr *MockUserServiceMockRecorder) GetUserRole(userID interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockUserService)(nil).GetUserRole), userID)
 }
 
+// GetUsersPage mocks base method.
+func (m *MockUserService) GetUsersPage(role string, request models.PageRequest) (*models.UserPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersPage", role, request)
+	ret0, _ := ret[0].(*models.UserPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersPage indicates an expected call of GetUsersPage.
+func (mr *MockUserServiceMockRecorder) GetUsersPage(role, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersPage", reflect.TypeOf((*MockUserService)(nil).GetUsersPage), role, request)
+}
+
+// ImportProgress mocks base method.
+func (m *MockUserService) ImportProgress(userID string, reader io.Reader) (*models.ProgressImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportProgress", userID, reader)
+	ret0, _ := ret[0].(*models.ProgressImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportProgress indicates an expected call of ImportProgress.
+func (mr *MockUserServiceMockRecorder) ImportProgress(userID, reader interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportProgress", reflect.TypeOf((*MockUserService)(nil).ImportProgress), userID, reader)
+}
+
 // IsUserBanned mocks base method.
 func (m *MockUserService) IsUserBanned(userID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -170,11 +335,12 @@ func (mr *MockUserServiceMockRecorder) IsUserBanned(userID interface{}) *gomock.
 }
 
 // Login mocks base method.
-func (m *MockUserService) Login(username, password string) error {
+func (m *MockUserService) Login(username, password string) (*models.Session, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Login", username, password)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
@@ -184,17 +350,62 @@ func (mr *MockUserServiceMockRecorder) Login(username, password interface{}) *go
 }
 
 // Logout mocks base method.
-func (m *MockUserService) Logout() error {
+func (m *MockUserService) Logout(session *models.Session) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logout")
+	ret := m.ctrl.Call(m, "Logout", session)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Logout indicates an expected call of Logout.
-func (mr *MockUserServiceMockRecorder) Logout() *gomock.Call {
+func (mr *MockUserServiceMockRecorder) Logout(session interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserService)(nil).Logout))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserService)(nil).Logout), session)
+}
+
+// PromoteUser mocks base method.
+func (m *MockUserService) PromoteUser(actorID, username string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteUser", actorID, username)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteUser indicates an expected call of PromoteUser.
+func (mr *MockUserServiceMockRecorder) PromoteUser(actorID, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteUser", reflect.TypeOf((*MockUserService)(nil).PromoteUser), actorID, username)
+}
+
+// ResolveBanAppeal mocks base method.
+func (m *MockUserService) ResolveBanAppeal(actorID, banID string, accept bool, response string) (*models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveBanAppeal", actorID, banID, accept, response)
+	ret0, _ := ret[0].(*models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveBanAppeal indicates an expected call of ResolveBanAppeal.
+func (mr *MockUserServiceMockRecorder) ResolveBanAppeal(actorID, banID, accept, response interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveBanAppeal", reflect.TypeOf((*MockUserService)(nil).ResolveBanAppeal), actorID, banID, accept, response)
+}
+
+// ResumeSession mocks base method.
+func (m *MockUserService) ResumeSession(token string) (*models.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeSession", token)
+	ret0, _ := ret[0].(*models.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeSession indicates an expected call of ResumeSession.
+func (mr *MockUserServiceMockRecorder) ResumeSession(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeSession", reflect.TypeOf((*MockUserService)(nil).ResumeSession), token)
 }
 
 // Signup mocks base method.
@@ -211,46 +422,76 @@ func (mr *MockUserServiceMockRecorder) Signup(user interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signup", reflect.TypeOf((*MockUserService)(nil).Signup), user)
 }
 
+// SubmitBanAppeal mocks base method.
+func (m *MockUserService) SubmitBanAppeal(userID, message string) (*models.Ban, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitBanAppeal", userID, message)
+	ret0, _ := ret[0].(*models.Ban)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitBanAppeal indicates an expected call of SubmitBanAppeal.
+func (mr *MockUserServiceMockRecorder) SubmitBanAppeal(userID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitBanAppeal", reflect.TypeOf((*MockUserService)(nil).SubmitBanAppeal), userID, message)
+}
+
+// SyncLeetcodeProgress mocks base method.
+func (m *MockUserService) SyncLeetcodeProgress(userID string) (*models.SyncReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncLeetcodeProgress", userID)
+	ret0, _ := ret[0].(*models.SyncReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncLeetcodeProgress indicates an expected call of SyncLeetcodeProgress.
+func (mr *MockUserServiceMockRecorder) SyncLeetcodeProgress(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncLeetcodeProgress", reflect.TypeOf((*MockUserService)(nil).SyncLeetcodeProgress), userID)
+}
+
 // UnbanUser mocks base method.
-func (m *MockUserService) UnbanUser(username string) (bool, error) {
+func (m *MockUserService) UnbanUser(actorID, username string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnbanUser", username)
+	ret := m.ctrl.Call(m, "UnbanUser", actorID, username)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UnbanUser indicates an expected call of UnbanUser.
-func (mr *MockUserServiceMockRecorder) UnbanUser(username interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) UnbanUser(actorID, username interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockUserService)(nil).UnbanUser), username)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockUserService)(nil).UnbanUser), actorID, username)
 }
 
 // UpdateUserProgress mocks base method.
-func (m *MockUserService) UpdateUserProgress(solvedQuestionID string) (bool, error) {
+func (m *MockUserService) UpdateUserProgress(userID string, event models.SolveEvent) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUserProgress", solvedQuestionID)
+	ret := m.ctrl.Call(m, "UpdateUserProgress", userID, event)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateUserProgress indicates an expected call of UpdateUserProgress.
-func (mr *MockUserServiceMockRecorder) UpdateUserProgress(solvedQuestionID interface{}) *gomock.Call {
+func (mr *MockUserServiceMockRecorder) UpdateUserProgress(userID, event interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProgress", reflect.TypeOf((*MockUserService)(nil).UpdateUserProgress), solvedQuestionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProgress", reflect.TypeOf((*MockUserService)(nil).UpdateUserProgress), userID, event)
 }
 
 // ViewDashboard mocks base method.
-func (m *MockUserService) ViewDashboard() error {
+func (m *MockUserService) ViewDashboard(userID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ViewDashboard")
+	ret := m.ctrl.Call(m, "ViewDashboard", userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ViewDashboard indicates an expected call of ViewDashboard.
-func (mr *MockUserServiceMockRecorder) ViewDashboard() *gomock.Call {
+func (mr *MockUserServiceMockRecorder) ViewDashboard(userID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewDashboard", reflect.TypeOf((*MockUserService)(nil).ViewDashboard))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewDashboard", reflect.TypeOf((*MockUserService)(nil).ViewDashboard), userID)
 }
@@ -0,0 +1,892 @@
+package server_test
+
+import (
+	"bytes"
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"cli-project/internal/server"
+	mock_services "cli-project/tests/mocks/services"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testSession = &models.Session{ID: "session-id", UserID: "user-id", Username: "testuser", Token: "token", ExpiresAt: time.Now().Add(time.Hour)}
+
+type testServer struct {
+	handler             http.Handler
+	mockUserService     *mock_services.MockUserService
+	mockQuestionService *mock_services.MockQuestionService
+	mockAuthService     *mock_services.MockAuthService
+	mockReviewService   *mock_services.MockReviewService
+	mockRecommender     *mock_services.MockRecommendationService
+	mockStudyLists      *mock_services.MockStudyListService
+	mockNotes           *mock_services.MockNoteService
+	mockAnnouncements   *mock_services.MockAnnouncementService
+	mockAudit           *mock_services.MockAuditService
+}
+
+func newTestServer(t *testing.T) *testServer {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	ts := &testServer{
+		mockUserService:     mock_services.NewMockUserService(ctrl),
+		mockQuestionService: mock_services.NewMockQuestionService(ctrl),
+		mockAuthService:     mock_services.NewMockAuthService(ctrl),
+		mockReviewService:   mock_services.NewMockReviewService(ctrl),
+		mockRecommender:     mock_services.NewMockRecommendationService(ctrl),
+		mockStudyLists:      mock_services.NewMockStudyListService(ctrl),
+		mockNotes:           mock_services.NewMockNoteService(ctrl),
+		mockAnnouncements:   mock_services.NewMockAnnouncementService(ctrl),
+		mockAudit:           mock_services.NewMockAuditService(ctrl),
+	}
+	ts.handler = server.NewServer(ts.mockUserService, ts.mockQuestionService, ts.mockAuthService, ts.mockReviewService, ts.mockRecommender, ts.mockStudyLists, ts.mockNotes, ts.mockAnnouncements, ts.mockAudit)
+	return ts
+}
+
+func (ts *testServer) do(method, path, token string, body interface{}) *httptest.ResponseRecorder {
+	var payload bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&payload).Encode(body)
+	}
+
+	req := httptest.NewRequest(method, path, &payload)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	ts.handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// expectAuth mocks a valid token belonging to a user with the given role and ban status
+func (ts *testServer) expectAuth(role string, banned bool) *models.StandardUser {
+	user := &models.StandardUser{
+		StandardUser:    models.User{ID: "user-id", Username: "testuser", Role: role, IsBanned: banned},
+		QuestionsSolved: []string{"1", "2"},
+	}
+	ts.mockUserService.EXPECT().ResumeSession("token").Return(testSession, nil)
+	ts.mockUserService.EXPECT().GetUserByID("user-id").Return(user, nil)
+	return user
+}
+
+// expectAuthUser mocks the user lookup done after a password login
+func (ts *testServer) expectAuthUser(role string, banned bool) {
+	ts.mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", Username: "testuser", Role: role, IsBanned: banned},
+	}, nil)
+}
+
+func decode(t *testing.T, rec *httptest.ResponseRecorder) map[string]interface{} {
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	return body
+}
+
+func TestServer_Login(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.mockUserService.EXPECT().Login("testuser", "Password@123").Return(testSession, nil)
+	ts.expectAuthUser(roles.USER, false)
+
+	rec := ts.do(http.MethodPost, "/api/login", "", map[string]string{"username": "testuser", "password": "Password@123"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, "token", body["token"])
+	assert.Equal(t, "testuser", body["user"].(map[string]interface{})["username"])
+	assert.NotContains(t, rec.Body.String(), "password")
+}
+
+func TestServer_Login_InvalidCredentials(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.mockUserService.EXPECT().Login("nobody", "Password@123").Return(nil, services.ErrUserNotFound)
+
+	rec := ts.do(http.MethodPost, "/api/login", "", map[string]string{"username": "nobody", "password": "Password@123"})
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, services.ErrInvalidCredentials.Error(), decode(t, rec)["error"])
+}
+
+func TestServer_Login_Banned(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.mockUserService.EXPECT().Login("testuser", "Password@123").Return(testSession, nil)
+	ts.expectAuthUser(roles.USER, true)
+	ts.mockUserService.EXPECT().Logout(testSession).Return(nil)
+	ts.mockUserService.EXPECT().GetActiveBan("user-id").Return(nil, services.ErrBanNotFound)
+
+	rec := ts.do(http.MethodPost, "/api/login", "", map[string]string{"username": "testuser", "password": "Password@123"})
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "you are banned from the platform", decode(t, rec)["error"])
+}
+
+func TestServer_Signup_InvalidUsername(t *testing.T) {
+	ts := newTestServer(t)
+
+	rec := ts.do(http.MethodPost, "/api/signup", "", map[string]string{"username": "ab"})
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, decode(t, rec)["error"], "invalid username")
+}
+
+func TestServer_Signup_UsernameTaken(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.mockAuthService.EXPECT().IsUsernameUnique("testuser").Return(false, nil)
+
+	rec := ts.do(http.MethodPost, "/api/signup", "", map[string]string{"username": "TestUser"})
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestServer_Signup(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.mockAuthService.EXPECT().IsUsernameUnique("testuser").Return(true, nil)
+	ts.mockAuthService.EXPECT().IsEmailUnique("testuser@gmail.com").Return(true, nil)
+	ts.mockAuthService.EXPECT().IsLeetcodeIDUnique("test_leet").Return(true, nil)
+	ts.mockAuthService.EXPECT().ValidateLeetcodeUsername("test_leet").Return(true, nil)
+	ts.mockUserService.EXPECT().Signup(gomock.Any()).DoAndReturn(func(user *models.StandardUser) error {
+		user.StandardUser.ID = "new-id"
+		return nil
+	})
+
+	rec := ts.do(http.MethodPost, "/api/signup", "", map[string]string{
+		"username":     "testuser",
+		"password":     "Password@123",
+		"name":         "Test User",
+		"email":        "testuser@gmail.com",
+		"leetcode_id":  "test_leet",
+		"organisation": "watchguard",
+		"country":      "india",
+	})
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "new-id", decode(t, rec)["id"])
+}
+
+func TestServer_Signup_UnknownField(t *testing.T) {
+	ts := newTestServer(t)
+
+	rec := ts.do(http.MethodPost, "/api/signup", "", map[string]string{"user": "testuser"})
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_MissingToken(t *testing.T) {
+	ts := newTestServer(t)
+
+	rec := ts.do(http.MethodGet, "/api/questions", "", nil)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_InvalidToken(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.mockUserService.EXPECT().ResumeSession("token").Return(nil, services.ErrSessionRevoked)
+
+	rec := ts.do(http.MethodGet, "/api/questions", "token", nil)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestServer_BannedUser(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, true)
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	ts.mockUserService.EXPECT().GetActiveBan("user-id").Return(&models.Ban{Reason: "posting spam", ExpiresAt: expiresAt}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/questions", "token", nil)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, "you are banned from the platform until 2030-01-02T03:04:05Z: posting spam", decode(t, rec)["error"])
+}
+
+func TestServer_ListQuestions_Filtered(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().FilterQuestions(gomock.Any()).DoAndReturn(func(filter models.QuestionFilter) (*[]models.Question, error) {
+		assert.Equal(t, []string{"medium", "hard"}, filter.Difficulties)
+		assert.Equal(t, []string{"google", "meta"}, filter.Companies)
+		assert.True(t, filter.MatchAllCompanies)
+		assert.Equal(t, "unsolved", filter.Status)
+		return &[]models.Question{{QuestionID: "15", QuestionTitle: "3Sum", Difficulty: "medium"}}, nil
+	})
+
+	rec := ts.do(http.MethodGet, "/api/questions?difficulty=medium,hard&company=google&company=meta&company_match=all&status=unsolved", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var questions []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &questions))
+	require.Len(t, questions, 1)
+	assert.Equal(t, "3Sum", questions[0]["question_title"])
+}
+
+func TestServer_SearchQuestions(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().SearchQuestions("two sum", 5).Return(&[]models.SearchResult{
+		{Question: models.Question{QuestionID: "1", QuestionTitle: "two sum"}, Score: 1, MatchedOn: "title"},
+	}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/questions/search?q=two+sum&limit=5", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var results []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &results))
+	require.Len(t, results, 1)
+	assert.Equal(t, "title", results[0]["matched_on"])
+}
+
+func TestServer_SearchQuestions_EmptyQuery(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().SearchQuestions("", 0).Return(nil, services.ErrEmptySearchQuery)
+
+	rec := ts.do(http.MethodGet, "/api/questions/search", "token", nil)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_SaveNote(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("1").Return(true, nil)
+	ts.mockNotes.EXPECT().SaveNote("user-id", "1", "Hash map\n```go\nx := 1\n```").Return(&models.Note{
+		QuestionID: "1",
+		Body:       "Hash map\n```go\nx := 1\n```",
+		Snippets:   []models.CodeSnippet{{Language: "go", Code: "x := 1"}},
+	}, nil)
+
+	rec := ts.do(http.MethodPut, "/api/notes/1", "token", map[string]string{"body": "Hash map\n```go\nx := 1\n```"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var note map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &note))
+	assert.Equal(t, "1", note["question_id"])
+	assert.Len(t, note["snippets"], 1)
+}
+
+func TestServer_SaveNote_Empty(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("1").Return(true, nil)
+	ts.mockNotes.EXPECT().SaveNote("user-id", "1", " ").Return(nil, services.ErrEmptyNote)
+
+	rec := ts.do(http.MethodPut, "/api/notes/1", "token", map[string]string{"body": " "})
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_GetNote_NotFound(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockNotes.EXPECT().GetNote("user-id", "1").Return(nil, services.ErrNoteNotFound)
+
+	rec := ts.do(http.MethodGet, "/api/notes/1", "token", nil)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_SearchNotes(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockNotes.EXPECT().SearchNotes("user-id", "hash map").Return(&[]models.NoteMatch{
+		{Note: models.Note{QuestionID: "1", Body: "Use a hash map"}, Excerpt: "Use a hash map"},
+	}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/notes/search?q=hash+map", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var matches []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &matches))
+	require.Len(t, matches, 1)
+	assert.Equal(t, "Use a hash map", matches[0]["excerpt"])
+}
+
+func TestServer_ListAnnouncements(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockAnnouncements.EXPECT().GetUserAnnouncements("user-id").Return(&[]models.UserAnnouncement{
+		{Announcement: models.Announcement{ID: "id", Title: "Welcome", Audience: models.AudienceEveryone}, Read: true},
+	}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/announcements", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var announcements []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &announcements))
+	require.Len(t, announcements, 1)
+	assert.Equal(t, "Welcome", announcements[0]["title"])
+	assert.Equal(t, true, announcements[0]["read"])
+}
+
+func TestServer_CreateAnnouncement(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockAnnouncements.EXPECT().CreateAnnouncement("user-id", models.AnnouncementDraft{Title: "Contest", Body: "Sunday", Audience: "organisation", Target: "acme"}).
+		Return(&models.Announcement{ID: "id", Title: "Contest", Reads: []models.ReadReceipt{}}, nil)
+
+	rec := ts.do(http.MethodPost, "/api/admin/announcements", "token", map[string]string{"title": "Contest", "body": "Sunday", "audience": "organisation", "target": "acme"})
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func TestServer_CreateAnnouncement_NotAdmin(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+
+	rec := ts.do(http.MethodPost, "/api/admin/announcements", "token", map[string]string{"title": "Contest", "body": "Sunday"})
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_ExpireAnnouncement_NotFound(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockAnnouncements.EXPECT().ExpireAnnouncement("user-id", "missing").Return(services.ErrAnnouncementNotFound)
+
+	rec := ts.do(http.MethodPost, "/api/admin/announcements/missing/expire", "token", nil)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_ListQuestions_InvalidFilter(t *testing.T) {
+	for _, query := range []string{"difficulty=extreme", "status=attempted", "topic_match=most"} {
+		t.Run(query, func(t *testing.T) {
+			ts := newTestServer(t)
+
+			ts.expectAuth(roles.USER, false)
+
+			rec := ts.do(http.MethodGet, "/api/questions?"+query, "token", nil)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestServer_GetQuestion_NotFound(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("999").Return(false, nil)
+
+	rec := ts.do(http.MethodGet, "/api/questions/999", "token", nil)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_Recommendations(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockRecommender.EXPECT().RecommendQuestions("user-id", models.RecommendationOptions{TargetCompany: "google", Progression: "gradual", Limit: 5}).Return(&models.RecommendationReport{
+		Level: "easy",
+		Recommendations: []models.Recommendation{
+			{Question: models.Question{QuestionID: "1", QuestionTitle: "Two Sum"}, Score: 7, Reasons: []string{"asked by google"}},
+		},
+	}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/recommendations?company=google&limit=5", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, "easy", body["level"])
+	assert.Len(t, body["recommendations"], 1)
+}
+
+func TestServer_Recommendations_UnknownCompany(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockRecommender.EXPECT().RecommendQuestions("user-id", gomock.Any()).Return(nil, services.ErrUnknownCompany)
+
+	rec := ts.do(http.MethodGet, "/api/recommendations?company=nowhere", "token", nil)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestServer_CreateStudyList(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockStudyLists.EXPECT().CreateStudyList("user-id", "Blind 75", "", true).Return(&models.StudyList{ID: "list-id", Name: "Blind 75", Shared: true}, nil)
+
+	rec := ts.do(http.MethodPost, "/api/lists", "token", map[string]interface{}{"name": "Blind 75", "shared": true})
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, "list-id", decode(t, rec)["id"])
+}
+
+func TestServer_GetStudyList(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockStudyLists.EXPECT().GetStudyListProgress("user-id", "list-id").Return(&models.StudyListProgress{
+		List:   models.StudyList{ID: "list-id", Name: "Blind 75", QuestionIDs: []string{"1"}},
+		Items:  []models.StudyListItem{{Position: 1, Question: models.Question{QuestionID: "1"}, Solved: true}},
+		Solved: 1,
+		Total:  1,
+	}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/lists/list-id", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, "Blind 75", body["name"])
+	assert.Equal(t, float64(1), body["solved"])
+	assert.Len(t, body["items"], 1)
+}
+
+func TestServer_StudyList_Forbidden(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockStudyLists.EXPECT().RemoveQuestionFromList("user-id", "list-id", "1").Return(services.ErrStudyListForbidden)
+
+	rec := ts.do(http.MethodDelete, "/api/lists/list-id/questions/1", "token", nil)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_UpdateProgress(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("202").Return(true, nil)
+	ts.mockUserService.EXPECT().UpdateUserProgress("user-id", models.SolveEvent{QuestionID: "202", TimeTaken: 30 * time.Minute, Language: "go"}).Return(true, nil)
+	ts.mockReviewService.EXPECT().RecordReview("user-id", "202", models.RecallGood).Return(&models.ReviewCard{DueAt: time.Date(2030, 1, 2, 0, 0, 0, 0, time.UTC)}, nil)
+
+	rec := ts.do(http.MethodPost, "/api/progress", "token", map[string]interface{}{"question_id": "202", "time_taken_minutes": 30, "language": "go"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, true, body["updated"])
+	assert.Equal(t, "2030-01-02T00:00:00Z", body["next_review_at"])
+}
+
+func TestServer_SyncProgress(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockUserService.EXPECT().SyncLeetcodeProgress("user-id").Return(&models.SyncReport{
+		Added:     []models.SyncedSolve{{QuestionID: "1", QuestionTitle: "Two Sum", FirstSolve: true}},
+		Unmatched: []models.LeetcodeSubmission{{Title: "Some New Problem"}},
+	}, nil)
+	ts.mockReviewService.EXPECT().RecordReview("user-id", "1", models.RecallGood).Return(&models.ReviewCard{}, nil)
+
+	rec := ts.do(http.MethodPost, "/api/progress/sync", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Len(t, body["added"], 1)
+	assert.Equal(t, []interface{}{"Some New Problem"}, body["unmatched"])
+}
+
+func TestServer_ImportProgress(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockUserService.EXPECT().ImportProgress("user-id", gomock.Any()).Return(&models.ProgressImportReport{
+		Added:     []models.SyncedSolve{{QuestionID: "1", QuestionTitle: "two sum", FirstSolve: true}},
+		Unmatched: []string{"999"},
+		Conflicts: []string{},
+	}, nil)
+	ts.mockReviewService.EXPECT().RecordReview("user-id", "1", models.RecallGood).Return(&models.ReviewCard{}, nil)
+
+	rec := ts.do(http.MethodPost, "/api/progress/import", "token", map[string]interface{}{"version": 1})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Len(t, body["added"], 1)
+	assert.Equal(t, []interface{}{"999"}, body["unmatched"])
+}
+
+func TestServer_ImportProgress_OwnerMismatch(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockUserService.EXPECT().ImportProgress("user-id", gomock.Any()).Return(nil, services.ErrProgressOwnerMismatch)
+
+	rec := ts.do(http.MethodPost, "/api/progress/import", "token", map[string]interface{}{"version": 1})
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestServer_DueReviews(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+	ts.mockReviewService.EXPECT().GetDueReviews("user-id").Return(&[]models.ReviewCard{
+		{QuestionID: "202", Interval: 6, Repetitions: 2, EaseFactor: 2.5},
+	}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/reviews/due", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var body []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body, 1)
+	assert.Equal(t, "202", body[0]["question_id"])
+}
+
+func TestServer_UserStats(t *testing.T) {
+	// Staff keep practising too, so every role sees its own stats
+	for _, role := range roles.All {
+		t.Run(role, func(t *testing.T) {
+			ts := newTestServer(t)
+
+			ts.expectAuth(role, false)
+			ts.mockUserService.EXPECT().GetLeetcodeStats("user-id").Return(&models.LeetcodeStats{EasyDoneCount: 4, TotalEasyCount: 10}, nil)
+
+			rec := ts.do(http.MethodGet, "/api/stats", "token", nil)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			body := decode(t, rec)
+			assert.Equal(t, float64(2), body["codesage_solved"])
+			assert.Equal(t, float64(4), body["easy_solved"])
+		})
+	}
+}
+
+func TestServer_Admin_RequiresAdmin(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/someone/ban", "token", nil)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_Admin_BanUser(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	expiresAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	ts.mockUserService.EXPECT().BanUser("user-id", "someone", "posting spam", expiresAt).Return(false, nil)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/someone/ban", "token", map[string]interface{}{
+		"reason": "posting spam", "expires_at": expiresAt,
+	})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, true, body["banned"])
+	assert.Equal(t, true, body["changed"])
+}
+
+func TestServer_Admin_BanUser_NoReason(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockUserService.EXPECT().BanUser("user-id", "someone", "", time.Time{}).Return(false, services.ErrBanReasonRequired)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/someone/ban", "token", map[string]string{})
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, services.ErrBanReasonRequired.Error(), decode(t, rec)["error"])
+}
+
+func TestServer_Admin_BanAppeals(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockUserService.EXPECT().GetBanAppeals("user-id", "").Return(&[]models.Ban{{
+		ID:       "ban-id",
+		Username: "someone",
+		Reason:   "posting spam",
+		Appeal:   &models.BanAppeal{Message: "I was hacked", Status: models.AppealPending},
+	}}, nil)
+
+	rec := ts.do(http.MethodGet, "/api/admin/appeals", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	var body []map[string]interface{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	require.Len(t, body, 1)
+	assert.Equal(t, "ban-id", body[0]["ban_id"])
+	assert.Equal(t, "I was hacked", body[0]["message"])
+	assert.Equal(t, models.AppealPending, body[0]["status"])
+}
+
+func TestServer_Admin_ResolveBanAppeal(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{"accepted", nil, http.StatusOK},
+		{"unknown ban", services.ErrBanNotFound, http.StatusNotFound},
+		{"already resolved", services.ErrNoPendingAppeal, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t)
+
+			ts.expectAuth(roles.ADMIN, false)
+			ban := &models.Ban{ID: "ban-id", Appeal: &models.BanAppeal{Status: models.AppealAccepted, Response: "welcome back"}}
+			if tt.err != nil {
+				ban = nil
+			}
+			ts.mockUserService.EXPECT().ResolveBanAppeal("user-id", "ban-id", true, "welcome back").Return(ban, tt.err)
+
+			rec := ts.do(http.MethodPost, "/api/admin/appeals/ban-id", "token", map[string]interface{}{
+				"accept": true, "response": "welcome back",
+			})
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestServer_Admin_UnbanUnknownUser(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockUserService.EXPECT().UnbanUser("user-id", "nobody").Return(false, mongo.ErrNoDocuments)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/nobody/unban", "token", nil)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_Admin_PlatformStats(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockUserService.EXPECT().CountActiveUserInLast24Hours("user-id").Return(int64(3), nil)
+	ts.mockQuestionService.EXPECT().GetTotalQuestionsCount().Return(int64(42), nil)
+
+	rec := ts.do(http.MethodGet, "/api/admin/stats", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, float64(42), decode(t, rec)["total_questions"])
+}
+
+func TestServer_Admin_UpdateQuestion(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("15").Return(true, nil)
+	ts.mockQuestionService.EXPECT().UpdateQuestion("user-id", "15", gomock.Any()).DoAndReturn(func(actorID, questionID string, update models.QuestionUpdate) (*models.Question, error) {
+		assert.Equal(t, "hard", *update.Difficulty)
+		assert.Nil(t, update.QuestionTitle)
+		return &models.Question{QuestionID: "15", QuestionTitle: "3sum", Difficulty: "hard"}, nil
+	})
+
+	rec := ts.do(http.MethodPatch, "/api/admin/questions/15", "token", map[string]interface{}{"difficulty": "hard"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "hard", decode(t, rec)["difficulty"])
+}
+
+func TestServer_Admin_UpdateQuestion_AuditFailed(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("15").Return(true, nil)
+	ts.mockQuestionService.EXPECT().UpdateQuestion("user-id", "15", gomock.Any()).Return(nil, fmt.Errorf("%w: disk full", services.ErrAuditFailed))
+
+	rec := ts.do(http.MethodPatch, "/api/admin/questions/15", "token", map[string]interface{}{"difficulty": "hard"})
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
+
+func TestServer_Admin_AuditLog(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	timestamp := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	ts.mockAudit.EXPECT().GetAuditLog("user-id", gomock.Any(), models.PageRequest{Page: 2, PageSize: 10}).DoAndReturn(func(_ string, filter models.AuditFilter, request models.PageRequest) (*models.AuditPage, error) {
+		assert.Equal(t, "user", filter.Action)
+		assert.Equal(t, "someone", filter.Target)
+		assert.True(t, filter.Since.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local)))
+		return &models.AuditPage{
+			Entries: []models.AuditEntry{{ID: "entry-1", ActorID: "user-id", ActorName: "testuser", Action: models.AuditUserBan, Target: "someone",
+				Before: `{"is_banned":false}`, After: `{"is_banned":true}`, Timestamp: timestamp}},
+			PageInfo: models.PageInfo{Page: 2, PageSize: 10, Total: 11, TotalPages: 2},
+		}, nil
+	})
+
+	rec := ts.do(http.MethodGet, "/api/admin/audit?action=user&target=someone&since=2024-05-01&page=2&page_size=10", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, float64(11), body["total"])
+	entries := body["entries"].([]interface{})
+	require.Len(t, entries, 1)
+	entry := entries[0].(map[string]interface{})
+	assert.Equal(t, "testuser", entry["actor"])
+	assert.Equal(t, map[string]interface{}{"is_banned": true}, entry["after"])
+}
+
+func TestServer_Admin_AuditLog_Invalid(t *testing.T) {
+	for _, query := range []string{"since=yesterday", "page=0", "since=2024-05-10&until=2024-05-01"} {
+		t.Run(query, func(t *testing.T) {
+			ts := newTestServer(t)
+			ts.expectAuth(roles.ADMIN, false)
+
+			rec := ts.do(http.MethodGet, "/api/admin/audit?"+query, "token", nil)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+		})
+	}
+}
+
+func TestServer_Admin_AuditLog_RequiresAdmin(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.USER, false)
+
+	rec := ts.do(http.MethodGet, "/api/admin/audit", "token", nil)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_Admin_UpdateQuestion_NotFound(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockQuestionService.EXPECT().QuestionExists("99").Return(false, nil)
+
+	rec := ts.do(http.MethodPatch, "/api/admin/questions/99", "token", map[string]interface{}{"difficulty": "hard"})
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestServer_Admin_PromoteUser(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockUserService.EXPECT().PromoteUser("user-id", "someone").Return(false, nil)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/someone/promote", "token", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	body := decode(t, rec)
+	assert.Equal(t, roles.ADMIN, body["role"])
+	assert.Equal(t, true, body["changed"])
+}
+
+func TestServer_Admin_DemoteLastAdmin(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.ADMIN, false)
+	ts.mockUserService.EXPECT().DemoteUser("user-id", "testuser").Return(false, services.ErrLastAdmin)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/testuser/demote", "token", nil)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, services.ErrLastAdmin.Error(), decode(t, rec)["error"])
+}
+
+func TestServer_Admin_Permissions(t *testing.T) {
+	tests := []struct {
+		name   string
+		role   string
+		method string
+		path   string
+		body   interface{}
+	}{
+		{"content editor bans", roles.CONTENT_EDITOR, http.MethodPost, "/api/admin/users/someone/ban", map[string]string{"reason": "spam"}},
+		{"moderator edits a question", roles.MODERATOR, http.MethodPatch, "/api/admin/questions/15", map[string]string{"difficulty": "hard"}},
+		{"moderator changes a role", roles.MODERATOR, http.MethodPut, "/api/admin/users/someone/role", map[string]string{"role": "admin"}},
+		{"moderator reads the audit log", roles.MODERATOR, http.MethodGet, "/api/admin/audit", nil},
+		{"user reads stats", roles.USER, http.MethodGet, "/api/admin/stats", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t)
+
+			ts.expectAuth(tt.role, false)
+
+			rec := ts.do(tt.method, tt.path, "token", tt.body)
+
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+			assert.Contains(t, decode(t, rec)["error"], "permission")
+		})
+	}
+}
+
+func TestServer_Admin_ModeratorBans(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.MODERATOR, false)
+	ts.mockUserService.EXPECT().BanUser("user-id", "someone", "spam", time.Time{}).Return(false, nil)
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/someone/ban", "token", map[string]string{"reason": "spam"})
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, true, decode(t, rec)["changed"])
+}
+
+func TestServer_Admin_ModeratorBansStaff(t *testing.T) {
+	ts := newTestServer(t)
+
+	ts.expectAuth(roles.MODERATOR, false)
+	ts.mockUserService.EXPECT().BanUser("user-id", "admin", "spam", time.Time{}).
+		Return(false, fmt.Errorf("%w: the moderator role does not have users:roles", services.ErrPermissionDenied))
+
+	rec := ts.do(http.MethodPost, "/api/admin/users/admin/ban", "token", map[string]string{"reason": "spam"})
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestServer_Admin_ChangeUserRole(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     string
+		err      error
+		wantCode int
+	}{
+		{"changed", roles.MODERATOR, nil, http.StatusOK},
+		{"unknown role", "superuser", services.ErrInvalidRole, http.StatusBadRequest},
+		{"last admin", roles.USER, services.ErrLastAdmin, http.StatusConflict},
+		{"unknown user", roles.MODERATOR, mongo.ErrNoDocuments, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ts := newTestServer(t)
+
+			ts.expectAuth(roles.ADMIN, false)
+			ts.mockUserService.EXPECT().ChangeUserRole("user-id", "someone", tt.role).Return(false, tt.err)
+
+			rec := ts.do(http.MethodPut, "/api/admin/users/someone/role", "token", map[string]string{"role": tt.role})
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+			if tt.err == nil {
+				assert.Equal(t, roles.MODERATOR, decode(t, rec)["role"])
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+	"testing"
+	"time"
+)
+
+func TestAccessService_Authorize(t *testing.T) {
+	tests := []struct {
+		role       string
+		permission string
+		allowed    bool
+	}{
+		{roles.USER, roles.STATS_VIEW, false},
+		{roles.CONTENT_EDITOR, roles.QUESTIONS_WRITE, true},
+		{roles.CONTENT_EDITOR, roles.STATS_VIEW, true},
+		{roles.CONTENT_EDITOR, roles.USERS_BAN, false},
+		{roles.CONTENT_EDITOR, roles.STUDY_LISTS_CURATE, true},
+		{roles.MODERATOR, roles.USERS_BAN, true},
+		{roles.MODERATOR, roles.ANNOUNCEMENTS_WRITE, true},
+		{roles.MODERATOR, roles.QUESTIONS_WRITE, false},
+		{roles.MODERATOR, roles.USERS_ROLES, false},
+		{roles.MODERATOR, roles.STUDY_LISTS_CURATE, false},
+		{roles.ADMIN, roles.USERS_ROLES, true},
+		{roles.ADMIN, roles.AUDIT_VIEW, true},
+		{"superuser", roles.STATS_VIEW, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+" "+tt.permission, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+				StandardUser: models.User{ID: "user-id", Role: tt.role},
+			}, nil)
+
+			err := accessService.Authorize("user-id", tt.permission)
+
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, services.ErrPermissionDenied)
+			}
+		})
+	}
+}
+
+func TestAccessService_Authorize_BannedOrMissing(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("banned-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "banned-id", Role: roles.ADMIN, IsBanned: true},
+	}, nil)
+	mockBanRepo.EXPECT().FetchActiveBan("banned-id").Return(&models.Ban{
+		ID: "ban-id", UserID: "banned-id", ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	mockUserRepo.EXPECT().FetchUserByID("missing").Return(nil, mongo.ErrNoDocuments)
+
+	assert.ErrorIs(t, accessService.Authorize("banned-id", roles.STATS_VIEW), services.ErrPermissionDenied)
+	assert.ErrorIs(t, accessService.Authorize("missing", roles.STATS_VIEW), services.ErrPermissionDenied)
+}
+
+func TestAccessService_Authorize_LiftsExpiredBan(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	expiresAt := time.Now().Add(-time.Hour)
+	mockUserRepo.EXPECT().FetchUserByID("moderator-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "moderator-id", Role: roles.MODERATOR, IsBanned: true},
+	}, nil)
+	mockBanRepo.EXPECT().FetchActiveBan("moderator-id").Return(&models.Ban{
+		ID: "ban-id", UserID: "moderator-id", ExpiresAt: expiresAt,
+	}, nil)
+	mockBanRepo.EXPECT().UpdateBan(gomock.Any()).DoAndReturn(func(ban *models.Ban) error {
+		assert.True(t, ban.LiftedAt.Equal(expiresAt))
+		return nil
+	})
+	mockUserRepo.EXPECT().UnbanUser("moderator-id").Return(nil)
+
+	assert.NoError(t, accessService.Authorize("moderator-id", roles.USERS_BAN))
+}
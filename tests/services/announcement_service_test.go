@@ -0,0 +1,202 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"errors"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"testing"
+	"time"
+)
+
+func announcementUser(id, organisation, country string) *models.StandardUser {
+	return &models.StandardUser{StandardUser: models.User{ID: id, Username: id, Role: roles.USER, Organisation: organisation, Country: country}}
+}
+
+func TestAnnouncementService_CreateAnnouncement(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	expiresAt := time.Now().Add(48 * time.Hour)
+	mockAccessService.EXPECT().Authorize("admin-id", roles.ANNOUNCEMENTS_WRITE).Return(nil)
+	mockUserService.EXPECT().GetUserByID("admin-id").Return(announcementUser("admin", "", ""), nil)
+	mockAnnouncementRepo.EXPECT().CreateAnnouncement(gomock.Any()).Return(nil)
+
+	announcement, err := announcementService.CreateAnnouncement("admin-id", models.AnnouncementDraft{
+		Title:     "  Contest   on Sunday ",
+		Body:      " Join us at 10am \n",
+		Audience:  "organisation",
+		Target:    " WatchGuard ",
+		Pinned:    true,
+		ExpiresAt: expiresAt,
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, announcement.ID)
+	assert.Equal(t, "Contest on Sunday", announcement.Title)
+	assert.Equal(t, "Join us at 10am", announcement.Body)
+	assert.Equal(t, models.AudienceOrganisation, announcement.Audience)
+	assert.Equal(t, "watchguard", announcement.Target)
+	assert.Equal(t, "admin", announcement.AuthorName)
+	assert.True(t, announcement.Pinned)
+	assert.True(t, announcement.ExpiresAt.Equal(expiresAt))
+}
+
+func TestAnnouncementService_CreateAnnouncement_Invalid(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	tests := []struct {
+		name  string
+		draft models.AnnouncementDraft
+		err   error
+	}{
+		{"Missing message", models.AnnouncementDraft{Title: "Hello"}, services.ErrEmptyAnnouncement},
+		{"Missing target", models.AnnouncementDraft{Title: "Hello", Body: "World", Audience: "country"}, services.ErrMissingAudienceTarget},
+		{"Unknown country", models.AnnouncementDraft{Title: "Hello", Body: "World", Audience: "country", Target: "atlantis"}, services.ErrInvalidAudience},
+		{"Unknown audience", models.AnnouncementDraft{Title: "Hello", Body: "World", Audience: "team"}, services.ErrInvalidAudience},
+		{"Expiry in the past", models.AnnouncementDraft{Title: "Hello", Body: "World", ExpiresAt: time.Now().Add(-time.Hour)}, services.ErrExpiryInPast},
+	}
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.ANNOUNCEMENTS_WRITE).Return(nil).Times(len(tests))
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := announcementService.CreateAnnouncement("admin-id", tt.draft)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestAnnouncementService_GetUnreadAnnouncements(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(announcementUser("user-id", "watchguard", "india"), nil)
+	mockAnnouncementRepo.EXPECT().FetchAnnouncements().Return(&[]models.Announcement{
+		{ID: "everyone", Audience: models.AudienceEveryone},
+		{ID: "read", Audience: models.AudienceEveryone, Reads: []models.ReadReceipt{{UserID: "user-id"}}},
+		{ID: "expired", Audience: models.AudienceEveryone, ExpiresAt: time.Now().Add(-time.Minute)},
+		{ID: "not-expired", Audience: models.AudienceEveryone, ExpiresAt: time.Now().Add(time.Hour)},
+		{ID: "organisation", Audience: models.AudienceOrganisation, Target: "watchguard"},
+		{ID: "other-organisation", Audience: models.AudienceOrganisation, Target: "acme"},
+		{ID: "country", Audience: models.AudienceCountry, Target: "india"},
+		{ID: "other-country", Audience: models.AudienceCountry, Target: "france"},
+	}, nil)
+
+	unread, err := announcementService.GetUnreadAnnouncements("user-id")
+
+	require.NoError(t, err)
+	var ids []string
+	for _, announcement := range *unread {
+		ids = append(ids, announcement.ID)
+	}
+	assert.Equal(t, []string{"everyone", "not-expired", "organisation", "country"}, ids)
+}
+
+func TestAnnouncementService_UpdateAnnouncement_KeepsReads(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	created := time.Now().Add(-time.Hour).UTC()
+	mockAccessService.EXPECT().Authorize("admin-id", roles.ANNOUNCEMENTS_WRITE).Return(nil)
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("id").Return(&models.Announcement{
+		ID: "id", Title: "Old", Body: "Old", Audience: models.AudienceEveryone, CreatedAt: created, UpdatedAt: created,
+		Reads: []models.ReadReceipt{{UserID: "user-id"}},
+	}, nil)
+	mockAnnouncementRepo.EXPECT().UpdateAnnouncement(gomock.Any()).DoAndReturn(func(announcement *models.Announcement) error {
+		assert.Equal(t, "New", announcement.Title)
+		assert.Equal(t, models.AudienceCountry, announcement.Audience)
+		assert.Equal(t, "india", announcement.Target)
+		assert.Len(t, announcement.Reads, 1)
+		assert.True(t, announcement.UpdatedAt.After(created))
+		return nil
+	})
+
+	_, err := announcementService.UpdateAnnouncement("admin-id", "id", models.AnnouncementDraft{Title: "New", Body: "Body", Audience: "country", Target: "India"})
+	assert.NoError(t, err)
+}
+
+func TestAnnouncementService_ExpireAnnouncement(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.ANNOUNCEMENTS_WRITE).Return(nil).Times(3)
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("id").Return(&models.Announcement{ID: "id"}, nil)
+	mockAnnouncementRepo.EXPECT().UpdateAnnouncement(gomock.Any()).DoAndReturn(func(announcement *models.Announcement) error {
+		assert.False(t, announcement.ExpiresAt.IsZero())
+		assert.False(t, announcement.ExpiresAt.After(time.Now()))
+		return nil
+	})
+	assert.NoError(t, announcementService.ExpireAnnouncement("admin-id", "id"))
+
+	// Expiring it again keeps the first expiry
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("expired").Return(&models.Announcement{ID: "expired", ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	assert.NoError(t, announcementService.ExpireAnnouncement("admin-id", "expired"))
+
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("missing").Return(nil, mongo.ErrNoDocuments)
+	assert.Equal(t, services.ErrAnnouncementNotFound, announcementService.ExpireAnnouncement("admin-id", "missing"))
+}
+
+func TestAnnouncementService_PermissionDenied(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	denied := fmt.Errorf("%w: the content_editor role does not have announcements:write", services.ErrPermissionDenied)
+	mockAccessService.EXPECT().Authorize("editor-id", roles.ANNOUNCEMENTS_WRITE).Return(denied).Times(4)
+
+	_, err := announcementService.CreateAnnouncement("editor-id", models.AnnouncementDraft{Title: "Hello", Body: "World"})
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, err = announcementService.UpdateAnnouncement("editor-id", "id", models.AnnouncementDraft{Title: "Hello", Body: "World"})
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+	assert.ErrorIs(t, announcementService.ExpireAnnouncement("editor-id", "id"), services.ErrPermissionDenied)
+	assert.ErrorIs(t, announcementService.SetAnnouncementPinned("editor-id", "id", true), services.ErrPermissionDenied)
+}
+
+func TestAnnouncementService_MarkAnnouncementRead(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(announcementUser("user-id", "", ""), nil).Times(2)
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("id").Return(&models.Announcement{ID: "id", Audience: models.AudienceEveryone}, nil)
+	mockAnnouncementRepo.EXPECT().AddReadReceipt("id", gomock.Any()).DoAndReturn(func(_ string, receipt models.ReadReceipt) error {
+		assert.Equal(t, "user-id", receipt.UserID)
+		assert.Equal(t, "user-id", receipt.Username)
+		assert.False(t, receipt.ReadAt.IsZero())
+		return nil
+	})
+	assert.NoError(t, announcementService.MarkAnnouncementRead("user-id", "id"))
+
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("missing").Return(nil, mongo.ErrNoDocuments)
+	assert.Equal(t, services.ErrAnnouncementNotFound, announcementService.MarkAnnouncementRead("user-id", "missing"))
+}
+
+func TestAnnouncementService_MarkAnnouncementRead_NotVisible(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	staffOnly := &models.Announcement{ID: "staff", Audience: models.AudienceOrganisation, Target: "codesage staff"}
+	expired := &models.Announcement{ID: "old", Audience: models.AudienceEveryone, ExpiresAt: time.Now().Add(-time.Hour)}
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(announcementUser("user-id", "WatchGuard", "India"), nil).Times(2)
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("staff").Return(staffOnly, nil)
+	mockAnnouncementRepo.EXPECT().FetchAnnouncementByID("old").Return(expired, nil)
+	mockAnnouncementRepo.EXPECT().AddReadReceipt(gomock.Any(), gomock.Any()).Times(0)
+
+	assert.Equal(t, services.ErrAnnouncementNotFound, announcementService.MarkAnnouncementRead("user-id", "staff"))
+	assert.Equal(t, services.ErrAnnouncementNotFound, announcementService.MarkAnnouncementRead("user-id", "old"))
+}
+
+func TestAnnouncementService_GetUserAnnouncements_UserError(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(nil, errors.New("db down"))
+	_, err := announcementService.GetUserAnnouncements("user-id")
+	assert.Error(t, err)
+}
@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"bytes"
+	"cli-project/internal/app/services"
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"testing"
+	"time"
+)
+
+func TestAuditService_Record(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	before := models.Question{QuestionID: "1", Difficulty: "easy"}
+	after := models.Question{QuestionID: "1", Difficulty: "hard"}
+
+	mockUserRepo.EXPECT().FetchUserByID("admin-id").Return(&models.StandardUser{StandardUser: models.User{ID: "admin-id", Username: "admin"}}, nil)
+	mockAuditRepo.EXPECT().AppendAuditEntry(gomock.Any()).DoAndReturn(func(entry *models.AuditEntry) error {
+		assert.NotEmpty(t, entry.ID)
+		assert.Equal(t, "admin-id", entry.ActorID)
+		assert.Equal(t, "admin", entry.ActorName)
+		assert.Equal(t, models.AuditQuestionUpdate, entry.Action)
+		assert.Equal(t, "1", entry.Target)
+		assert.JSONEq(t, `"easy"`, jsonField(t, entry.Before, "difficulty"))
+		assert.JSONEq(t, `"hard"`, jsonField(t, entry.After, "difficulty"))
+		assert.WithinDuration(t, time.Now(), entry.Timestamp, time.Minute)
+		return nil
+	})
+
+	err := auditService.Record("admin-id", models.AuditQuestionUpdate, "1", before, after)
+
+	assert.NoError(t, err)
+}
+
+func TestAuditService_Record_NothingBefore(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// An actor who is no longer found is still recorded by ID
+	mockUserRepo.EXPECT().FetchUserByID("gone-id").Return(nil, mongo.ErrNoDocuments)
+	mockAuditRepo.EXPECT().AppendAuditEntry(gomock.Any()).DoAndReturn(func(entry *models.AuditEntry) error {
+		assert.Equal(t, "", entry.ActorName)
+		assert.Equal(t, "", entry.Before)
+		var added []models.Question
+		require.NoError(t, json.Unmarshal([]byte(entry.After), &added))
+		assert.Equal(t, []models.Question{{QuestionID: "15"}}, added)
+		return nil
+	})
+
+	err := auditService.Record("gone-id", models.AuditQuestionAdd, "questions.csv", nil, []models.Question{{QuestionID: "15"}})
+
+	assert.NoError(t, err)
+}
+
+func TestAuditService_GetAuditLog(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	filter := models.AuditFilter{Actor: " admin ", Action: " User "}
+	mockAccessService.EXPECT().Authorize("admin-id", roles.AUDIT_VIEW).Return(nil)
+	mockAuditRepo.EXPECT().FetchAuditEntries(models.AuditFilter{Actor: "admin", Action: "user"}, models.PageRequest{Page: 1, PageSize: config.PAGE_SIZE}).
+		Return(&models.AuditPage{Entries: []models.AuditEntry{{ID: "entry-1"}}}, nil)
+
+	page, err := auditService.GetAuditLog("admin-id", filter, models.PageRequest{})
+
+	require.NoError(t, err)
+	assert.Len(t, page.Entries, 1)
+}
+
+func TestAuditService_GetAuditLog_Invalid(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	now := time.Now()
+	mockAccessService.EXPECT().Authorize("admin-id", roles.AUDIT_VIEW).Return(nil).Times(2)
+	_, err := auditService.GetAuditLog("admin-id", models.AuditFilter{Since: now, Until: now.Add(-time.Hour)}, models.PageRequest{})
+	assert.ErrorIs(t, err, services.ErrInvalidAuditPeriod)
+
+	_, err = auditService.GetAuditLog("admin-id", models.AuditFilter{}, models.PageRequest{Page: -1})
+	assert.ErrorIs(t, err, services.ErrInvalidPage)
+}
+
+func TestAuditService_ExportAuditLog(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	timestamp := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	entries := []models.AuditEntry{
+		{ID: "entry-1", ActorID: "admin-id", ActorName: "admin", Action: models.AuditUserBan, Target: "someone", Before: `{"is_banned":false}`, After: `{"is_banned":true}`, Timestamp: timestamp},
+		{ID: "entry-2", ActorID: "admin-id", ActorName: "admin", Action: models.AuditQuestionRemove, Target: "1", Before: `{"question_id":"1"}`, Timestamp: timestamp.Add(time.Hour)},
+	}
+	mockAccessService.EXPECT().Authorize("admin-id", roles.AUDIT_VIEW).Return(nil).Times(2)
+	mockAuditRepo.EXPECT().FetchAllAuditEntries(models.AuditFilter{}).Return(&entries, nil).Times(2)
+
+	var out bytes.Buffer
+	count, err := auditService.ExportAuditLog("admin-id", &out, "csv", models.AuditFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	records, err := csv.NewReader(&out).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, []string{"2024-05-01T10:00:00Z", "admin-id", "admin", "user.ban", "someone", `{"is_banned":false}`, `{"is_banned":true}`}, records[1])
+
+	out.Reset()
+	count, err = auditService.ExportAuditLog("admin-id", &out, "JSON", models.AuditFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	var exported []map[string]interface{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &exported))
+	require.Len(t, exported, 2)
+	assert.Equal(t, map[string]interface{}{"is_banned": true}, exported[0]["after"])
+	assert.Nil(t, exported[1]["after"])
+}
+
+func TestAuditService_ExportAuditLog_Markdown(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.AUDIT_VIEW).Return(nil)
+	_, err := auditService.ExportAuditLog("admin-id", &bytes.Buffer{}, "markdown", models.AuditFilter{})
+
+	assert.ErrorIs(t, err, services.ErrUnsupportedAuditFormat)
+}
+
+func TestAuditService_PermissionDenied(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	denied := fmt.Errorf("%w: the moderator role does not have audit:view", services.ErrPermissionDenied)
+	mockAccessService.EXPECT().Authorize("moderator-id", roles.AUDIT_VIEW).Return(denied).Times(2)
+
+	_, err := auditService.GetAuditLog("moderator-id", models.AuditFilter{}, models.PageRequest{})
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, err = auditService.ExportAuditLog("moderator-id", &bytes.Buffer{}, "csv", models.AuditFilter{})
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+}
+
+// jsonField returns one field of a JSON object as JSON
+func jsonField(t *testing.T, object, field string) string {
+	var fields map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal([]byte(object), &fields))
+	return string(fields[field])
+}
@@ -0,0 +1,122 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLeaderboardService_GetLeaderboard_Global(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockEntries := []models.LeaderboardEntry{
+		{UserID: "u1", Username: "alice", EasySolved: 6},
+		{UserID: "u2", Username: "bob", HardSolved: 2},
+		{UserID: "u3", Username: "carol", MediumSolved: 1, HardSolved: 1},
+		{UserID: "u4", Username: "dave", EasySolved: 3, MediumSolved: 1},
+	}
+
+	mockLeaderboardRepo.EXPECT().FetchSolveCounts("", "").Return(&mockEntries, nil).Times(1)
+
+	board, err := leaderboardService.GetLeaderboard("Global", "ignored")
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.GlobalScope, board.Scope)
+	assert.Equal(t, "", board.ScopeValue)
+
+	// bob (10), carol (8), then alice and dave share third place with 6 points
+	var usernames []string
+	var ranks []int
+	for _, entry := range board.Entries {
+		usernames = append(usernames, entry.Username)
+		ranks = append(ranks, entry.Rank)
+	}
+	assert.Equal(t, []string{"bob", "carol", "alice", "dave"}, usernames)
+	assert.Equal(t, []int{1, 2, 3, 3}, ranks)
+	assert.Equal(t, 10, board.Entries[0].Score)
+}
+
+func TestLeaderboardService_GetLeaderboard_Organisation(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockEntries := []models.LeaderboardEntry{}
+
+	mockLeaderboardRepo.EXPECT().FetchSolveCounts("Watch Guard", "").Return(&mockEntries, nil).Times(1)
+
+	board, err := leaderboardService.GetLeaderboard("organisation", " watch guard ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Watch Guard", board.ScopeValue)
+	assert.Empty(t, board.Entries)
+}
+
+func TestLeaderboardService_GetLeaderboard_Country(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockEntries := []models.LeaderboardEntry{{UserID: "u1", Username: "alice", Country: "India"}}
+
+	mockLeaderboardRepo.EXPECT().FetchSolveCounts("", "India").Return(&mockEntries, nil).Times(1)
+
+	board, err := leaderboardService.GetLeaderboard("country", "india")
+
+	assert.NoError(t, err)
+	assert.Len(t, board.Entries, 1)
+	assert.Equal(t, 1, board.Entries[0].Rank)
+}
+
+func TestLeaderboardService_GetLeaderboard_InvalidScope(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	board, err := leaderboardService.GetLeaderboard("galaxy", "")
+
+	assert.Nil(t, board)
+	assert.Equal(t, services.ErrInvalidScope, err)
+}
+
+func TestLeaderboardService_GetLeaderboard_MissingScopeValue(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	board, err := leaderboardService.GetLeaderboard("country", "  ")
+
+	assert.Nil(t, board)
+	assert.Equal(t, services.ErrMissingScopeValue, err)
+}
+
+func TestLeaderboardService_GetLeaderboard_RepoError(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockLeaderboardRepo.EXPECT().FetchSolveCounts("", "").Return(nil, errors.New("db down")).Times(1)
+
+	board, err := leaderboardService.GetLeaderboard("global", "")
+
+	assert.Nil(t, board)
+	assert.Error(t, err)
+}
+
+func TestLeaderboardService_GetUserStanding(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	board := &models.Leaderboard{
+		Entries: []models.LeaderboardEntry{
+			{UserID: "u1", Rank: 1},
+			{UserID: "u2", Rank: 2},
+		},
+	}
+
+	standing, err := leaderboardService.GetUserStanding(board, "u2")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, standing.Rank)
+
+	standing, err = leaderboardService.GetUserStanding(board, "u3")
+	assert.Nil(t, standing)
+	assert.Equal(t, services.ErrUserNotOnBoard, err)
+}
@@ -0,0 +1,119 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNoteService_SaveNote(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	body := "## Approach\nSliding window.\n\n```Go\nfor right := range s {\n}\n```\n\n~~~\nplain text\n~~~\n"
+
+	mockQuestionService.EXPECT().QuestionExists("3").Return(true, nil)
+	mockNoteRepo.EXPECT().FetchNote("user-id", "3").Return(nil, mongo.ErrNoDocuments)
+	mockNoteRepo.EXPECT().UpsertNote(gomock.Any()).DoAndReturn(func(note *models.Note) error {
+		assert.Equal(t, "user-id", note.UserID)
+		assert.Equal(t, "3", note.QuestionID)
+		assert.Equal(t, strings.TrimSpace(body), note.Body)
+		assert.False(t, note.CreatedAt.IsZero())
+		assert.Equal(t, note.CreatedAt, note.UpdatedAt)
+		return nil
+	})
+
+	note, err := noteService.SaveNote("user-id", " 3 ", "  "+body)
+	require.NoError(t, err)
+	assert.Equal(t, []models.CodeSnippet{
+		{Language: "go", Code: "for right := range s {\n}"},
+		{Language: "", Code: "plain text"},
+	}, note.Snippets)
+}
+
+func TestNoteService_SaveNote_KeepsCreatedAt(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	created := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	mockQuestionService.EXPECT().QuestionExists("3").Return(true, nil)
+	mockNoteRepo.EXPECT().FetchNote("user-id", "3").Return(&models.Note{UserID: "user-id", QuestionID: "3", Body: "old", CreatedAt: created, UpdatedAt: created}, nil)
+	mockNoteRepo.EXPECT().UpsertNote(gomock.Any()).Return(nil)
+
+	note, err := noteService.SaveNote("user-id", "3", "new\n````python\nprint('```')\n````")
+	require.NoError(t, err)
+	assert.Equal(t, created, note.CreatedAt)
+	assert.True(t, note.UpdatedAt.After(created))
+	assert.Equal(t, []models.CodeSnippet{{Language: "python", Code: "print('```')"}}, note.Snippets)
+}
+
+func TestNoteService_SaveNote_Invalid(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := noteService.SaveNote("user-id", "3", " \n ")
+	assert.Equal(t, services.ErrEmptyNote, err)
+
+	_, err = noteService.SaveNote("user-id", "3", strings.Repeat("a", 20001))
+	assert.Equal(t, services.ErrNoteTooLong, err)
+
+	mockQuestionService.EXPECT().QuestionExists("999").Return(false, nil)
+	_, err = noteService.SaveNote("user-id", "999", "text")
+	assert.EqualError(t, err, "question with ID 999 does not exist")
+}
+
+func TestNoteService_GetNote_NotFound(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockNoteRepo.EXPECT().FetchNote("user-id", "3").Return(nil, mongo.ErrNoDocuments)
+	_, err := noteService.GetNote("user-id", "3")
+	assert.Equal(t, services.ErrNoteNotFound, err)
+}
+
+func TestNoteService_DeleteNote(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockNoteRepo.EXPECT().DeleteNote("user-id", "3").Return(nil)
+	assert.NoError(t, noteService.DeleteNote("user-id", "3"))
+
+	mockNoteRepo.EXPECT().DeleteNote("user-id", "4").Return(mongo.ErrNoDocuments)
+	assert.Equal(t, services.ErrNoteNotFound, noteService.DeleteNote("user-id", "4"))
+}
+
+func TestNoteService_SearchNotes(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockNoteRepo.EXPECT().FetchNotes("user-id").Return(&[]models.Note{
+		{QuestionID: "1", Body: "# Two Sum\nUse a hash-map of complements.\nO(n) time."},
+		{QuestionID: "2", Body: "Hash the prefix sums"},
+		{QuestionID: "3", Body: "Binary search on the answer"},
+	}, nil)
+
+	matches, err := noteService.SearchNotes("user-id", "HASH map")
+	require.NoError(t, err)
+	require.Len(t, *matches, 1)
+	assert.Equal(t, "1", (*matches)[0].Note.QuestionID)
+	assert.Equal(t, "Use a hash-map of complements.", (*matches)[0].Excerpt)
+}
+
+func TestNoteService_SearchNotes_Errors(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := noteService.SearchNotes("user-id", "  ?! ")
+	assert.Equal(t, services.ErrEmptySearchQuery, err)
+
+	mockNoteRepo.EXPECT().FetchNotes("user-id").Return(nil, errors.New("db down"))
+	_, err = noteService.SearchNotes("user-id", "hash")
+	assert.Error(t, err)
+}
@@ -2,9 +2,18 @@ package service_test
 
 import (
 	"cli-project/internal/app/services"
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
 	"cli-project/internal/domain/models"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -12,22 +21,53 @@ func TestQuestionService_RemoveQuestionByID(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
 
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
+
 	// Mock expectations
-	mockQuestionRepo.EXPECT().QuestionExists("q1").Return(true, nil)
-	mockQuestionRepo.EXPECT().RemoveQuestionByID("q1").Return(nil)
+	question := &models.Question{QuestionID: "1", QuestionTitle: "Title1"}
+	mockQuestionRepo.EXPECT().QuestionExists("1").Return(true, nil)
+	mockQuestionRepo.EXPECT().FetchQuestionByID("1").Return(question, nil)
+	mockQuestionRepo.EXPECT().RemoveQuestionByID("1").Return(nil)
+	mockAuditService.EXPECT().Record("admin-id", models.AuditQuestionRemove, "1", question, nil).Return(nil)
 
 	// Execute
-	err := questionService.RemoveQuestionByID("q1")
+	err := questionService.RemoveQuestionByID("admin-id", "1")
 
 	// Assert
 	assert.Nil(t, err)
 }
 
+func TestQuestionService_AuditFailed(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// The audit entry is written first, so when the audit log cannot be written the bank is left alone
+	auditService := services.NewAuditService(mockAuditRepo, mockUserRepo, mockAccessService)
+	questionService := services.NewQuestionService(mockQuestionRepo, auditService, mockAccessService)
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil).Times(2)
+	mockUserRepo.EXPECT().FetchUserByID("admin-id").Return(&models.StandardUser{StandardUser: models.User{ID: "admin-id", Username: "admin"}}, nil).Times(2)
+	mockAuditRepo.EXPECT().AppendAuditEntry(gomock.Any()).Return(errors.New("disk full")).Times(2)
+	mockQuestionRepo.EXPECT().QuestionExists("1").Return(true, nil).Times(2)
+	mockQuestionRepo.EXPECT().FetchQuestionByID("1").DoAndReturn(func(string) (*models.Question, error) {
+		return &models.Question{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy"}, nil
+	}).Times(2)
+
+	// No RemoveQuestionByID or UpdateQuestion calls are expected
+	err := questionService.RemoveQuestionByID("admin-id", "1")
+	assert.ErrorIs(t, err, services.ErrAuditFailed)
+
+	difficulty := "hard"
+	question, err := questionService.UpdateQuestion("admin-id", "1", models.QuestionUpdate{Difficulty: &difficulty})
+	assert.ErrorIs(t, err, services.ErrAuditFailed)
+	assert.Nil(t, question)
+}
+
 func TestQuestionService_GetQuestionByID(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
 
-	questionID := "q1"
+	questionID := "1"
 	mockQuestion := &models.Question{
 		QuestionID:    "1",
 		QuestionTitle: "Title1",
@@ -48,6 +88,123 @@ func TestQuestionService_GetQuestionByID(t *testing.T) {
 	assert.Equal(t, mockQuestion, question)
 }
 
+func TestQuestionService_UpdateQuestion(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
+
+	stored := &models.Question{
+		QuestionID:    "1",
+		QuestionTitle: "two sum",
+		Difficulty:    "easy",
+		QuestionLink:  "https://leetcode.com/problems/two-sum",
+		TopicTags:     []string{"array"},
+		CompanyTags:   []string{"google"},
+	}
+	title, difficulty := "  Two Sum II ", "Medium"
+	topics := []string{" Array", "hash-table", "array", ""}
+
+	mockQuestionRepo.EXPECT().QuestionExists("1").Return(true, nil)
+	mockQuestionRepo.EXPECT().FetchQuestionByID("1").Return(stored, nil)
+	mockQuestionRepo.EXPECT().UpdateQuestion(gomock.Any()).DoAndReturn(func(question *models.Question) error {
+		assert.Equal(t, "two sum ii", question.QuestionTitle)
+		assert.Equal(t, "medium", question.Difficulty)
+		assert.Equal(t, []string{"array", "hash-table"}, question.TopicTags)
+		assert.Equal(t, []string{"google"}, question.CompanyTags)
+		return nil
+	})
+	before := *stored
+	mockAuditService.EXPECT().Record("admin-id", models.AuditQuestionUpdate, "1", before, gomock.Any()).DoAndReturn(func(actorID, action, target string, before, after interface{}) error {
+		assert.Equal(t, "two sum ii", after.(models.Question).QuestionTitle)
+		return nil
+	})
+
+	question, err := questionService.UpdateQuestion("admin-id", "1", models.QuestionUpdate{
+		QuestionTitle: &title,
+		Difficulty:    &difficulty,
+		TopicTags:     &topics,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "two sum ii", question.QuestionTitle)
+	assert.Equal(t, "https://leetcode.com/problems/two-sum", question.QuestionLink)
+}
+
+func TestQuestionService_UpdateQuestion_InvalidFields(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	stored := models.Question{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy"}
+	empty, difficulty, link := " ", "extreme", "https://example.com/problems/two-sum"
+
+	tests := []struct {
+		name   string
+		update models.QuestionUpdate
+	}{
+		{"empty title", models.QuestionUpdate{QuestionTitle: &empty}},
+		{"invalid difficulty", models.QuestionUpdate{Difficulty: &difficulty}},
+		{"invalid link", models.QuestionUpdate{QuestionLink: &link}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			question := stored
+			mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
+			mockQuestionRepo.EXPECT().QuestionExists("1").Return(true, nil)
+			mockQuestionRepo.EXPECT().FetchQuestionByID("1").Return(&question, nil)
+
+			_, err := questionService.UpdateQuestion("admin-id", "1", tt.update)
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestQuestionService_UpdateQuestion_NoChanges(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
+
+	_, err := questionService.UpdateQuestion("admin-id", "1", models.QuestionUpdate{})
+
+	assert.ErrorIs(t, err, services.ErrNoQuestionChanges)
+}
+
+func TestQuestionService_UpdateQuestion_NotFound(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
+
+	difficulty := "hard"
+	mockQuestionRepo.EXPECT().QuestionExists("99").Return(false, nil)
+
+	_, err := questionService.UpdateQuestion("admin-id", "99", models.QuestionUpdate{Difficulty: &difficulty})
+
+	assert.Error(t, err)
+}
+
+func TestQuestionService_PermissionDenied(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	denied := fmt.Errorf("%w: the moderator role does not have questions:write", services.ErrPermissionDenied)
+	mockAccessService.EXPECT().Authorize("moderator-id", roles.QUESTIONS_WRITE).Return(denied).Times(5)
+
+	_, err := questionService.AddQuestionsFromFile("moderator-id", "questions.csv")
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, err = questionService.ImportQuestionsFromFile("moderator-id", "questions.csv", true)
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+	assert.ErrorIs(t, questionService.RemoveQuestionByID("moderator-id", "1"), services.ErrPermissionDenied)
+	difficulty := "hard"
+	_, err = questionService.UpdateQuestion("moderator-id", "1", models.QuestionUpdate{Difficulty: &difficulty})
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+	_, err = questionService.ExportQuestions("moderator-id", &strings.Builder{}, "csv", "", "", "")
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+}
+
 func TestQuestionService_GetAllQuestions(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
@@ -89,15 +246,95 @@ func TestQuestionService_GetQuestionsByFilters(t *testing.T) {
 	assert.Equal(t, &mockQuestions, questions)
 }
 
+func TestQuestionService_GetQuestionsByFilters_AnyDifficulty(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionRepo.EXPECT().FetchQuestionsByFilters("", "google", "").Return(&[]models.Question{}, nil).Times(2)
+
+	_, err := questionService.GetQuestionsByFilters("", "google", "")
+	assert.NoError(t, err)
+	_, err = questionService.GetQuestionsByFilters("Any", "google", "")
+	assert.NoError(t, err)
+
+	_, err = questionService.GetQuestionsByFilters("extreme", "google", "")
+	assert.Error(t, err)
+}
+
+func TestQuestionService_FilterQuestions(t *testing.T) {
+	questions := []models.Question{
+		{QuestionID: "200", Difficulty: "medium", TopicTags: []string{"graph", "bfs"}, CompanyTags: []string{"amazon"}},
+		{QuestionID: "207", Difficulty: "medium", TopicTags: []string{"graph", "topological-sort"}, CompanyTags: []string{"meta", "amazon"}},
+		{QuestionID: "127", Difficulty: "hard", TopicTags: []string{"graph", "bfs"}, CompanyTags: []string{"meta"}},
+		{QuestionID: "1", Difficulty: "easy", TopicTags: []string{"array"}, CompanyTags: []string{"amazon"}},
+		{QuestionID: "42", Difficulty: "hard", TopicTags: []string{"array", "two-pointers"}, CompanyTags: []string{"google"}},
+	}
+
+	tests := []struct {
+		name     string
+		filter   models.QuestionFilter
+		expected []string
+	}{
+		{"empty filter", models.QuestionFilter{}, []string{"1", "42", "127", "200", "207"}},
+		{"several difficulties", models.QuestionFilter{Difficulties: []string{"Medium", "hard"}}, []string{"42", "127", "200", "207"}},
+		{"any company", models.QuestionFilter{Companies: []string{"meta", "google"}}, []string{"42", "127", "207"}},
+		{"all companies", models.QuestionFilter{Companies: []string{"meta", "amazon"}, MatchAllCompanies: true}, []string{"207"}},
+		{"all topics", models.QuestionFilter{Topics: []string{"graph", "bfs"}, MatchAllTopics: true}, []string{"127", "200"}},
+		{"excluded tags", models.QuestionFilter{Topics: []string{"graph"}, ExcludeTopics: []string{"bfs"}, ExcludeCompanies: []string{"google"}}, []string{"207"}},
+		{"any value", models.QuestionFilter{Difficulties: []string{"any"}, Topics: []string{"any"}}, []string{"1", "42", "127", "200", "207"}},
+		{"solved", models.QuestionFilter{Status: "solved", SolvedQuestionIDs: []string{"200", "1"}}, []string{"1", "200"}},
+		{
+			"unsolved medium or hard graph questions asked by amazon or meta",
+			models.QuestionFilter{
+				Difficulties:      []string{"medium", "hard"},
+				Topics:            []string{"graph"},
+				Companies:         []string{"amazon", "meta"},
+				Status:            "unsolved",
+				SolvedQuestionIDs: []string{"200"},
+			},
+			[]string{"127", "207"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&questions, nil)
+
+			filtered, err := questionService.FilterQuestions(tt.filter)
+
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, question := range *filtered {
+				ids = append(ids, question.QuestionID)
+			}
+			assert.Equal(t, tt.expected, ids)
+		})
+	}
+}
+
+func TestQuestionService_FilterQuestions_Invalid(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := questionService.FilterQuestions(models.QuestionFilter{Difficulties: []string{"easy", "extreme"}})
+	assert.Error(t, err)
+
+	_, err = questionService.FilterQuestions(models.QuestionFilter{Status: "attempted"})
+	assert.Error(t, err)
+}
+
 func TestQuestionService_QuestionExists(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
 
 	// Mock expectations
-	mockQuestionRepo.EXPECT().QuestionExists("q1").Return(true, nil)
+	mockQuestionRepo.EXPECT().QuestionExists("1").Return(true, nil)
 
 	// Execute
-	exists, err := questionService.QuestionExists("q1")
+	exists, err := questionService.QuestionExists("1")
 
 	// Assert
 	assert.Nil(t, err)
@@ -120,42 +357,303 @@ func TestQuestionService_GetTotalQuestionsCount(t *testing.T) {
 	assert.Equal(t, count, totalCount)
 }
 
+// writeQuestionCSV writes the rows to a CSV file in a temporary directory and returns its path
+func writeQuestionCSV(t *testing.T, rows ...string) string {
+	path := filepath.Join(t.TempDir(), "questions.csv")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(rows, "\n")+"\n"), 0o600))
+	return path
+}
+
 func TestQuestionService_AddQuestionsFromFile(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
 
-	// Define the CSV data
-	//csvData := [][]string{
-	//	{"QuestionID", "QuestionTitle", "Difficulty", "QuestionLink", "TopicTags", "CompanyTags"},
-	//	{"q1", "Title1", "easy", "http://example.com/1", "topic1", "company1"},
-	//	{"q2", "Title2", "medium", "http://example.com/2", "topic2", "company2"},
-	//}
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil).Times(2)
+
+	path := writeQuestionCSV(t,
+		"ID,Title,Difficulty,Leetcode Question Link,Topic Tags,Company Tags",
+		`1,Two Sum,Easy,https://leetcode.com/problems/two-sum,"array,hash-table",google`,
+		`15,3Sum,Medium,https://leetcode.com/problems/3sum,array,meta`,
+	)
+
+	// Existing questions are left alone and only new ones are added
+	existing := []models.Question{{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy"}}
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&existing, nil)
+	mockQuestionRepo.EXPECT().AddQuestions(gomock.Any()).DoAndReturn(func(questions *[]models.Question) error {
+		require.Len(t, *questions, 1)
+		assert.Equal(t, "15", (*questions)[0].QuestionID)
+		return nil
+	})
+	mockAuditService.EXPECT().Record("admin-id", models.AuditQuestionAdd, filepath.Base(path), nil, gomock.Len(1)).Return(nil)
+
+	newQuestionsAdded, err := questionService.AddQuestionsFromFile("admin-id", path)
+	assert.NoError(t, err)
+	assert.True(t, newQuestionsAdded)
 
-	// Mock the CSV reader function
-	//mockReadCSV := func(filePath string) ([][]string, error) {
-	//	return csvData, nil
-	//}
+	// Nothing is written when every question already exists
+	existing = append(existing, models.Question{QuestionID: "15"})
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&existing, nil)
 
-	// Create the service with the mock reader function
-	questionService = services.NewQuestionService(mockQuestionRepo)
+	newQuestionsAdded, err = questionService.AddQuestionsFromFile("admin-id", path)
+	assert.NoError(t, err)
+	assert.False(t, newQuestionsAdded)
+}
 
-	// Set expectations for the repository
-	mockQuestionRepo.EXPECT().QuestionExists("q1").Return(false, nil)
-	mockQuestionRepo.EXPECT().QuestionExists("q2").Return(false, nil)
-	mockQuestionRepo.EXPECT().AddQuestions(gomock.Any()).Return(nil)
+func TestQuestionService_AddQuestionsFromFile_InvalidRow(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
 
-	// Execute
-	newQuestionsAdded, err := questionService.AddQuestionsFromFile("dummy/path")
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
 
-	// Assert
-	assert.Nil(t, err)
-	assert.True(t, newQuestionsAdded)
+	path := writeQuestionCSV(t,
+		"ID,Title,Difficulty,Leetcode Question Link,Topic Tags,Company Tags",
+		`1,Two Sum,Easy,https://leetcode.com/problems/two-sum,array,google`,
+		`15,3Sum,Extreme,https://leetcode.com/problems/3sum,array,meta`,
+	)
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&[]models.Question{}, nil)
 
-	// Test with a CSV entry for an existing question
-	mockQuestionRepo.EXPECT().QuestionExists("q1").Return(true, nil)
-	mockQuestionRepo.EXPECT().AddQuestions(gomock.Any()).Return(nil)
+	_, err := questionService.AddQuestionsFromFile("admin-id", path)
 
-	newQuestionsAdded, err = questionService.AddQuestionsFromFile("dummy/path")
-	assert.Nil(t, err)
-	assert.False(t, newQuestionsAdded)
+	assert.ErrorContains(t, err, "row 3")
+}
+
+func TestQuestionService_ImportQuestionsFromFile(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil).Times(2)
+
+	// Columns in a different order, with an extra one the import does not know
+	path := writeQuestionCSV(t,
+		"difficulty,question_id,notes,title,link,company tags,topic tags",
+		`easy,1,,Two Sum,https://leetcode.com/problems/two-sum,google,"array,hash-table"`,
+		`medium,15,,3Sum,https://leetcode.com/problems/3sum,"meta,apple",array`,
+		`hard,42,,Trapping Rain Water,https://leetcode.com/problems/trapping-rain-water,,stack`,
+		`easy,abc,,Bad ID,https://leetcode.com/problems/bad,,`,
+		`easy,7,,Reverse Integer,https://example.com/problems/reverse-integer,,`,
+		`easy,42,,Duplicate,https://leetcode.com/problems/trapping-rain-water,,`,
+		`easy,9`,
+	)
+
+	existing := []models.Question{
+		{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy", QuestionLink: "https://leetcode.com/problems/two-sum", TopicTags: []string{"array", "hash-table"}, CompanyTags: []string{"google"}},
+		{QuestionID: "15", QuestionTitle: "3sum", Difficulty: "easy", QuestionLink: "https://leetcode.com/problems/3sum", TopicTags: []string{"array"}, CompanyTags: []string{"meta"}},
+	}
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&existing, nil)
+
+	report, err := questionService.ImportQuestionsFromFile("admin-id", path, true)
+
+	require.NoError(t, err)
+	assert.False(t, report.Applied)
+	assert.Equal(t, 1, report.New)
+	assert.Equal(t, 1, report.Changed)
+	assert.Equal(t, 1, report.Unchanged)
+	assert.Equal(t, 4, report.Invalid)
+
+	statuses := make(map[int]string)
+	for _, row := range report.Rows {
+		statuses[row.Row] = row.Status
+	}
+	assert.Equal(t, map[int]string{
+		2: models.ImportRowUnchanged,
+		3: models.ImportRowChanged,
+		4: models.ImportRowNew,
+		5: models.ImportRowInvalid,
+		6: models.ImportRowInvalid,
+		7: models.ImportRowInvalid,
+		8: models.ImportRowInvalid,
+	}, statuses)
+	assert.Equal(t, []string{`difficulty: "easy" -> "medium"`, `company tags: "meta" -> "meta,apple"`}, report.Rows[1].Changes)
+	assert.Contains(t, report.Rows[5].Reason, "already on row 4")
+
+	// Applying writes the new and changed questions in one upsert
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&existing, nil)
+	mockQuestionRepo.EXPECT().UpsertQuestions(gomock.Any()).DoAndReturn(func(questions *[]models.Question) error {
+		require.Len(t, *questions, 2)
+		assert.Equal(t, "15", (*questions)[0].QuestionID)
+		assert.Equal(t, "42", (*questions)[1].QuestionID)
+		return nil
+	})
+	// One audit entry for the file, holding the question it overwrote
+	mockAuditService.EXPECT().Record("admin-id", models.AuditQuestionImport, filepath.Base(path), []models.Question{existing[1]}, gomock.Len(2)).Return(nil)
+
+	report, err = questionService.ImportQuestionsFromFile("admin-id", path, false)
+
+	require.NoError(t, err)
+	assert.True(t, report.Applied)
+}
+
+func TestQuestionService_ImportQuestionsFromFile_InvalidHeader(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil)
+
+	path := writeQuestionCSV(t,
+		"ID,Name,Difficulty,Topic Tags",
+		`1,Two Sum,Easy,array`,
+	)
+
+	_, err := questionService.ImportQuestionsFromFile("admin-id", path, true)
+
+	assert.ErrorContains(t, err, "missing title, link column")
+}
+
+func TestQuestionService_ExportQuestions(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	bank := []models.Question{
+		{QuestionID: "15", QuestionTitle: "3sum", Difficulty: "medium", QuestionLink: "https://leetcode.com/problems/3sum", TopicTags: []string{"array", "two-pointers"}, CompanyTags: []string{"meta"}},
+		{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy", QuestionLink: "https://leetcode.com/problems/two-sum", TopicTags: []string{"array"}, CompanyTags: []string{}},
+	}
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.QUESTIONS_WRITE).Return(nil).Times(4)
+
+	t.Run("csv", func(t *testing.T) {
+		mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&bank, nil)
+
+		var out strings.Builder
+		count, err := questionService.ExportQuestions("admin-id", &out, "csv", "", "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, "ID,Title,Difficulty,Leetcode Question Link,Topic Tags,Company Tags\n"+
+			"1,two sum,easy,https://leetcode.com/problems/two-sum,array,\n"+
+			"15,3sum,medium,https://leetcode.com/problems/3sum,\"array,two-pointers\",meta\n", out.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&bank, nil)
+
+		var out strings.Builder
+		_, err := questionService.ExportQuestions("admin-id", &out, "JSON", "", "", "")
+
+		require.NoError(t, err)
+		var exported []models.Question
+		require.NoError(t, json.Unmarshal([]byte(out.String()), &exported))
+		assert.Equal(t, []models.Question{bank[1], bank[0]}, exported)
+	})
+
+	t.Run("markdown with filters", func(t *testing.T) {
+		mockQuestionRepo.EXPECT().FetchQuestionsByFilters("medium", "meta", "").Return(&[]models.Question{bank[0]}, nil)
+
+		var out strings.Builder
+		count, err := questionService.ExportQuestions("admin-id", &out, "md", "Medium", "Meta", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, "# Question bank\n\n## Medium (1)\n\n- [ ] 15. [3sum](https://leetcode.com/problems/3sum) - array, two-pointers (meta)\n", out.String())
+	})
+
+	t.Run("invalid format", func(t *testing.T) {
+		_, err := questionService.ExportQuestions("admin-id", &strings.Builder{}, "xlsx", "", "", "")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestQuestionService_SearchQuestions(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&[]models.Question{
+		{QuestionID: "167", QuestionTitle: "two sum ii - input array is sorted", Difficulty: "medium", TopicTags: []string{"two-pointers"}},
+		{QuestionID: "1", QuestionTitle: "two-sum", Difficulty: "easy", TopicTags: []string{"array", "hash-table"}},
+		{QuestionID: "20", QuestionTitle: "valid parentheses", Difficulty: "easy", TopicTags: []string{"stack"}, CompanyTags: []string{"amazon"}},
+	}, nil)
+
+	results, err := questionService.SearchQuestions("Two Sum", 0)
+
+	assert.NoError(t, err)
+	if assert.Len(t, *results, 2) {
+		assert.Equal(t, "1", (*results)[0].Question.QuestionID)
+		assert.Equal(t, "title", (*results)[0].MatchedOn)
+		assert.Equal(t, "167", (*results)[1].Question.QuestionID)
+		assert.Greater(t, (*results)[0].Score, (*results)[1].Score)
+	}
+}
+
+func TestQuestionService_SearchQuestions_TypoAndTags(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	questions := &[]models.Question{
+		{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy", TopicTags: []string{"array"}},
+		{QuestionID: "20", QuestionTitle: "valid parentheses", Difficulty: "easy", TopicTags: []string{"stack"}, CompanyTags: []string{"amazon"}},
+	}
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(questions, nil).Times(3)
+
+	results, err := questionService.SearchQuestions("vaild parenthesis", 0)
+	assert.NoError(t, err)
+	if assert.Len(t, *results, 1) {
+		assert.Equal(t, "20", (*results)[0].Question.QuestionID)
+	}
+
+	results, err = questionService.SearchQuestions("Amazon", 0)
+	assert.NoError(t, err)
+	if assert.Len(t, *results, 1) {
+		assert.Equal(t, "company: amazon", (*results)[0].MatchedOn)
+	}
+
+	results, err = questionService.SearchQuestions("20", 1)
+	assert.NoError(t, err)
+	if assert.Len(t, *results, 1) {
+		assert.Equal(t, "id", (*results)[0].MatchedOn)
+	}
+}
+
+func TestQuestionService_SearchQuestions_EmptyQuery(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := questionService.SearchQuestions(" - ", 0)
+
+	assert.ErrorIs(t, err, services.ErrEmptySearchQuery)
+}
+
+func TestQuestionService_GetQuestionsPage(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionRepo.EXPECT().FetchQuestionsPage(models.PageRequest{Page: 1, PageSize: config.PAGE_SIZE, SortBy: models.SortByID}, nil).Return(&models.QuestionPage{}, nil)
+	mockQuestionRepo.EXPECT().FetchQuestionsPage(models.PageRequest{Page: 3, PageSize: config.MAX_PAGE_SIZE, SortBy: models.SortByDifficulty, Descending: true}, []string{"1"}).Return(&models.QuestionPage{}, nil)
+
+	_, err := questionService.GetQuestionsPage(models.PageRequest{}, nil)
+	assert.NoError(t, err)
+	_, err = questionService.GetQuestionsPage(models.PageRequest{Page: 3, PageSize: 5000, SortBy: " Difficulty ", Descending: true}, []string{"1"})
+	assert.NoError(t, err)
+}
+
+func TestQuestionService_GetQuestionsPage_Invalid(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := questionService.GetQuestionsPage(models.PageRequest{Page: -1}, nil)
+	assert.ErrorIs(t, err, services.ErrInvalidPage)
+
+	_, err = questionService.GetQuestionsPage(models.PageRequest{Page: 1, SortBy: "last_seen"}, nil)
+	assert.Error(t, err)
+}
+
+func TestQuestionService_GetCompletionByDifficulty(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionRepo.EXPECT().FetchAllQuestions().Return(&[]models.Question{
+		{QuestionID: "1", Difficulty: "easy"},
+		{QuestionID: "20", Difficulty: "easy"},
+		{QuestionID: "15", Difficulty: "medium"},
+		{QuestionID: "42", Difficulty: "hard"},
+	}, nil)
+
+	// Question 999 was removed from the bank after it was solved
+	completion, err := questionService.GetCompletionByDifficulty([]string{"1", "15", "999"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.DifficultyCompletion{
+		{Difficulty: "easy", Solved: 1, Total: 2},
+		{Difficulty: "medium", Solved: 1, Total: 1},
+		{Difficulty: "hard", Solved: 0, Total: 1},
+	}, *completion)
 }
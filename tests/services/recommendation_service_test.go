@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// recommendationBank has plenty of arrays, a few graphs and a single google question
+func recommendationBank() *[]models.Question {
+	questions := []models.Question{}
+	for i := 1; i <= 6; i++ {
+		questions = append(questions, models.Question{QuestionID: fmt.Sprint(i), QuestionTitle: fmt.Sprintf("Array %d", i), Difficulty: "easy", TopicTags: []string{"arrays"}, CompanyTags: []string{"amazon"}})
+	}
+	questions = append(questions,
+		models.Question{QuestionID: "7", QuestionTitle: "Graph Easy", Difficulty: "easy", TopicTags: []string{"graphs"}, CompanyTags: []string{"amazon"}},
+		models.Question{QuestionID: "8", QuestionTitle: "Graph Medium", Difficulty: "medium", TopicTags: []string{"graphs"}, CompanyTags: []string{"amazon"}},
+		models.Question{QuestionID: "9", QuestionTitle: "Graph Hard", Difficulty: "hard", TopicTags: []string{"graphs"}, CompanyTags: []string{"google"}},
+		models.Question{QuestionID: "10", QuestionTitle: "Array Medium", Difficulty: "medium", TopicTags: []string{"arrays"}, CompanyTags: []string{"amazon"}},
+	)
+	return &questions
+}
+
+func TestRecommendationService_WeakTopicsFirst(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// Most arrays are solved, no graphs are
+	mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{QuestionsSolved: []string{"1", "2", "3", "4", "5"}}, nil)
+	mockQuestionService.EXPECT().GetAllQuestions().Return(recommendationBank(), nil)
+
+	report, err := recommender.RecommendQuestions("user-id", models.RecommendationOptions{Limit: 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, "easy", report.Level)
+	require.NotEmpty(t, report.WeakTopics)
+	assert.Equal(t, models.TagCoverage{Tag: "graphs", Solved: 0, Total: 3}, report.WeakTopics[0])
+
+	// The unsolved easy graph question beats the last easy array question
+	require.Len(t, report.Recommendations, 3)
+	assert.Equal(t, "7", report.Recommendations[0].Question.QuestionID)
+	assert.Equal(t, "6", report.Recommendations[1].Question.QuestionID)
+	assert.Contains(t, report.Recommendations[0].Reasons, "under-practised topic: graphs (0/3 solved)")
+	for _, recommendation := range report.Recommendations {
+		assert.NotContains(t, []string{"1", "2", "3", "4", "5"}, recommendation.Question.QuestionID)
+	}
+}
+
+func TestRecommendationService_GradualProgression(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// Every easy question is solved so the user moves up to medium
+	mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{QuestionsSolved: []string{"1", "2", "3", "4", "5", "6", "7"}}, nil)
+	mockQuestionService.EXPECT().GetAllQuestions().Return(recommendationBank(), nil)
+
+	report, err := recommender.RecommendQuestions("user-id", models.RecommendationOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "medium", report.Level)
+	assert.Equal(t, "8", report.Recommendations[0].Question.QuestionID)
+	assert.Equal(t, "9", report.Recommendations[len(report.Recommendations)-1].Question.QuestionID)
+}
+
+func TestRecommendationService_TargetCompany(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{}, nil)
+	mockQuestionService.EXPECT().GetAllQuestions().Return(recommendationBank(), nil)
+
+	report, err := recommender.RecommendQuestions("user-id", models.RecommendationOptions{TargetCompany: " Google ", Progression: "easy"})
+	require.NoError(t, err)
+
+	// The only google question wins even though it is two levels above the chosen progression
+	assert.Equal(t, "easy", report.Level)
+	assert.Equal(t, "9", report.Recommendations[0].Question.QuestionID)
+	assert.Contains(t, report.Recommendations[0].Reasons, "asked by google")
+}
+
+func TestRecommendationService_UnknownCompany(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(&models.StandardUser{}, nil)
+	mockQuestionService.EXPECT().GetAllQuestions().Return(recommendationBank(), nil)
+
+	_, err := recommender.RecommendQuestions("user-id", models.RecommendationOptions{TargetCompany: "nowhere"})
+	assert.Equal(t, services.ErrUnknownCompany, err)
+}
+
+func TestRecommendationService_InvalidProgression(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := recommender.RecommendQuestions("user-id", models.RecommendationOptions{Progression: "sideways"})
+	assert.Error(t, err)
+}
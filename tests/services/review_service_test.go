@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/config"
+	"cli-project/internal/domain/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"testing"
+	"time"
+)
+
+// reviewAgain runs RecordReview against a stored card and returns the updated card
+func reviewAgain(t *testing.T, card *models.ReviewCard, rating int) *models.ReviewCard {
+	mockQuestionService.EXPECT().QuestionExists("1").Return(true, nil)
+	if card == nil {
+		mockReviewRepo.EXPECT().FetchReviewCard("user-id", "1").Return(nil, mongo.ErrNoDocuments)
+	} else {
+		stored := *card
+		mockReviewRepo.EXPECT().FetchReviewCard("user-id", "1").Return(&stored, nil)
+	}
+	mockReviewRepo.EXPECT().UpsertReviewCard(gomock.Any()).Return(nil)
+
+	updated, err := reviewService.RecordReview("user-id", "1", rating)
+	require.NoError(t, err)
+	return updated
+}
+
+func TestReviewService_RecordReview_Schedule(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// First review creates the card and schedules it for tomorrow
+	card := reviewAgain(t, nil, models.RecallGood)
+	assert.Equal(t, "user-id", card.UserID)
+	assert.Equal(t, 1, card.Interval)
+	assert.Equal(t, 1, card.Repetitions)
+	assert.InDelta(t, config.SM2_INITIAL_EASE_FACTOR, card.EaseFactor, 0.0001)
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	assert.Equal(t, tomorrow.Day(), card.DueAt.Local().Day())
+
+	// Second review jumps to six days
+	card = reviewAgain(t, card, models.RecallGood)
+	assert.Equal(t, 6, card.Interval)
+	assert.Equal(t, 2, card.Repetitions)
+
+	// After that the interval grows by the ease factor, easy ratings raise it
+	card = reviewAgain(t, card, models.RecallEasy)
+	assert.Equal(t, 15, card.Interval)
+	assert.InDelta(t, 2.6, card.EaseFactor, 0.0001)
+	assert.Equal(t, models.RecallEasy, card.LastRating)
+}
+
+func TestReviewService_RecordReview_Forgotten(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	card := &models.ReviewCard{UserID: "user-id", QuestionID: "1", EaseFactor: 1.4, Interval: 20, Repetitions: 4}
+
+	card = reviewAgain(t, card, models.RecallAgain)
+
+	// Forgotten questions restart from one day and the ease factor never drops below the minimum
+	assert.Equal(t, 1, card.Interval)
+	assert.Equal(t, 0, card.Repetitions)
+	assert.Equal(t, config.SM2_MIN_EASE_FACTOR, card.EaseFactor)
+}
+
+func TestReviewService_RecordReview_InvalidRating(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := reviewService.RecordReview("user-id", "1", 6)
+	assert.Equal(t, services.ErrInvalidRating, err)
+}
+
+func TestReviewService_RecordReview_UnknownQuestion(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionService.EXPECT().QuestionExists("999").Return(false, nil)
+
+	_, err := reviewService.RecordReview("user-id", "999", models.RecallGood)
+	assert.Error(t, err)
+}
+
+func TestReviewService_GetDueReviews(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	due := []models.ReviewCard{{UserID: "user-id", QuestionID: "1"}}
+	mockReviewRepo.EXPECT().FetchDueReviewCards("user-id", gomock.Any()).DoAndReturn(func(userID string, dueBefore time.Time) (*[]models.ReviewCard, error) {
+		// Everything due by the end of today is included
+		assert.True(t, dueBefore.After(time.Now()))
+		assert.True(t, dueBefore.Before(time.Now().Add(25*time.Hour)))
+		return &due, nil
+	})
+
+	cards, err := reviewService.GetDueReviews("user-id")
+	assert.NoError(t, err)
+	assert.Equal(t, &due, cards)
+}
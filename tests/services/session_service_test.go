@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/domain/models"
+	"cli-project/pkg/utils/tokens"
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"testing"
+	"time"
+)
+
+func createTestSession(t *testing.T) *models.Session {
+	var stored *models.Session
+	mockSessionRepo.EXPECT().CreateSession(gomock.Any()).DoAndReturn(func(session *models.Session) error {
+		stored = session
+		return nil
+	}).Times(1)
+
+	session, err := sessionService.CreateSession(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", Username: "testuser"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, stored, session)
+
+	return session
+}
+
+func TestSessionService_CreateSession(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	session := createTestSession(t)
+
+	assert.NotEmpty(t, session.ID)
+	assert.NotEmpty(t, session.Token)
+	assert.Equal(t, "user-id", session.UserID)
+	assert.Equal(t, "testuser", session.Username)
+	assert.True(t, session.ExpiresAt.After(time.Now()))
+	assert.False(t, session.Revoked)
+}
+
+func TestSessionService_CreateSession_RepoError(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockSessionRepo.EXPECT().CreateSession(gomock.Any()).Return(errors.New("db down")).Times(1)
+
+	session, err := sessionService.CreateSession(&models.StandardUser{StandardUser: models.User{ID: "user-id"}})
+	assert.Nil(t, session)
+	assert.Error(t, err)
+}
+
+func TestSessionService_ValidateSession(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	session := createTestSession(t)
+	stored := *session
+	stored.Token = ""
+
+	mockSessionRepo.EXPECT().FetchSessionByID(session.ID).Return(&stored, nil).Times(1)
+
+	validated, err := sessionService.ValidateSession(session.Token)
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, validated.ID)
+	assert.Equal(t, session.Token, validated.Token)
+}
+
+func TestSessionService_ValidateSession_Revoked(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	session := createTestSession(t)
+	stored := *session
+	stored.Revoked = true
+
+	mockSessionRepo.EXPECT().FetchSessionByID(session.ID).Return(&stored, nil).Times(1)
+
+	validated, err := sessionService.ValidateSession(session.Token)
+	assert.Nil(t, validated)
+	assert.Equal(t, services.ErrSessionRevoked, err)
+}
+
+func TestSessionService_ValidateSession_Unknown(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	session := createTestSession(t)
+
+	mockSessionRepo.EXPECT().FetchSessionByID(session.ID).Return(nil, mongo.ErrNoDocuments).Times(1)
+
+	_, err := sessionService.ValidateSession(session.Token)
+	assert.Equal(t, services.ErrInvalidSession, err)
+}
+
+func TestSessionService_ValidateSession_Tampered(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	session := createTestSession(t)
+
+	_, err := sessionService.ValidateSession(session.Token + "x")
+	assert.Equal(t, services.ErrInvalidSession, err)
+
+	// A token signed with another secret is rejected before the repository is consulted
+	other := services.NewSessionService(mockSessionRepo, []byte("other-secret"))
+	_, err = other.ValidateSession(session.Token)
+	assert.Equal(t, services.ErrInvalidSession, err)
+}
+
+func TestSessionService_ValidateSession_Expired(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	token := tokens.Sign([]byte("test-secret"), []byte(`{"sid":"session-id","uid":"user-id","exp":1}`))
+
+	_, err := sessionService.ValidateSession(token)
+	assert.Equal(t, services.ErrSessionExpired, err)
+}
+
+func TestSessionService_RevokeSession(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockSessionRepo.EXPECT().RevokeSession("session-id").Return(nil).Times(1)
+
+	assert.NoError(t, sessionService.RevokeSession(&models.Session{ID: "session-id"}))
+	assert.Equal(t, services.ErrInvalidSession, sessionService.RevokeSession(nil))
+}
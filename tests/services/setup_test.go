@@ -12,17 +12,37 @@ import (
 )
 
 var (
-	ctrl                *gomock.Controller
-	mockUserRepo        *mock_interfaces.MockUserRepository
-	mockQuestionRepo    *mock_interfaces.MockQuestionRepository
-	mockUserService     *mock_services.MockUserService
-	mockQuestionService *mock_services.MockQuestionService
-	mockAuthService     *mock_services.MockAuthService
-	mockLeetcodeAPI     *mock_services.MockLeetcodeAPI
-	userService         interfaces.UserService
-	questionService     interfaces.QuestionService
-	authService         interfaces.AuthService
-	LeetcodeAPI         interfaces2.LeetcodeAPI
+	ctrl                 *gomock.Controller
+	mockUserRepo         *mock_interfaces.MockUserRepository
+	mockQuestionRepo     *mock_interfaces.MockQuestionRepository
+	mockLeaderboardRepo  *mock_interfaces.MockLeaderboardRepository
+	mockSessionRepo      *mock_interfaces.MockSessionRepository
+	mockReviewRepo       *mock_interfaces.MockReviewRepository
+	mockStudyListRepo    *mock_interfaces.MockStudyListRepository
+	mockNoteRepo         *mock_interfaces.MockNoteRepository
+	mockAnnouncementRepo *mock_interfaces.MockAnnouncementRepository
+	mockAuditRepo        *mock_interfaces.MockAuditRepository
+	mockBanRepo          *mock_interfaces.MockBanRepository
+	mockUserService      *mock_services.MockUserService
+	mockQuestionService  *mock_services.MockQuestionService
+	mockAuthService      *mock_services.MockAuthService
+	mockLeetcodeAPI      *mock_services.MockLeetcodeAPI
+	mockSessionService   *mock_services.MockSessionService
+	mockAuditService     *mock_services.MockAuditService
+	mockAccessService    *mock_services.MockAccessService
+	userService          interfaces.UserService
+	questionService      interfaces.QuestionService
+	authService          interfaces.AuthService
+	leaderboardService   interfaces.LeaderboardService
+	sessionService       interfaces.SessionService
+	reviewService        interfaces.ReviewService
+	recommender          interfaces.RecommendationService
+	studyListService     interfaces.StudyListService
+	noteService          interfaces.NoteService
+	announcementService  interfaces.AnnouncementService
+	auditService         interfaces.AuditService
+	accessService        interfaces.AccessService
+	LeetcodeAPI          interfaces2.LeetcodeAPI
 )
 
 func setup(t *testing.T) func() {
@@ -32,18 +52,38 @@ func setup(t *testing.T) func() {
 	// Create mock repositories
 	mockUserRepo = mock_interfaces.NewMockUserRepository(ctrl)
 	mockQuestionRepo = mock_interfaces.NewMockQuestionRepository(ctrl)
+	mockLeaderboardRepo = mock_interfaces.NewMockLeaderboardRepository(ctrl)
+	mockSessionRepo = mock_interfaces.NewMockSessionRepository(ctrl)
+	mockReviewRepo = mock_interfaces.NewMockReviewRepository(ctrl)
+	mockStudyListRepo = mock_interfaces.NewMockStudyListRepository(ctrl)
+	mockNoteRepo = mock_interfaces.NewMockNoteRepository(ctrl)
+	mockAnnouncementRepo = mock_interfaces.NewMockAnnouncementRepository(ctrl)
+	mockAuditRepo = mock_interfaces.NewMockAuditRepository(ctrl)
+	mockBanRepo = mock_interfaces.NewMockBanRepository(ctrl)
 
 	// Create mock services
 	mockUserService = mock_services.NewMockUserService(ctrl)
 	mockQuestionService = mock_services.NewMockQuestionService(ctrl)
 	mockAuthService = mock_services.NewMockAuthService(ctrl)
 	mockLeetcodeAPI = mock_services.NewMockLeetcodeAPI(ctrl)
+	mockSessionService = mock_services.NewMockSessionService(ctrl)
+	mockAuditService = mock_services.NewMockAuditService(ctrl)
+	mockAccessService = mock_services.NewMockAccessService(ctrl)
 	LeetcodeAPI = mock_services.NewMockLeetcodeAPI(ctrl)
 
 	// Create Genuine Services
-	userService = services.NewUserService(mockUserRepo, mockQuestionService, mockLeetcodeAPI)
-	questionService = services.NewQuestionService(mockQuestionRepo)
+	userService = services.NewUserService(mockUserRepo, mockBanRepo, mockQuestionService, mockSessionService, mockLeetcodeAPI, mockAuditService, mockAccessService)
+	questionService = services.NewQuestionService(mockQuestionRepo, mockAuditService, mockAccessService)
 	authService = services.NewAuthService(mockUserRepo, mockLeetcodeAPI)
+	leaderboardService = services.NewLeaderboardService(mockLeaderboardRepo)
+	sessionService = services.NewSessionService(mockSessionRepo, []byte("test-secret"))
+	reviewService = services.NewReviewService(mockReviewRepo, mockQuestionService)
+	recommender = services.NewRecommendationService(mockUserService, mockQuestionService)
+	studyListService = services.NewStudyListService(mockStudyListRepo, mockUserService, mockQuestionService, mockAccessService)
+	noteService = services.NewNoteService(mockNoteRepo, mockQuestionService)
+	announcementService = services.NewAnnouncementService(mockAnnouncementRepo, mockUserService, mockAccessService)
+	auditService = services.NewAuditService(mockAuditRepo, mockUserRepo, mockAccessService)
+	accessService = services.NewAccessService(mockUserRepo, mockBanRepo)
 	LeetcodeAPI = api.NewLeetcodeAPI()
 
 	// Return a cleanup function to be called at the end of the test
@@ -0,0 +1,1184 @@
+package service_test
+
+import (
+	"bytes"
+	"cli-project/internal/app/repositories"
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/drivers"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/interfaces"
+	"cli-project/internal/domain/models"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The TestStorageDrivers_* tests run the real services on top of each storage driver, one table of cases
+// per repository. Bolt always runs, the Mongo half needs a disposable database, see the README.
+
+// storageTest is one case of a TestStorageDrivers_* table, run against a fresh database of every driver
+type storageTest struct {
+	name string
+	run  func(t *testing.T, driver interfaces.StorageDriver)
+}
+
+func runStorageTests(t *testing.T, tests []storageTest) {
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forEachDriver(t, tt.run)
+		})
+	}
+}
+
+// forEachDriver runs fn against a fresh Bolt database, and against MongoDB when
+// CODESAGE_TEST_MONGO_URI points at a disposable database (e.g. mongodb://localhost:27017/codesage_test).
+func forEachDriver(t *testing.T, fn func(t *testing.T, driver interfaces.StorageDriver)) {
+	t.Run(drivers.BOLT, func(t *testing.T) {
+		driver, err := repositories.NewStorageDriver(drivers.BOLT, filepath.Join(t.TempDir(), "codesage.db"))
+		require.NoError(t, err)
+		defer driver.Close()
+
+		fn(t, driver)
+	})
+
+	t.Run(drivers.MONGO, func(t *testing.T) {
+		uri := os.Getenv("CODESAGE_TEST_MONGO_URI")
+		if uri == "" {
+			t.Skip("CODESAGE_TEST_MONGO_URI not set")
+		}
+
+		driver, err := repositories.NewStorageDriver(drivers.MONGO, uri)
+		require.NoError(t, err)
+		defer driver.Close()
+
+		mongoDriver, ok := driver.(interface {
+			Database() (*mongo.Database, error)
+		})
+		require.True(t, ok)
+		database, err := mongoDriver.Database()
+		require.NoError(t, err)
+		require.NoError(t, database.Drop(context.Background()))
+		defer database.Drop(context.Background())
+
+		fn(t, driver)
+	})
+}
+
+// storageServices are the services cmd/main.go builds, wired to the driver's own storage
+type storageServices struct {
+	access        interfaces.AccessService
+	audit         interfaces.AuditService
+	questions     interfaces.QuestionService
+	users         interfaces.UserService
+	auth          interfaces.AuthService
+	leaderboard   interfaces.LeaderboardService
+	reviews       interfaces.ReviewService
+	studyLists    interfaces.StudyListService
+	notes         interfaces.NoteService
+	announcements interfaces.AnnouncementService
+}
+
+func newStorageServices(driver interfaces.StorageDriver) *storageServices {
+	s := &storageServices{}
+	s.access = services.NewAccessService(driver.UserRepository(), driver.BanRepository())
+	s.audit = services.NewAuditService(driver.AuditRepository(), driver.UserRepository(), s.access)
+	s.questions = services.NewQuestionService(driver.QuestionRepository(), s.audit, s.access)
+	sessionService := services.NewSessionService(driver.SessionRepository(), []byte("test-secret"))
+	s.users = services.NewUserService(driver.UserRepository(), driver.BanRepository(), s.questions, sessionService, nil, s.audit, s.access)
+	s.auth = services.NewAuthService(driver.UserRepository(), nil)
+	s.leaderboard = services.NewLeaderboardService(driver.LeaderboardRepository())
+	s.reviews = services.NewReviewService(driver.ReviewRepository(), s.questions)
+	s.studyLists = services.NewStudyListService(driver.StudyListRepository(), s.users, s.questions, s.access)
+	s.notes = services.NewNoteService(driver.NoteRepository(), s.questions)
+	s.announcements = services.NewAnnouncementService(driver.AnnouncementRepository(), s.users, s.access)
+	return s
+}
+
+func createUsers(t *testing.T, driver interfaces.StorageDriver, users ...models.User) {
+	t.Helper()
+	for _, user := range users {
+		require.NoError(t, driver.UserRepository().CreateUser(&models.StandardUser{StandardUser: user}))
+	}
+}
+
+func addQuestions(t *testing.T, driver interfaces.StorageDriver, questions ...models.Question) {
+	t.Helper()
+	require.NoError(t, driver.QuestionRepository().AddQuestions(&questions))
+}
+
+func TestStorageDrivers_QuestionRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"lookup, filter and update", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy", QuestionLink: "https://leetcode.com/problems/two-sum", TopicTags: []string{"array", "hash-table"}, CompanyTags: []string{"google", "amazon"}},
+				models.Question{QuestionID: "15", QuestionTitle: "3Sum", Difficulty: "medium", QuestionLink: "https://leetcode.com/problems/3sum", TopicTags: []string{"array", "two-pointers"}, CompanyTags: []string{"meta"}},
+			)
+			// Content editors look after the question bank, moderators may not change it
+			createUsers(t, driver,
+				models.User{ID: "editor-id", Username: "editor", Role: roles.CONTENT_EDITOR},
+				models.User{ID: "moderator-id", Username: "moderator", Role: roles.MODERATOR},
+			)
+
+			count, err := s.questions.GetTotalQuestionsCount()
+			assert.NoError(t, err)
+			assert.Equal(t, int64(2), count)
+
+			exists, err := s.questions.QuestionExists("15")
+			assert.NoError(t, err)
+			assert.True(t, exists)
+
+			question, err := s.questions.GetQuestionByID("1")
+			assert.NoError(t, err)
+			assert.Equal(t, "Two Sum", question.QuestionTitle)
+
+			filtered, err := s.questions.GetQuestionsByFilters("easy", "google", "array")
+			assert.NoError(t, err)
+			assert.Len(t, *filtered, 1)
+
+			filtered, err = s.questions.FilterQuestions(models.QuestionFilter{Topics: []string{"array"}, ExcludeCompanies: []string{"google"}})
+			assert.NoError(t, err)
+			if assert.Len(t, *filtered, 1) {
+				assert.Equal(t, "15", (*filtered)[0].QuestionID)
+			}
+
+			difficulty, companies := "hard", []string{"meta", "apple"}
+			_, err = s.questions.UpdateQuestion("editor-id", "15", models.QuestionUpdate{Difficulty: &difficulty, CompanyTags: &companies})
+			assert.NoError(t, err)
+			updated, err := s.questions.GetQuestionByID("15")
+			assert.NoError(t, err)
+			assert.Equal(t, "hard", updated.Difficulty)
+			assert.Equal(t, []string{"meta", "apple"}, updated.CompanyTags)
+			assert.Equal(t, []string{"array", "two-pointers"}, updated.TopicTags)
+
+			assert.ErrorIs(t, s.questions.RemoveQuestionByID("moderator-id", "1"), services.ErrPermissionDenied)
+			assert.NoError(t, s.questions.RemoveQuestionByID("editor-id", "1"))
+
+			all, err := s.questions.GetAllQuestions()
+			assert.NoError(t, err)
+			assert.Len(t, *all, 1)
+		}},
+		{"import and export", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "15", QuestionTitle: "3Sum", Difficulty: "hard", QuestionLink: "https://leetcode.com/problems/3sum", TopicTags: []string{"array", "two-pointers"}, CompanyTags: []string{"meta", "apple"}},
+			)
+			createUsers(t, driver, models.User{ID: "editor-id", Username: "editor", Role: roles.CONTENT_EDITOR})
+
+			path := filepath.Join(t.TempDir(), "questions.csv")
+			require.NoError(t, os.WriteFile(path, []byte(strings.Join([]string{
+				"ID,Title,Difficulty,Leetcode Question Link,Topic Tags,Company Tags",
+				`1,Two Sum,Easy,https://leetcode.com/problems/two-sum,array,google`,
+				`15,3Sum,Medium,https://leetcode.com/problems/3sum,"array,two-pointers","meta,apple"`,
+			}, "\n")), 0o600))
+
+			report, err := s.questions.ImportQuestionsFromFile("editor-id", path, false)
+			require.NoError(t, err)
+			assert.Equal(t, 1, report.New)
+			assert.Equal(t, 1, report.Changed)
+			assert.True(t, report.Applied)
+
+			report, err = s.questions.ImportQuestionsFromFile("editor-id", path, true)
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.Unchanged)
+
+			imported, err := s.questions.GetQuestionByID("15")
+			assert.NoError(t, err)
+			assert.Equal(t, "medium", imported.Difficulty)
+
+			// An exported bank imports back without changes
+			var exported bytes.Buffer
+			exportedCount, err := s.questions.ExportQuestions("editor-id", &exported, "csv", "", "", "")
+			require.NoError(t, err)
+			assert.Equal(t, 2, exportedCount)
+			require.NoError(t, os.WriteFile(path, exported.Bytes(), 0o600))
+			report, err = s.questions.ImportQuestionsFromFile("editor-id", path, true)
+			require.NoError(t, err)
+			assert.Equal(t, 2, report.Unchanged)
+			assert.Zero(t, report.New+report.Changed+report.Invalid)
+		}},
+		{"paging and sort ties", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "10", QuestionTitle: "regular expression matching", Difficulty: "hard"},
+				models.Question{QuestionID: "2", QuestionTitle: "add two numbers", Difficulty: "medium"},
+				models.Question{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy"},
+				models.Question{QuestionID: "15", QuestionTitle: "3sum", Difficulty: "medium"},
+				models.Question{QuestionID: "20", QuestionTitle: "valid parentheses", Difficulty: "easy"},
+			)
+
+			pages := []struct {
+				name    string
+				request models.PageRequest
+				exclude []string
+				want    []string
+				total   int64
+			}{
+				// Numeric IDs sort by value
+				{"first page", models.PageRequest{Page: 1, PageSize: 2}, nil, []string{"1", "2"}, 5},
+				{"middle page", models.PageRequest{Page: 2, PageSize: 2}, nil, []string{"10", "15"}, 5},
+				{"last page", models.PageRequest{Page: 3, PageSize: 2}, nil, []string{"20"}, 5},
+				{"past the last page", models.PageRequest{Page: 4, PageSize: 2}, nil, []string{}, 5},
+				{"descending", models.PageRequest{Page: 1, PageSize: 3, Descending: true}, nil, []string{"20", "15", "10"}, 5},
+				// Ties on difficulty fall back to the ID, in either direction
+				{"difficulty ties", models.PageRequest{Page: 1, SortBy: "difficulty"}, nil, []string{"1", "20", "2", "15", "10"}, 5},
+				{"difficulty ties descending", models.PageRequest{Page: 1, PageSize: 3, SortBy: "difficulty", Descending: true}, nil, []string{"10", "2", "15"}, 5},
+				{"title", models.PageRequest{Page: 1, PageSize: 2, SortBy: "title"}, nil, []string{"15", "2"}, 5},
+				// Solved questions can be left out without leaving gaps in the pages
+				{"solved left out", models.PageRequest{Page: 1, PageSize: 2}, []string{"1", "10"}, []string{"2", "15"}, 3},
+			}
+			for _, tt := range pages {
+				page, err := s.questions.GetQuestionsPage(tt.request, tt.exclude)
+				require.NoError(t, err, tt.name)
+				assert.Equal(t, tt.total, page.Total, tt.name)
+				ids := []string{}
+				for _, question := range page.Questions {
+					ids = append(ids, question.QuestionID)
+				}
+				assert.Equal(t, tt.want, ids, tt.name)
+			}
+
+			page, err := s.questions.GetQuestionsPage(models.PageRequest{Page: 1, PageSize: 2}, nil)
+			require.NoError(t, err)
+			assert.Equal(t, 3, page.TotalPages)
+		}},
+		{"empty bank", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+
+			count, err := s.questions.GetTotalQuestionsCount()
+			require.NoError(t, err)
+			assert.Zero(t, count)
+
+			all, err := s.questions.GetAllQuestions()
+			require.NoError(t, err)
+			assert.Empty(t, *all)
+
+			page, err := s.questions.GetQuestionsPage(models.PageRequest{Page: 1}, nil)
+			require.NoError(t, err)
+			assert.Zero(t, page.Total)
+			assert.Empty(t, page.Questions)
+
+			filtered, err := s.questions.FilterQuestions(models.QuestionFilter{Topics: []string{"array"}})
+			require.NoError(t, err)
+			assert.Empty(t, *filtered)
+
+			exists, err := s.questions.QuestionExists("1")
+			require.NoError(t, err)
+			assert.False(t, exists)
+		}},
+		{"missing IDs", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver, models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy"})
+			createUsers(t, driver, models.User{ID: "editor-id", Username: "editor", Role: roles.CONTENT_EDITOR})
+
+			_, err := s.questions.GetQuestionByID("404")
+			assert.EqualError(t, err, "question with ID 404 not found")
+			_, err = driver.QuestionRepository().FetchQuestionByID("404")
+			assert.EqualError(t, err, "question with ID 404 not found")
+
+			difficulty := "hard"
+			_, err = s.questions.UpdateQuestion("editor-id", "404", models.QuestionUpdate{Difficulty: &difficulty})
+			assert.EqualError(t, err, "question with ID 404 not found")
+			assert.ErrorIs(t, driver.QuestionRepository().UpdateQuestion(&models.Question{QuestionID: "404"}), mongo.ErrNoDocuments)
+			assert.EqualError(t, s.questions.RemoveQuestionByID("editor-id", "404"), "question with ID 404 not found")
+
+			count, err := s.questions.GetTotalQuestionsCount()
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), count)
+		}},
+	})
+}
+
+func TestStorageDrivers_UserRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"signup, login and progress", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver, models.Question{QuestionID: "202", QuestionTitle: "Happy Number", Difficulty: "easy"})
+			createUsers(t, driver, models.User{ID: "moderator-id", Username: "moderator", Role: roles.MODERATOR})
+
+			err := s.users.Signup(&models.StandardUser{
+				StandardUser: models.User{
+					Username:     "TestUser",
+					Password:     "Password@123",
+					Name:         "Test User",
+					Email:        "testuser@gmail.com",
+					Organisation: "watchguard",
+					Country:      "india",
+				},
+				LeetcodeID: "test_leet",
+			})
+			require.NoError(t, err)
+
+			unique, err := s.auth.IsUsernameUnique("testuser")
+			assert.NoError(t, err)
+			assert.False(t, unique)
+
+			unique, err = s.auth.IsEmailUnique("other@gmail.com")
+			assert.NoError(t, err)
+			assert.True(t, unique)
+
+			unique, err = s.auth.IsLeetcodeIDUnique("test_leet")
+			assert.NoError(t, err)
+			assert.False(t, unique)
+
+			session, err := s.users.Login("testuser", "Password@123")
+			require.NoError(t, err)
+			_, err = s.users.Login("testuser", "wrong")
+			assert.Equal(t, services.ErrInvalidCredentials, err)
+
+			userID, err := s.users.GetUserID("testuser")
+			require.NoError(t, err)
+			assert.Equal(t, userID, session.UserID)
+
+			resumed, err := s.users.ResumeSession(session.Token)
+			require.NoError(t, err)
+			assert.Equal(t, session.ID, resumed.ID)
+
+			updated, err := s.users.UpdateUserProgress(session.UserID, models.SolveEvent{QuestionID: "202", Language: "Go"})
+			assert.NoError(t, err)
+			assert.True(t, updated)
+
+			updated, err = s.users.UpdateUserProgress(session.UserID, models.SolveEvent{QuestionID: "202", TimeTaken: 10 * time.Minute})
+			assert.NoError(t, err)
+			assert.False(t, updated)
+
+			user, err := s.users.GetUserByID(userID)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"202"}, user.QuestionsSolved)
+			assert.Equal(t, "Watchguard", user.StandardUser.Organisation)
+
+			history, err := s.users.GetSolveHistory(userID)
+			require.NoError(t, err)
+			require.Len(t, *history, 2)
+			assert.Equal(t, "go", (*history)[0].Language)
+			assert.Equal(t, 10*time.Minute, (*history)[1].TimeTaken)
+
+			activity, err := s.users.GetDailyActivity(userID, 7)
+			require.NoError(t, err)
+			require.Len(t, *activity, 7)
+			assert.Equal(t, 2, (*activity)[6].Solves)
+
+			alreadyBanned, err := s.users.BanUser("moderator-id", "testuser", "spam", time.Time{})
+			assert.NoError(t, err)
+			assert.False(t, alreadyBanned)
+
+			banned, err := s.users.IsUserBanned(userID)
+			assert.NoError(t, err)
+			assert.True(t, banned)
+
+			alreadyUnbanned, err := s.users.UnbanUser("moderator-id", "testuser")
+			assert.NoError(t, err)
+			assert.False(t, alreadyUnbanned)
+
+			assert.NoError(t, s.users.Logout(session))
+
+			_, err = s.users.ResumeSession(session.Token)
+			assert.Equal(t, services.ErrSessionRevoked, err)
+
+			active, err := s.users.CountActiveUserInLast24Hours("moderator-id")
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), active)
+
+			_, err = s.users.CountActiveUserInLast24Hours(userID)
+			assert.ErrorIs(t, err, services.ErrPermissionDenied)
+
+			// The moderator never logged in, so only the user has been seen
+			users, err := s.users.GetAllUsers()
+			assert.NoError(t, err)
+			assert.Len(t, *users, 2)
+			for _, user := range *users {
+				if user.StandardUser.Username == "testuser" {
+					assert.WithinDuration(t, time.Now(), user.LastSeen, time.Minute)
+				}
+			}
+		}},
+		{"paging and sort ties", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			now := time.Now().UTC().Truncate(time.Second)
+			// Alice and dave were last seen at the same time
+			lastSeen := map[string]time.Time{"carol": now, "alice": now.Add(time.Hour), "dave": now.Add(time.Hour), "bob": now.Add(2 * time.Hour)}
+			for username, seen := range lastSeen {
+				require.NoError(t, driver.UserRepository().CreateUser(&models.StandardUser{
+					StandardUser: models.User{ID: username + "-id", Username: username, Role: roles.USER},
+					LastSeen:     seen,
+				}))
+			}
+			createUsers(t, driver, models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN})
+
+			pages := []struct {
+				name    string
+				role    string
+				request models.PageRequest
+				want    []string
+				total   int64
+			}{
+				{"by username", roles.USER, models.PageRequest{Page: 1}, []string{"alice", "bob", "carol", "dave"}, 4},
+				{"second page", roles.USER, models.PageRequest{Page: 2, PageSize: 1}, []string{"bob"}, 4},
+				{"past the last page", roles.USER, models.PageRequest{Page: 3, PageSize: 2}, []string{}, 4},
+				// Ties on last seen fall back to the username, in either direction
+				{"last seen ties", roles.USER, models.PageRequest{Page: 1, SortBy: "last seen"}, []string{"carol", "alice", "dave", "bob"}, 4},
+				{"last seen ties descending", roles.USER, models.PageRequest{Page: 1, SortBy: "last seen", Descending: true}, []string{"bob", "alice", "dave", "carol"}, 4},
+				{"every role", "", models.PageRequest{Page: 1}, []string{"admin", "alice", "bob", "carol", "dave"}, 5},
+			}
+			for _, tt := range pages {
+				page, err := s.users.GetUsersPage(tt.role, tt.request)
+				require.NoError(t, err, tt.name)
+				assert.Equal(t, tt.total, page.Total, tt.name)
+				names := []string{}
+				for _, user := range page.Users {
+					names = append(names, user.StandardUser.Username)
+				}
+				assert.Equal(t, tt.want, names, tt.name)
+			}
+		}},
+		{"solve history migration", func(t *testing.T, driver interfaces.StorageDriver) {
+			userRepo := driver.UserRepository()
+
+			// A user stored before solve events existed
+			require.NoError(t, userRepo.CreateUser(&models.StandardUser{
+				StandardUser:    models.User{ID: "old-user", Username: "olduser", Role: roles.USER},
+				QuestionsSolved: []string{"1", "2"},
+			}))
+
+			migrated, err := userRepo.MigrateSolveHistory()
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), migrated)
+
+			user, err := userRepo.FetchUserByID("old-user")
+			require.NoError(t, err)
+			require.Len(t, user.SolveHistory, 2)
+			assert.Equal(t, "1", user.SolveHistory[0].QuestionID)
+			assert.True(t, user.SolveHistory[0].SolvedAt.IsZero())
+
+			// Running it again leaves migrated users alone
+			migrated, err = userRepo.MigrateSolveHistory()
+			require.NoError(t, err)
+			assert.Equal(t, int64(0), migrated)
+		}},
+		{"role management", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+
+			root := models.StandardUser{StandardUser: models.User{Username: "root", Email: "root@gmail.com", Password: "Password@123"}}
+			require.NoError(t, s.users.BootstrapAdmin(&root))
+
+			second := models.StandardUser{StandardUser: models.User{Username: "second", Email: "second@gmail.com", Password: "Password@123"}}
+			assert.ErrorIs(t, s.users.BootstrapAdmin(&second), services.ErrAdminExists)
+
+			require.NoError(t, s.users.Signup(&models.StandardUser{
+				StandardUser: models.User{Username: "someone", Email: "someone@gmail.com", Password: "Password@123"},
+			}))
+
+			role, err := s.users.GetUserRole(root.StandardUser.ID)
+			require.NoError(t, err)
+			assert.Equal(t, roles.ADMIN, role)
+
+			_, err = s.users.DemoteUser(root.StandardUser.ID, "root")
+			assert.ErrorIs(t, err, services.ErrLastAdmin)
+
+			alreadyAdmin, err := s.users.PromoteUser(root.StandardUser.ID, "someone")
+			require.NoError(t, err)
+			assert.False(t, alreadyAdmin)
+
+			admins, err := driver.UserRepository().CountUsersByRole(roles.ADMIN)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), admins)
+
+			// With a second admin the first can step down
+			notAdmin, err := s.users.DemoteUser(root.StandardUser.ID, "root")
+			require.NoError(t, err)
+			assert.False(t, notAdmin)
+
+			role, err = s.users.GetUserRole(root.StandardUser.ID)
+			require.NoError(t, err)
+			assert.Equal(t, roles.USER, role)
+
+			// A standard user has no permissions left
+			_, err = s.users.PromoteUser(root.StandardUser.ID, "root")
+			assert.ErrorIs(t, err, services.ErrPermissionDenied)
+
+			someoneID, err := s.users.GetUserID("someone")
+			require.NoError(t, err)
+			_, err = s.users.ChangeUserRole(someoneID, "root", "superuser")
+			assert.ErrorIs(t, err, services.ErrInvalidRole)
+			alreadyModerator, err := s.users.ChangeUserRole(someoneID, "root", roles.MODERATOR)
+			require.NoError(t, err)
+			assert.False(t, alreadyModerator)
+
+			// Moderators ban users but cannot change roles, or ban staff
+			_, err = s.users.ChangeUserRole(root.StandardUser.ID, "someone", roles.USER)
+			assert.ErrorIs(t, err, services.ErrPermissionDenied)
+			_, err = s.users.BanUser(root.StandardUser.ID, "someone", "spam", time.Time{})
+			assert.ErrorIs(t, err, services.ErrPermissionDenied)
+
+			page, err := s.audit.GetAuditLog(someoneID, models.AuditFilter{Action: "user"}, models.PageRequest{})
+			require.NoError(t, err)
+			require.Len(t, page.Entries, 4)
+			assert.Equal(t, models.AuditUserPromote, page.Entries[0].Action)
+			assert.JSONEq(t, `{"role":"moderator"}`, page.Entries[0].After)
+			assert.Equal(t, models.AuditUserDemote, page.Entries[1].Action)
+			assert.JSONEq(t, `{"role":"admin"}`, page.Entries[1].Before)
+			assert.Equal(t, models.AuditUserBootstrap, page.Entries[3].Action)
+			assert.Equal(t, "root", page.Entries[3].ActorName)
+
+			// Only admins read the audit log
+			_, err = s.audit.GetAuditLog(root.StandardUser.ID, models.AuditFilter{}, models.PageRequest{})
+			assert.ErrorIs(t, err, services.ErrPermissionDenied)
+		}},
+		{"no users", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+
+			users, err := s.users.GetAllUsers()
+			require.NoError(t, err)
+			assert.Empty(t, *users)
+
+			page, err := s.users.GetUsersPage("", models.PageRequest{Page: 1})
+			require.NoError(t, err)
+			assert.Zero(t, page.Total)
+			assert.Empty(t, page.Users)
+
+			admins, err := driver.UserRepository().CountUsersByRole(roles.ADMIN)
+			require.NoError(t, err)
+			assert.Zero(t, admins)
+
+			active, err := driver.UserRepository().CountActiveUsersInLast24Hours()
+			require.NoError(t, err)
+			assert.Zero(t, active)
+
+			unique, err := s.auth.IsUsernameUnique("anyone")
+			require.NoError(t, err)
+			assert.True(t, unique)
+		}},
+		{"missing IDs", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver, models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN})
+
+			_, err := s.users.GetUserByID("nobody-id")
+			assert.EqualError(t, err, "user not found")
+			_, err = s.users.GetUserID("nobody")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			_, err = s.users.Login("nobody", "Password@123")
+			assert.Equal(t, services.ErrUserNotFound, err)
+			_, err = s.users.UnbanUser("admin-id", "nobody")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			_, err = s.users.BanUser("admin-id", "nobody", "spam", time.Time{})
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+		}},
+	})
+}
+
+func TestStorageDrivers_BanRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"appeals", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN},
+				models.User{ID: "spammer-id", Username: "spammer", Role: roles.USER},
+				models.User{ID: "cheater-id", Username: "cheater", Role: roles.USER},
+			)
+
+			_, err := s.users.BanUser("admin-id", "spammer", "posting spam", time.Time{})
+			require.NoError(t, err)
+			_, err = s.users.BanUser("admin-id", "cheater", "copied solutions", time.Now().Add(time.Hour))
+			require.NoError(t, err)
+
+			ban, err := s.users.GetActiveBan("cheater-id")
+			require.NoError(t, err)
+			assert.Equal(t, "copied solutions", ban.Reason)
+			assert.Equal(t, "admin", ban.IssuedByName)
+			assert.False(t, ban.ExpiresAt.IsZero())
+
+			_, err = s.users.SubmitBanAppeal("spammer-id", "my account was hacked")
+			require.NoError(t, err)
+			// Stored times keep milliseconds, so the appeals must be apart for their order to show
+			time.Sleep(2 * time.Millisecond)
+			_, err = s.users.SubmitBanAppeal("cheater-id", "I wrote them myself")
+			require.NoError(t, err)
+			_, err = s.users.SubmitBanAppeal("cheater-id", "really")
+			assert.ErrorIs(t, err, services.ErrAppealExists)
+
+			// Oldest appeal first
+			pending, err := s.users.GetBanAppeals("admin-id", models.AppealPending)
+			require.NoError(t, err)
+			require.Len(t, *pending, 2)
+			assert.Equal(t, "spammer", (*pending)[0].Username)
+
+			_, err = s.users.ResolveBanAppeal("admin-id", (*pending)[0].ID, true, "welcome back")
+			require.NoError(t, err)
+			_, err = s.users.ResolveBanAppeal("admin-id", (*pending)[1].ID, false, "")
+			require.NoError(t, err)
+
+			banned, err := s.users.IsUserBanned("spammer-id")
+			require.NoError(t, err)
+			assert.False(t, banned)
+
+			accepted, err := s.users.GetBanAppeals("admin-id", models.AppealAccepted)
+			require.NoError(t, err)
+			require.Len(t, *accepted, 1)
+			assert.Equal(t, "welcome back", (*accepted)[0].Appeal.Response)
+			assert.False(t, (*accepted)[0].LiftedAt.IsZero())
+
+			pending, err = s.users.GetBanAppeals("admin-id", models.AppealPending)
+			require.NoError(t, err)
+			assert.Empty(t, *pending)
+
+			banned, err = s.users.IsUserBanned("cheater-id")
+			require.NoError(t, err)
+			assert.True(t, banned)
+		}},
+		{"expired bans", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "spammer-id", Username: "spammer", Role: roles.USER},
+				models.User{ID: "moderator-id", Username: "moderator", Role: roles.MODERATOR},
+				models.User{ID: "cheater-id", Username: "cheater", Role: roles.USER},
+			)
+			for _, userID := range []string{"spammer-id", "moderator-id"} {
+				require.NoError(t, driver.BanRepository().CreateBan(&models.Ban{
+					ID:        userID + "-ban",
+					UserID:    userID,
+					Reason:    "posting spam",
+					StartedAt: time.Now().Add(-2 * time.Hour),
+					ExpiresAt: time.Now().Add(-time.Hour),
+				}))
+				require.NoError(t, driver.UserRepository().BanUser(userID))
+			}
+
+			// A ban that has run out is lifted the next time anyone looks
+			user, err := s.users.GetUserByID("spammer-id")
+			require.NoError(t, err)
+			assert.False(t, user.StandardUser.IsBanned)
+			lifted, err := driver.BanRepository().FetchBanByID("spammer-id-ban")
+			require.NoError(t, err)
+			assert.True(t, lifted.LiftedAt.Equal(lifted.ExpiresAt))
+			_, err = driver.BanRepository().FetchActiveBan("spammer-id")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+
+			// Including when staff act, without logging in again first
+			_, err = s.users.BanUser("moderator-id", "cheater", "copied solutions", time.Now().Add(time.Hour))
+			require.NoError(t, err)
+			banned, err := s.users.IsUserBanned("moderator-id")
+			require.NoError(t, err)
+			assert.False(t, banned)
+
+			// A ban that has not run out yet stays
+			banned, err = s.users.IsUserBanned("cheater-id")
+			require.NoError(t, err)
+			assert.True(t, banned)
+			ban, err := s.users.GetActiveBan("cheater-id")
+			require.NoError(t, err)
+			assert.True(t, ban.LiftedAt.IsZero())
+		}},
+		{"no bans", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN},
+				models.User{ID: "user-id", Username: "user", Role: roles.USER},
+			)
+
+			pending, err := s.users.GetBanAppeals("admin-id", models.AppealPending)
+			require.NoError(t, err)
+			assert.Empty(t, *pending)
+
+			_, err = s.users.GetActiveBan("user-id")
+			assert.ErrorIs(t, err, services.ErrNoActiveBan)
+			_, err = s.users.SubmitBanAppeal("user-id", "what ban?")
+			assert.ErrorIs(t, err, services.ErrNoActiveBan)
+			_, err = s.users.ResolveBanAppeal("admin-id", "missing", true, "")
+			assert.ErrorIs(t, err, services.ErrBanNotFound)
+
+			_, err = driver.BanRepository().FetchBanByID("missing")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			_, err = driver.BanRepository().FetchActiveBan("user-id")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.ErrorIs(t, driver.BanRepository().UpdateBan(&models.Ban{ID: "missing"}), mongo.ErrNoDocuments)
+		}},
+	})
+}
+
+func TestStorageDrivers_ReviewRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"due cards", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy"},
+				models.Question{QuestionID: "2", QuestionTitle: "Add Two Numbers", Difficulty: "medium"},
+			)
+
+			// Nothing is due right after solving
+			_, err := s.reviews.RecordReview("user-id", "1", models.RecallGood)
+			require.NoError(t, err)
+			due, err := s.reviews.GetDueReviews("user-id")
+			require.NoError(t, err)
+			assert.Empty(t, *due)
+
+			// Cards due in the past show up, oldest first, and only for their owner
+			overdue := &models.ReviewCard{UserID: "user-id", QuestionID: "2", EaseFactor: 2.5, DueAt: time.Now().AddDate(0, 0, -3).UTC()}
+			require.NoError(t, driver.ReviewRepository().UpsertReviewCard(overdue))
+			require.NoError(t, driver.ReviewRepository().UpsertReviewCard(&models.ReviewCard{UserID: "other-user", QuestionID: "2", DueAt: time.Now().AddDate(0, 0, -5).UTC()}))
+
+			due, err = s.reviews.GetDueReviews("user-id")
+			require.NoError(t, err)
+			require.Len(t, *due, 1)
+			assert.Equal(t, "2", (*due)[0].QuestionID)
+
+			// Reviewing it replaces the card rather than adding another
+			card, err := s.reviews.RecordReview("user-id", "2", models.RecallHard)
+			require.NoError(t, err)
+			assert.Equal(t, 1, card.Repetitions)
+
+			due, err = s.reviews.GetDueReviews("user-id")
+			require.NoError(t, err)
+			assert.Empty(t, *due)
+		}},
+		{"no cards", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+
+			due, err := s.reviews.GetDueReviews("user-id")
+			require.NoError(t, err)
+			assert.Empty(t, *due)
+
+			_, err = driver.ReviewRepository().FetchReviewCard("user-id", "1")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			_, err = s.reviews.RecordReview("user-id", "1", models.RecallGood)
+			assert.EqualError(t, err, "question with ID 1 does not exist")
+		}},
+	})
+}
+
+func TestStorageDrivers_StudyListRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"sharing and editing", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy"},
+				models.Question{QuestionID: "2", QuestionTitle: "Add Two Numbers", Difficulty: "medium"},
+				models.Question{QuestionID: "3", QuestionTitle: "Longest Substring", Difficulty: "medium"},
+			)
+			require.NoError(t, driver.UserRepository().CreateUser(&models.StandardUser{
+				StandardUser: models.User{ID: "owner-id", Username: "owner", Role: roles.USER}, QuestionsSolved: []string{"2"},
+			}))
+			createUsers(t, driver, models.User{ID: "other-id", Username: "other", Role: roles.USER})
+
+			list, err := s.studyLists.CreateStudyList("owner-id", "Blind 75", "classics", false)
+			require.NoError(t, err)
+			_, err = s.studyLists.CreateStudyList("owner-id", "blind 75", "", false)
+			assert.Equal(t, services.ErrDuplicateStudyList, err)
+
+			require.NoError(t, s.studyLists.AddQuestionToList("owner-id", list.ID, "1", 0))
+			require.NoError(t, s.studyLists.AddQuestionToList("owner-id", list.ID, "3", 0))
+			require.NoError(t, s.studyLists.AddQuestionToList("owner-id", list.ID, "2", 1))
+
+			progress, err := s.studyLists.GetStudyListProgress("owner-id", list.ID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"2", "1", "3"}, progress.List.QuestionIDs)
+			assert.Equal(t, 1, progress.Solved)
+			assert.Equal(t, 3, progress.Total)
+			assert.True(t, progress.Items[0].Solved)
+
+			// Private lists are hidden from everyone else until shared
+			_, err = s.studyLists.GetStudyListProgress("other-id", list.ID)
+			assert.Equal(t, services.ErrStudyListNotFound, err)
+			lists, err := s.studyLists.GetStudyLists("other-id")
+			require.NoError(t, err)
+			assert.Empty(t, *lists)
+
+			require.NoError(t, s.studyLists.SetStudyListShared("owner-id", list.ID, true))
+			lists, err = s.studyLists.GetStudyLists("other-id")
+			require.NoError(t, err)
+			require.Len(t, *lists, 1)
+			assert.Equal(t, "owner", (*lists)[0].OwnerName)
+
+			assert.Equal(t, services.ErrStudyListForbidden, s.studyLists.RemoveQuestionFromList("other-id", list.ID, "1"))
+
+			require.NoError(t, s.studyLists.MoveQuestionInList("owner-id", list.ID, "3", 1))
+			require.NoError(t, s.studyLists.RemoveQuestionFromList("owner-id", list.ID, "2"))
+			progress, err = s.studyLists.GetStudyListProgress("other-id", list.ID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{"3", "1"}, progress.List.QuestionIDs)
+			assert.Equal(t, 0, progress.Solved)
+			assert.False(t, progress.CanEdit)
+
+			require.NoError(t, s.studyLists.DeleteStudyList("owner-id", list.ID))
+			_, err = s.studyLists.GetStudyListProgress("owner-id", list.ID)
+			assert.Equal(t, services.ErrStudyListNotFound, err)
+		}},
+		{"name ties", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "alice-id", Username: "alice", Role: roles.USER},
+				models.User{ID: "bob-id", Username: "bob", Role: roles.USER},
+				models.User{ID: "carol-id", Username: "carol", Role: roles.USER},
+			)
+
+			// Lists sort by name, lists with the same name by when they were made
+			for _, list := range []struct{ ownerID, name string }{
+				{"bob-id", "Blind 75"},
+				{"alice-id", "Blind 75"},
+				{"bob-id", "Arrays"},
+			} {
+				_, err := s.studyLists.CreateStudyList(list.ownerID, list.name, "", true)
+				require.NoError(t, err)
+				// Stored times keep milliseconds, so the lists must be apart for their order to show
+				time.Sleep(2 * time.Millisecond)
+			}
+
+			lists, err := s.studyLists.GetStudyLists("carol-id")
+			require.NoError(t, err)
+			require.Len(t, *lists, 3)
+			owners := []string{}
+			for _, list := range *lists {
+				owners = append(owners, list.Name+" by "+list.OwnerName)
+			}
+			assert.Equal(t, []string{"Arrays by bob", "Blind 75 by bob", "Blind 75 by alice"}, owners)
+		}},
+		{"missing lists", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver, models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy"})
+			createUsers(t, driver, models.User{ID: "owner-id", Username: "owner", Role: roles.USER})
+
+			lists, err := s.studyLists.GetStudyLists("owner-id")
+			require.NoError(t, err)
+			assert.Empty(t, *lists)
+
+			_, err = s.studyLists.GetStudyListProgress("owner-id", "missing")
+			assert.Equal(t, services.ErrStudyListNotFound, err)
+			assert.Equal(t, services.ErrStudyListNotFound, s.studyLists.AddQuestionToList("owner-id", "missing", "1", 0))
+			assert.Equal(t, services.ErrStudyListNotFound, s.studyLists.DeleteStudyList("owner-id", "missing"))
+
+			_, err = driver.StudyListRepository().FetchStudyListByID("missing")
+			assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+			assert.ErrorIs(t, driver.StudyListRepository().UpdateStudyList(&models.StudyList{ID: "missing"}), mongo.ErrNoDocuments)
+			assert.ErrorIs(t, driver.StudyListRepository().DeleteStudyList("missing"), mongo.ErrNoDocuments)
+		}},
+	})
+}
+
+func TestStorageDrivers_NoteRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"saving and searching", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy"},
+				models.Question{QuestionID: "2", QuestionTitle: "Add Two Numbers", Difficulty: "medium"},
+			)
+
+			first, err := s.notes.SaveNote("user-id", "1", "Hash map of complements, O(n)")
+			require.NoError(t, err)
+			_, err = s.notes.SaveNote("user-id", "2", "Carry the digit\n```go\ncarry := sum / 10\n```")
+			require.NoError(t, err)
+			_, err = s.notes.SaveNote("other-id", "1", "Sort and use two pointers")
+			require.NoError(t, err)
+
+			// Saving again replaces the body but keeps when the note was started
+			updated, err := s.notes.SaveNote("user-id", "1", "Hash map of complements, O(n) time and space")
+			require.NoError(t, err)
+			note, err := s.notes.GetNote("user-id", "1")
+			require.NoError(t, err)
+			assert.Equal(t, updated.Body, note.Body)
+			assert.WithinDuration(t, first.CreatedAt, note.CreatedAt, time.Millisecond)
+
+			notes, err := s.notes.GetNotes("user-id")
+			require.NoError(t, err)
+			require.Len(t, *notes, 2)
+			assert.Equal(t, "1", (*notes)[0].QuestionID)
+			assert.Equal(t, []models.CodeSnippet{{Language: "go", Code: "carry := sum / 10"}}, (*notes)[1].Snippets)
+
+			matches, err := s.notes.SearchNotes("user-id", "two pointers")
+			require.NoError(t, err)
+			assert.Empty(t, *matches)
+
+			require.NoError(t, s.notes.DeleteNote("user-id", "2"))
+			_, err = s.notes.GetNote("user-id", "2")
+			assert.Equal(t, services.ErrNoteNotFound, err)
+		}},
+		{"no notes", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver, models.Question{QuestionID: "1", QuestionTitle: "Two Sum", Difficulty: "easy"})
+
+			notes, err := s.notes.GetNotes("user-id")
+			require.NoError(t, err)
+			assert.Empty(t, *notes)
+
+			matches, err := s.notes.SearchNotes("user-id", "hash map")
+			require.NoError(t, err)
+			assert.Empty(t, *matches)
+
+			_, err = s.notes.GetNote("user-id", "1")
+			assert.Equal(t, services.ErrNoteNotFound, err)
+			assert.Equal(t, services.ErrNoteNotFound, s.notes.DeleteNote("user-id", "1"))
+		}},
+	})
+}
+
+func TestStorageDrivers_AnnouncementRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"audiences and read receipts", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN},
+				models.User{ID: "user-id", Username: "user", Role: roles.USER, Organisation: "watchguard", Country: "india"},
+			)
+
+			general, err := s.announcements.CreateAnnouncement("admin-id", models.AnnouncementDraft{Title: "Welcome", Body: "Hello everyone"})
+			require.NoError(t, err)
+			pinned, err := s.announcements.CreateAnnouncement("admin-id", models.AnnouncementDraft{Title: "Maintenance", Body: "Down on Sunday", Pinned: true})
+			require.NoError(t, err)
+			france, err := s.announcements.CreateAnnouncement("admin-id", models.AnnouncementDraft{Title: "France", Body: "Bonjour", Audience: "country", Target: "france"})
+			require.NoError(t, err)
+
+			// Pinned announcements come first
+			unread, err := s.announcements.GetUnreadAnnouncements("user-id")
+			require.NoError(t, err)
+			require.Len(t, *unread, 2)
+			assert.Equal(t, pinned.ID, (*unread)[0].ID)
+			assert.Equal(t, general.ID, (*unread)[1].ID)
+
+			// Reading twice keeps one receipt
+			require.NoError(t, s.announcements.MarkAnnouncementRead("user-id", general.ID))
+			require.NoError(t, s.announcements.MarkAnnouncementRead("user-id", general.ID))
+			assert.Equal(t, services.ErrAnnouncementNotFound, s.announcements.MarkAnnouncementRead("user-id", france.ID))
+
+			// Edits keep the receipts
+			_, err = s.announcements.UpdateAnnouncement("admin-id", general.ID, models.AnnouncementDraft{Title: "Welcome!", Body: "Hello everyone"})
+			require.NoError(t, err)
+			stored, err := s.announcements.GetAnnouncement(general.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "Welcome!", stored.Title)
+			require.Len(t, stored.Reads, 1)
+			assert.Equal(t, "user", stored.Reads[0].Username)
+
+			require.NoError(t, s.announcements.ExpireAnnouncement("admin-id", pinned.ID))
+			announcements, err := s.announcements.GetUserAnnouncements("user-id")
+			require.NoError(t, err)
+			require.Len(t, *announcements, 1)
+			assert.True(t, (*announcements)[0].Read)
+
+			all, err := s.announcements.GetAllAnnouncements()
+			require.NoError(t, err)
+			assert.Len(t, *all, 3)
+		}},
+		{"pinned ties", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver, models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN})
+
+			// Among announcements pinned alike the newest comes first
+			titles := []string{"Older pin", "Older", "Newer pin", "Newer"}
+			for i, title := range titles {
+				_, err := s.announcements.CreateAnnouncement("admin-id", models.AnnouncementDraft{Title: title, Body: "Hello", Pinned: i%2 == 0})
+				require.NoError(t, err)
+				// Stored times keep milliseconds, so the announcements must be apart for their order to show
+				time.Sleep(2 * time.Millisecond)
+			}
+
+			all, err := s.announcements.GetAllAnnouncements()
+			require.NoError(t, err)
+			got := []string{}
+			for _, announcement := range *all {
+				got = append(got, announcement.Title)
+			}
+			assert.Equal(t, []string{"Newer pin", "Older pin", "Newer", "Older"}, got)
+		}},
+		{"missing announcements", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN},
+				models.User{ID: "user-id", Username: "user", Role: roles.USER},
+			)
+
+			all, err := s.announcements.GetAllAnnouncements()
+			require.NoError(t, err)
+			assert.Empty(t, *all)
+			unread, err := s.announcements.GetUnreadAnnouncements("user-id")
+			require.NoError(t, err)
+			assert.Empty(t, *unread)
+
+			_, err = s.announcements.GetAnnouncement("missing")
+			assert.Equal(t, services.ErrAnnouncementNotFound, err)
+			_, err = s.announcements.UpdateAnnouncement("admin-id", "missing", models.AnnouncementDraft{Title: "Welcome", Body: "Hello"})
+			assert.ErrorIs(t, err, services.ErrAnnouncementNotFound)
+			assert.ErrorIs(t, s.announcements.ExpireAnnouncement("admin-id", "missing"), services.ErrAnnouncementNotFound)
+			assert.Equal(t, services.ErrAnnouncementNotFound, s.announcements.MarkAnnouncementRead("user-id", "missing"))
+			assert.ErrorIs(t, driver.AnnouncementRepository().AddReadReceipt("missing", models.ReadReceipt{UserID: "user-id"}), mongo.ErrNoDocuments)
+		}},
+	})
+}
+
+func TestStorageDrivers_LeaderboardRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"scopes", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "1", Difficulty: "easy"},
+				models.Question{QuestionID: "2", Difficulty: "medium"},
+				models.Question{QuestionID: "3", Difficulty: "hard"},
+			)
+			users := []models.StandardUser{
+				{StandardUser: models.User{ID: "u1", Username: "alice", Role: roles.USER, Organisation: "Acme", Country: "India"}, QuestionsSolved: []string{"1", "2"}},
+				{StandardUser: models.User{ID: "u2", Username: "bob", Role: roles.USER, Organisation: "Globex", Country: "India"}, QuestionsSolved: []string{"3", "99"}},
+				{StandardUser: models.User{ID: "u3", Username: "carol", Role: roles.USER, Organisation: "Acme", Country: "Japan", IsBanned: true}, QuestionsSolved: []string{"3"}},
+				{StandardUser: models.User{ID: "u4", Username: "root", Role: roles.ADMIN}, QuestionsSolved: []string{"1"}},
+				{StandardUser: models.User{ID: "u5", Username: "dave", Role: roles.MODERATOR, Organisation: "Initech", Country: "Japan"}, QuestionsSolved: []string{"1"}},
+			}
+			for i := range users {
+				require.NoError(t, driver.UserRepository().CreateUser(&users[i]))
+			}
+
+			// Staff who practise are ranked, admins and banned users are not
+			boards := []struct {
+				name       string
+				scope      string
+				scopeValue string
+				want       []string
+			}{
+				{"global", models.GlobalScope, "", []string{"bob", "alice", "dave"}},
+				{"organisation", models.OrganisationScope, "acme", []string{"alice"}},
+				{"country", models.CountryScope, "japan", []string{"dave"}},
+				{"nobody there", models.CountryScope, "france", []string{}},
+			}
+			for _, tt := range boards {
+				board, err := s.leaderboard.GetLeaderboard(tt.scope, tt.scopeValue)
+				require.NoError(t, err, tt.name)
+				usernames := []string{}
+				for _, entry := range board.Entries {
+					usernames = append(usernames, entry.Username)
+				}
+				assert.Equal(t, tt.want, usernames, tt.name)
+			}
+
+			board, err := s.leaderboard.GetLeaderboard(models.GlobalScope, "")
+			require.NoError(t, err)
+			require.Len(t, board.Entries, 3)
+			assert.Equal(t, 1, board.Entries[0].HardSolved)
+			assert.Equal(t, 1, board.Entries[1].EasySolved)
+			assert.Equal(t, 1, board.Entries[1].MediumSolved)
+			assert.Equal(t, 3, board.Entries[2].Rank)
+		}},
+		{"score ties", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			addQuestions(t, driver,
+				models.Question{QuestionID: "1", Difficulty: "easy"},
+				models.Question{QuestionID: "2", Difficulty: "medium"},
+			)
+			for _, user := range []models.StandardUser{
+				{StandardUser: models.User{ID: "u1", Username: "frank", Role: roles.USER}, QuestionsSolved: []string{"1"}},
+				{StandardUser: models.User{ID: "u2", Username: "erin", Role: roles.USER}, QuestionsSolved: []string{"1"}},
+				{StandardUser: models.User{ID: "u3", Username: "gina", Role: roles.USER}, QuestionsSolved: []string{"2"}},
+			} {
+				require.NoError(t, driver.UserRepository().CreateUser(&user))
+			}
+
+			// Equal scores share a rank and are listed by username
+			board, err := s.leaderboard.GetLeaderboard(models.GlobalScope, "")
+			require.NoError(t, err)
+			require.Len(t, board.Entries, 3)
+			ranks := []string{}
+			for _, entry := range board.Entries {
+				ranks = append(ranks, entry.Username+" "+strconv.Itoa(entry.Rank))
+			}
+			assert.Equal(t, []string{"gina 1", "erin 2", "frank 2"}, ranks)
+		}},
+		{"empty board", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+
+			board, err := s.leaderboard.GetLeaderboard(models.GlobalScope, "")
+			require.NoError(t, err)
+			assert.Empty(t, board.Entries)
+		}},
+	})
+}
+
+func TestStorageDrivers_AuditRepository(t *testing.T) {
+	runStorageTests(t, []storageTest{
+		{"filters and export", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver,
+				models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN},
+				models.User{ID: "user-id", Username: "someone", Role: roles.USER},
+			)
+			addQuestions(t, driver, models.Question{QuestionID: "1", QuestionTitle: "two sum", Difficulty: "easy", QuestionLink: "https://leetcode.com/problems/two-sum"})
+
+			start := time.Now().Add(-time.Second)
+			_, err := s.users.BanUser("admin-id", "someone", "spam", time.Time{})
+			require.NoError(t, err)
+			_, err = s.users.UnbanUser("admin-id", "someone")
+			require.NoError(t, err)
+			difficulty := "medium"
+			_, err = s.questions.UpdateQuestion("admin-id", "1", models.QuestionUpdate{Difficulty: &difficulty})
+			require.NoError(t, err)
+			require.NoError(t, s.questions.RemoveQuestionByID("admin-id", "1"))
+
+			// Newest first, with the username kept next to the ID
+			page, err := s.audit.GetAuditLog("admin-id", models.AuditFilter{}, models.PageRequest{PageSize: 3})
+			require.NoError(t, err)
+			assert.Equal(t, int64(4), page.Total)
+			assert.Equal(t, 2, page.TotalPages)
+			require.Len(t, page.Entries, 3)
+			assert.Equal(t, models.AuditQuestionRemove, page.Entries[0].Action)
+			assert.Equal(t, "admin", page.Entries[0].ActorName)
+			assert.Empty(t, page.Entries[0].After)
+			assert.Contains(t, page.Entries[1].Before, `"difficulty":"easy"`)
+			assert.Contains(t, page.Entries[1].After, `"difficulty":"medium"`)
+
+			filters := []struct {
+				name   string
+				filter models.AuditFilter
+				want   []string
+			}{
+				{"action prefix and actor name", models.AuditFilter{Action: "user", Actor: "admin"}, []string{models.AuditUserUnban, models.AuditUserBan}},
+				{"exact action, target and actor ID", models.AuditFilter{Action: "user.ban", Target: "someone", Actor: "admin-id"}, []string{models.AuditUserBan}},
+				{"partial action name", models.AuditFilter{Action: "use"}, []string{}},
+				{"before the first entry", models.AuditFilter{Until: start}, []string{}},
+			}
+			for _, tt := range filters {
+				page, err := s.audit.GetAuditLog("admin-id", tt.filter, models.PageRequest{})
+				require.NoError(t, err, tt.name)
+				actions := []string{}
+				for _, entry := range page.Entries {
+					actions = append(actions, entry.Action)
+				}
+				assert.Equal(t, tt.want, actions, tt.name)
+			}
+
+			page, err = s.audit.GetAuditLog("admin-id", models.AuditFilter{Action: models.AuditUserBan}, models.PageRequest{})
+			require.NoError(t, err)
+			require.Len(t, page.Entries, 1)
+			assert.JSONEq(t, `{"is_banned":false}`, page.Entries[0].Before)
+			assert.Contains(t, page.Entries[0].After, `"reason":"spam"`)
+
+			// Exports list the entries in the order they were recorded
+			var exported bytes.Buffer
+			count, err := s.audit.ExportAuditLog("admin-id", &exported, "json", models.AuditFilter{Since: start})
+			require.NoError(t, err)
+			assert.Equal(t, 4, count)
+			var entries []struct {
+				Action string `json:"action"`
+			}
+			require.NoError(t, json.Unmarshal(exported.Bytes(), &entries))
+			require.Len(t, entries, 4)
+			assert.Equal(t, models.AuditUserBan, entries[0].Action)
+			assert.Equal(t, models.AuditQuestionRemove, entries[3].Action)
+		}},
+		{"empty log", func(t *testing.T, driver interfaces.StorageDriver) {
+			s := newStorageServices(driver)
+			createUsers(t, driver, models.User{ID: "admin-id", Username: "admin", Role: roles.ADMIN})
+
+			page, err := s.audit.GetAuditLog("admin-id", models.AuditFilter{}, models.PageRequest{})
+			require.NoError(t, err)
+			assert.Zero(t, page.Total)
+			assert.Empty(t, page.Entries)
+
+			var exported bytes.Buffer
+			count, err := s.audit.ExportAuditLog("admin-id", &exported, "json", models.AuditFilter{})
+			require.NoError(t, err)
+			assert.Zero(t, count)
+		}},
+	})
+}
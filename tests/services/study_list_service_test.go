@@ -0,0 +1,171 @@
+package service_test
+
+import (
+	"cli-project/internal/app/services"
+	"cli-project/internal/config/roles"
+	"cli-project/internal/domain/models"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"testing"
+)
+
+func studyListUser(id, role string, solved ...string) *models.StandardUser {
+	return &models.StandardUser{StandardUser: models.User{ID: id, Username: id, Role: role}, QuestionsSolved: solved}
+}
+
+func TestStudyListService_CreateStudyList(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(studyListUser("user-id", roles.USER), nil)
+	mockStudyListRepo.EXPECT().FetchVisibleStudyLists("user-id").Return(&[]models.StudyList{
+		{Name: "Blind 75", OwnerID: "someone-else", Shared: true},
+	}, nil)
+	mockStudyListRepo.EXPECT().CreateStudyList(gomock.Any()).Return(nil)
+
+	// Other users' lists may use the same name
+	list, err := studyListService.CreateStudyList("user-id", "  Blind   75 ", " classics ", true)
+	require.NoError(t, err)
+	assert.Equal(t, "Blind 75", list.Name)
+	assert.Equal(t, "classics", list.Description)
+	assert.Equal(t, "user-id", list.OwnerName)
+	assert.True(t, list.Shared)
+	assert.NotEmpty(t, list.ID)
+	assert.Equal(t, []string{}, list.QuestionIDs)
+}
+
+func TestStudyListService_CreateStudyList_InvalidName(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	_, err := studyListService.CreateStudyList("user-id", " x ", "", false)
+	assert.Error(t, err)
+}
+
+func TestStudyListService_AddQuestionToList(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionService.EXPECT().QuestionExists("3").Return(true, nil)
+	mockUserService.EXPECT().GetUserByID("user-id").Return(studyListUser("user-id", roles.USER), nil)
+	mockStudyListRepo.EXPECT().FetchStudyListByID("list-id").Return(&models.StudyList{ID: "list-id", OwnerID: "user-id", QuestionIDs: []string{"1", "2"}}, nil)
+	mockStudyListRepo.EXPECT().UpdateStudyList(gomock.Any()).DoAndReturn(func(list *models.StudyList) error {
+		assert.Equal(t, []string{"1", "3", "2"}, list.QuestionIDs)
+		assert.False(t, list.UpdatedAt.IsZero())
+		return nil
+	})
+
+	assert.NoError(t, studyListService.AddQuestionToList("user-id", "list-id", "3", 2))
+}
+
+func TestStudyListService_AddQuestionToList_Errors(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockQuestionService.EXPECT().QuestionExists(gomock.Any()).Return(true, nil).Times(2)
+	mockUserService.EXPECT().GetUserByID("user-id").Return(studyListUser("user-id", roles.USER), nil).Times(2)
+	mockStudyListRepo.EXPECT().FetchStudyListByID("list-id").Return(&models.StudyList{ID: "list-id", OwnerID: "user-id", QuestionIDs: []string{"1"}}, nil).Times(2)
+
+	assert.Equal(t, services.ErrQuestionAlreadyInList, studyListService.AddQuestionToList("user-id", "list-id", "1", 0))
+	assert.Equal(t, services.ErrInvalidListPosition, studyListService.AddQuestionToList("user-id", "list-id", "2", 5))
+}
+
+func TestStudyListService_Permissions(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// Curating goes through the real access service, so bans are checked as well as roles
+	studyListService := services.NewStudyListService(mockStudyListRepo, mockUserService, mockQuestionService, services.NewAccessService(mockUserRepo, mockBanRepo))
+
+	private := &models.StudyList{ID: "private-id", OwnerID: "owner-id"}
+	shared := &models.StudyList{ID: "shared-id", OwnerID: "owner-id", Shared: true, QuestionIDs: []string{"1"}}
+	mockStudyListRepo.EXPECT().FetchStudyListByID("private-id").Return(private, nil).AnyTimes()
+	mockStudyListRepo.EXPECT().FetchStudyListByID("shared-id").DoAndReturn(func(string) (*models.StudyList, error) {
+		copied := *shared
+		return &copied, nil
+	}).AnyTimes()
+
+	users := []*models.StandardUser{
+		studyListUser("user-id", roles.USER),
+		studyListUser("admin-id", roles.ADMIN),
+		studyListUser("editor-id", roles.CONTENT_EDITOR),
+		studyListUser("moderator-id", roles.MODERATOR),
+		studyListUser("banned-editor-id", roles.CONTENT_EDITOR),
+	}
+	users[4].StandardUser.IsBanned = true
+	for _, user := range users {
+		mockUserService.EXPECT().GetUserByID(user.StandardUser.ID).Return(user, nil).AnyTimes()
+		mockUserRepo.EXPECT().FetchUserByID(user.StandardUser.ID).Return(user, nil).AnyTimes()
+	}
+	mockBanRepo.EXPECT().FetchActiveBan("banned-editor-id").Return(&models.Ban{ID: "ban-id", UserID: "banned-editor-id"}, nil)
+
+	// Other users' private lists look missing, even to admins
+	assert.Equal(t, services.ErrStudyListNotFound, studyListService.SetStudyListShared("user-id", "private-id", true))
+	assert.Equal(t, services.ErrStudyListNotFound, studyListService.DeleteStudyList("admin-id", "private-id"))
+
+	// Shared lists can only be changed by their owner and by roles that curate study lists, unless banned
+	assert.Equal(t, services.ErrStudyListForbidden, studyListService.RemoveQuestionFromList("user-id", "shared-id", "1"))
+	assert.Equal(t, services.ErrStudyListForbidden, studyListService.RemoveQuestionFromList("moderator-id", "shared-id", "1"))
+	assert.Equal(t, services.ErrStudyListForbidden, studyListService.RemoveQuestionFromList("banned-editor-id", "shared-id", "1"))
+	mockStudyListRepo.EXPECT().UpdateStudyList(gomock.Any()).Return(nil).Times(2)
+	assert.NoError(t, studyListService.RemoveQuestionFromList("admin-id", "shared-id", "1"))
+	assert.NoError(t, studyListService.RemoveQuestionFromList("editor-id", "shared-id", "1"))
+}
+
+func TestStudyListService_MoveQuestionInList(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(studyListUser("user-id", roles.USER), nil).Times(2)
+	mockStudyListRepo.EXPECT().FetchStudyListByID("list-id").DoAndReturn(func(string) (*models.StudyList, error) {
+		return &models.StudyList{ID: "list-id", OwnerID: "user-id", QuestionIDs: []string{"1", "2", "3"}}, nil
+	}).Times(2)
+	mockStudyListRepo.EXPECT().UpdateStudyList(gomock.Any()).DoAndReturn(func(list *models.StudyList) error {
+		assert.Equal(t, []string{"2", "3", "1"}, list.QuestionIDs)
+		return nil
+	})
+
+	assert.NoError(t, studyListService.MoveQuestionInList("user-id", "list-id", "1", 3))
+	assert.Equal(t, services.ErrQuestionNotInList, studyListService.MoveQuestionInList("user-id", "list-id", "9", 1))
+}
+
+func TestStudyListService_GetStudyListProgress(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(studyListUser("user-id", roles.USER, "2"), nil)
+	mockStudyListRepo.EXPECT().FetchStudyListByID("list-id").Return(&models.StudyList{
+		ID: "list-id", OwnerID: "owner-id", Shared: true, QuestionIDs: []string{"1", "2", "99"},
+	}, nil)
+	mockAccessService.EXPECT().Authorize("user-id", roles.STUDY_LISTS_CURATE).Return(services.ErrPermissionDenied)
+	mockQuestionService.EXPECT().GetAllQuestions().Return(&[]models.Question{
+		{QuestionID: "1", QuestionTitle: "Two Sum"},
+		{QuestionID: "2", QuestionTitle: "Add Two Numbers"},
+	}, nil)
+
+	progress, err := studyListService.GetStudyListProgress("user-id", "list-id")
+	require.NoError(t, err)
+
+	// Removed questions are listed but not counted
+	assert.Equal(t, 1, progress.Solved)
+	assert.Equal(t, 2, progress.Total)
+	assert.False(t, progress.CanEdit)
+	require.Len(t, progress.Items, 3)
+	assert.Equal(t, "Two Sum", progress.Items[0].Question.QuestionTitle)
+	assert.True(t, progress.Items[1].Solved)
+	assert.True(t, progress.Items[2].Missing)
+	assert.Equal(t, 3, progress.Items[2].Position)
+}
+
+func TestStudyListService_NotFound(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserService.EXPECT().GetUserByID("user-id").Return(studyListUser("user-id", roles.USER), nil)
+	mockStudyListRepo.EXPECT().FetchStudyListByID("missing").Return(nil, mongo.ErrNoDocuments)
+
+	_, err := studyListService.GetStudyListProgress("user-id", "missing")
+	assert.Equal(t, services.ErrStudyListNotFound, err)
+}
@@ -1,16 +1,22 @@
 package service_test
 
 import (
+	"bytes"
 	"cli-project/internal/app/services"
+	"cli-project/internal/config"
+	"cli-project/internal/config/roles"
 	"cli-project/internal/domain/models"
-	"cli-project/pkg/globals"
 	pwd "cli-project/pkg/utils/password"
 	mocks "cli-project/tests/mocks/repository"
 	mock_services "cli-project/tests/mocks/services"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
 	"testing"
 	"time"
 )
@@ -83,9 +89,13 @@ func TestUserService_Login(t *testing.T) {
 		},
 	}, nil).Times(1)
 
+	session := &models.Session{ID: "session-id", UserID: "user-id", Token: "token"}
+	mockSessionService.EXPECT().CreateSession(gomock.Any()).Return(session, nil).Times(1)
+
 	// Call the actual Login function
-	err = userService.Login(username, password)
+	loggedIn, err := userService.Login(username, password)
 	assert.NoError(t, err)
+	assert.Equal(t, session, loggedIn)
 }
 
 func TestUserService_Login_InvalidCredentials(t *testing.T) {
@@ -109,7 +119,8 @@ func TestUserService_Login_InvalidCredentials(t *testing.T) {
 	}, nil).Times(1)
 
 	// Call the actual Login function with the wrong password
-	err = userService.Login(username, wrongPassword)
+	session, err := userService.Login(username, wrongPassword)
+	assert.Nil(t, session)
 	assert.Error(t, err)
 	assert.Equal(t, services.ErrInvalidCredentials, err)
 }
@@ -120,19 +131,65 @@ func TestUserService_UpdateUserProgress(t *testing.T) {
 
 	solvedQuestionID := "123"
 
-	mockUserRepo.EXPECT().FetchUserByID(gomock.Any()).Return(&models.StandardUser{
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
 		QuestionsSolved: []string{},
 	}, nil).Times(1)
 
 	mockQuestionService.EXPECT().QuestionExists(solvedQuestionID).Return(true, nil).Times(1)
 
-	mockUserRepo.EXPECT().UpdateUserProgress(solvedQuestionID).Return(nil).Times(1)
+	mockUserRepo.EXPECT().UpdateUserProgress("user-id", gomock.Any()).DoAndReturn(func(userID string, event *models.SolveEvent) error {
+		assert.Equal(t, solvedQuestionID, event.QuestionID)
+		assert.Equal(t, "python", event.Language)
+		assert.False(t, event.SolvedAt.IsZero())
+		return nil
+	}).Times(1)
 
-	updated, err := userService.UpdateUserProgress(solvedQuestionID)
+	updated, err := userService.UpdateUserProgress("user-id", models.SolveEvent{QuestionID: solvedQuestionID, Language: " Python "})
 	assert.NoError(t, err)
 	assert.True(t, updated)
 }
 
+func TestUserService_UpdateUserProgress_Revisit(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		QuestionsSolved: []string{"123"},
+	}, nil).Times(1)
+	mockQuestionService.EXPECT().QuestionExists("123").Return(true, nil).Times(1)
+
+	// Revisits are still recorded as events
+	mockUserRepo.EXPECT().UpdateUserProgress("user-id", gomock.Any()).Return(nil).Times(1)
+
+	updated, err := userService.UpdateUserProgress("user-id", models.SolveEvent{QuestionID: "123"})
+	assert.NoError(t, err)
+	assert.False(t, updated)
+}
+
+func TestUserService_GetDailyActivity(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	now := time.Now()
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		SolveHistory: []models.SolveEvent{
+			{QuestionID: "1"}, // migrated, no timestamp
+			{QuestionID: "2", SolvedAt: now.AddDate(0, 0, -2)},
+			{QuestionID: "3", SolvedAt: now},
+			{QuestionID: "4", SolvedAt: now},
+			{QuestionID: "5", SolvedAt: now.AddDate(0, 0, -30)}, // outside the window
+		},
+	}, nil).Times(1)
+
+	activity, err := userService.GetDailyActivity("user-id", 3)
+	assert.NoError(t, err)
+	assert.Len(t, *activity, 3)
+	assert.Equal(t, 1, (*activity)[0].Solves)
+	assert.Equal(t, 0, (*activity)[1].Solves)
+	assert.Equal(t, 2, (*activity)[2].Solves)
+	assert.Equal(t, []string{"3", "4"}, (*activity)[2].Questions)
+}
+
 func TestUserService_UpdateUserProgress_QuestionNotExist(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
@@ -145,7 +202,7 @@ func TestUserService_UpdateUserProgress_QuestionNotExist(t *testing.T) {
 
 	mockQuestionService.EXPECT().QuestionExists(solvedQuestionID).Return(false, nil).Times(1)
 
-	updated, err := userService.UpdateUserProgress(solvedQuestionID)
+	updated, err := userService.UpdateUserProgress("user-id", models.SolveEvent{QuestionID: solvedQuestionID})
 	assert.Error(t, err)
 	assert.False(t, updated)
 }
@@ -224,9 +281,10 @@ func TestUserService_Logout(t *testing.T) {
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
 	mockLeetcodeAPI := mock_services.NewMockLeetcodeAPI(ctrl)
 	mockQuestionService := mock_services.NewMockQuestionService(ctrl)
+	mockSessionService := mock_services.NewMockSessionService(ctrl)
 
 	// Create the UserService instance with mocks
-	userService := services.NewUserService(mockUserRepo, mockQuestionService, mockLeetcodeAPI)
+	userService := services.NewUserService(mockUserRepo, nil, mockQuestionService, mockSessionService, mockLeetcodeAPI, nil, nil)
 
 	hashedPassword, err := pwd.HashPassword("password123")
 
@@ -245,24 +303,57 @@ func TestUserService_Logout(t *testing.T) {
 		LastSeen:        time.Time{},
 	}
 
-	// Set the active user ID globally
-	globals.ActiveUserID = "user-id"
+	session := &models.Session{ID: "session-id", UserID: "user-id"}
 
 	// Set up expectations for the mock methods
 	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(standardUser, nil)
 	mockUserRepo.EXPECT().UpdateUserDetails(gomock.Any()).Return(nil).Times(1)
+	mockSessionService.EXPECT().RevokeSession(session).Return(nil).Times(1)
 
 	// Call the Logout method
-	err = userService.Logout()
+	err = userService.Logout(session)
 
 	// Assert that no errors occurred
 	assert.NoError(t, err)
 
 	// Assert that the LastSeen field was updated
 	assert.NotEqual(t, time.Time{}, standardUser.LastSeen)
+}
+
+func TestUserService_Logout_NoSession(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
 
-	// Assert that the ActiveUserID was cleared
-	assert.Equal(t, "", globals.ActiveUserID)
+	err := userService.Logout(nil)
+	assert.Equal(t, services.ErrInvalidSession, err)
+}
+
+func TestUserService_ResumeSession(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	session := &models.Session{ID: "session-id", UserID: "user-id", Token: "token"}
+	user := &models.StandardUser{StandardUser: models.User{ID: "user-id"}}
+
+	mockSessionService.EXPECT().ValidateSession("token").Return(session, nil).Times(1)
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(user, nil).Times(1)
+	mockUserRepo.EXPECT().UpdateUserDetails(user).Return(nil).Times(1)
+
+	resumed, err := userService.ResumeSession("token")
+	assert.NoError(t, err)
+	assert.Equal(t, session, resumed)
+	assert.False(t, user.LastSeen.IsZero())
+}
+
+func TestUserService_ResumeSession_Invalid(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockSessionService.EXPECT().ValidateSession("token").Return(nil, services.ErrSessionRevoked).Times(1)
+
+	session, err := userService.ResumeSession("token")
+	assert.Nil(t, session)
+	assert.Equal(t, services.ErrSessionRevoked, err)
 }
 
 func TestUserService_GetUserByID(t *testing.T) {
@@ -270,7 +361,7 @@ func TestUserService_GetUserByID(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	userService := services.NewUserService(mockUserRepo, nil, nil)
+	userService := services.NewUserService(mockUserRepo, nil, nil, nil, nil, nil, nil)
 
 	userID := "user-id"
 
@@ -304,7 +395,7 @@ func TestUserService_GetUserRole(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	userService := services.NewUserService(mockUserRepo, nil, nil)
+	userService := services.NewUserService(mockUserRepo, nil, nil, nil, nil, nil, nil)
 
 	userID := "user-id"
 	role := "user"
@@ -338,41 +429,83 @@ func TestUserService_BanUser(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	userService := services.NewUserService(mockUserRepo, nil, nil)
+	mockBanRepo := mocks.NewMockBanRepository(ctrl)
+	mockAuditService := mock_services.NewMockAuditService(ctrl)
+	mockAccessService := mock_services.NewMockAccessService(ctrl)
+	userService := services.NewUserService(mockUserRepo, mockBanRepo, nil, nil, nil, mockAuditService, mockAccessService)
 
 	username := "testuser"
 	userID := "user-id"
 
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil).Times(1)
 	mockUserRepo.EXPECT().FetchUserByUsername(username).Return(&models.StandardUser{
-		StandardUser: models.User{
-			ID: userID,
-		},
-	}, nil).Times(1)
-
-	mockUserRepo.EXPECT().FetchUserByID(userID).Return(&models.StandardUser{
 		StandardUser: models.User{
 			ID:       userID,
+			Username: username,
+			Role:     roles.USER,
 			IsBanned: false,
 		},
 	}, nil).Times(1)
+	mockUserRepo.EXPECT().FetchUserByID("admin-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "admin-id", Username: "admin"},
+	}, nil).Times(1)
+	expiresAt := time.Now().Add(24 * time.Hour)
+	mockBanRepo.EXPECT().CreateBan(gomock.Any()).DoAndReturn(func(ban *models.Ban) error {
+		assert.Equal(t, userID, ban.UserID)
+		assert.Equal(t, username, ban.Username)
+		assert.Equal(t, "spamming the leaderboard", ban.Reason)
+		assert.Equal(t, "admin", ban.IssuedByName)
+		assert.True(t, ban.ExpiresAt.Equal(expiresAt))
+		return nil
+	}).Times(1)
 	mockUserRepo.EXPECT().BanUser(userID).Return(nil).Times(1)
+	mockAuditService.EXPECT().Record("admin-id", models.AuditUserBan, username, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-	banned, err := userService.BanUser(username)
+	banned, err := userService.BanUser("admin-id", username, " spamming the leaderboard ", expiresAt)
 
 	assert.NoError(t, err)
 	assert.False(t, banned)
 }
 
+func TestUserService_BanUser_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		reason    string
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{"no reason", "  ", time.Time{}, services.ErrBanReasonRequired},
+		{"reason too long", strings.Repeat("a", config.BAN_REASON_MAX_LENGTH+1), time.Time{}, services.ErrBanReasonTooLong},
+		{"expiry in the past", "spam", time.Now().Add(-time.Hour), services.ErrBanExpiryInPast},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+			mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil)
+
+			_, err := userService.BanUser("admin-id", "testuser", tt.reason, tt.expiresAt)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
 func TestUserService_UnbanUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	userService := services.NewUserService(mockUserRepo, nil, nil)
+	mockBanRepo := mocks.NewMockBanRepository(ctrl)
+	mockAuditService := mock_services.NewMockAuditService(ctrl)
+	mockAccessService := mock_services.NewMockAccessService(ctrl)
+	userService := services.NewUserService(mockUserRepo, mockBanRepo, nil, nil, nil, mockAuditService, mockAccessService)
 
 	username := "testuser"
 	userID := "awe1231"
 
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil).Times(1)
 	mockUserRepo.EXPECT().FetchUserByUsername(username).Return(&models.StandardUser{
 		StandardUser: models.User{
 			ID: userID,
@@ -384,14 +517,36 @@ func TestUserService_UnbanUser(t *testing.T) {
 			IsBanned: true,
 		},
 	}, nil).Times(1)
+	ban := &models.Ban{ID: "ban-id", UserID: userID, Reason: "spam", StartedAt: time.Now().Add(-time.Hour)}
+	mockBanRepo.EXPECT().FetchActiveBan(userID).Return(ban, nil).Times(2)
+	mockBanRepo.EXPECT().UpdateBan(gomock.Any()).DoAndReturn(func(ban *models.Ban) error {
+		assert.Equal(t, "admin-id", ban.LiftedBy)
+		assert.False(t, ban.LiftedAt.IsZero())
+		return nil
+	}).Times(1)
 	mockUserRepo.EXPECT().UnbanUser(userID).Return(nil).Times(1)
+	mockAuditService.EXPECT().Record("admin-id", models.AuditUserUnban, username, gomock.Any(), gomock.Any()).Return(nil).Times(1)
 
-	unbanned, err := userService.UnbanUser(username)
+	unbanned, err := userService.UnbanUser("admin-id", username)
 
 	assert.NoError(t, err)
 	assert.False(t, unbanned)
 }
 
+func TestUserService_UnbanUser_UnknownUser(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil)
+	mockUserRepo.EXPECT().FetchUserByUsername("nobody").Return(nil, mongo.ErrNoDocuments)
+
+	// The menu and the REST API both report a missing user by matching mongo.ErrNoDocuments
+	unbanned, err := userService.UnbanUser("admin-id", "nobody")
+
+	assert.ErrorIs(t, err, mongo.ErrNoDocuments)
+	assert.False(t, unbanned)
+}
+
 func TestUserService_GetAllUsers(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
@@ -412,12 +567,30 @@ func TestUserService_GetAllUsers(t *testing.T) {
 	assert.Equal(t, 2, len(*users))
 }
 
+func TestUserService_GetUsersPage(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUsersPage(roles.USER, models.PageRequest{Page: 2, PageSize: 10, SortBy: models.SortByLastSeen}).Return(&models.UserPage{
+		Users:    []models.StandardUser{{StandardUser: models.User{Username: "user1"}}},
+		PageInfo: models.PageInfo{Page: 2, PageSize: 10, Total: 11, TotalPages: 2},
+	}, nil)
+
+	page, err := userService.GetUsersPage(roles.USER, models.PageRequest{Page: 2, PageSize: 10, SortBy: "last-seen"})
+
+	assert.NoError(t, err)
+	assert.Len(t, page.Users, 1)
+
+	_, err = userService.GetUsersPage(roles.USER, models.PageRequest{Page: 1, SortBy: "difficulty"})
+	assert.Error(t, err)
+}
+
 func TestUserService_ViewDashboard(t *testing.T) {
 	teardown := setup(t)
 	defer teardown()
 
 	// Placeholder test as ViewDashboard has no implementation
-	err := userService.ViewDashboard()
+	err := userService.ViewDashboard("user-id")
 
 	// Assert no errors (since it's a placeholder)
 	assert.NoError(t, err)
@@ -428,10 +601,11 @@ func TestUserService_CountActiveUserInLast24Hours(t *testing.T) {
 	defer teardown()
 
 	// Mock the expected response
+	mockAccessService.EXPECT().Authorize("admin-id", roles.STATS_VIEW).Return(nil).Times(1)
 	mockUserRepo.EXPECT().CountActiveUsersInLast24Hours().Return(int64(5), nil).Times(1)
 
 	// Call the CountActiveUserInLast24Hours method
-	count, err := userService.CountActiveUserInLast24Hours()
+	count, err := userService.CountActiveUserInLast24Hours("admin-id")
 
 	// Assert the results
 	assert.NoError(t, err)
@@ -469,9 +643,11 @@ func TestUserService_IsUserBanned(t *testing.T) {
 	// Mock the expected response
 	mockUserRepo.EXPECT().FetchUserByID(userID).Return(&models.StandardUser{
 		StandardUser: models.User{
+			ID:       userID,
 			IsBanned: true,
 		},
 	}, nil).Times(1)
+	mockBanRepo.EXPECT().FetchActiveBan(userID).Return(&models.Ban{ID: "ban-id", UserID: userID}, nil).Times(1)
 
 	// Call the IsUserBanned method
 	isBanned, err := userService.IsUserBanned(userID)
@@ -505,7 +681,7 @@ func TestUserService_GetUserByID_Error(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	userService := services.NewUserService(mockUserRepo, nil, nil)
+	userService := services.NewUserService(mockUserRepo, nil, nil, nil, nil, nil, nil)
 
 	userID := "user-id"
 
@@ -543,30 +719,614 @@ func TestUserService_BanUser_Error(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUserRepo := mocks.NewMockUserRepository(ctrl)
-	userService := services.NewUserService(mockUserRepo, nil, nil)
+	mockBanRepo := mocks.NewMockBanRepository(ctrl)
+	mockAuditService := mock_services.NewMockAuditService(ctrl)
+	mockAccessService := mock_services.NewMockAccessService(ctrl)
+	userService := services.NewUserService(mockUserRepo, mockBanRepo, nil, nil, nil, mockAuditService, mockAccessService)
 
 	username := "testuser"
 	userID := "user-id"
 
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil).Times(1)
 	mockUserRepo.EXPECT().FetchUserByUsername(username).Return(&models.StandardUser{
-		StandardUser: models.User{
-			ID: userID,
-		},
-	}, nil).Times(1)
-
-	mockUserRepo.EXPECT().FetchUserByID(userID).Return(&models.StandardUser{
 		StandardUser: models.User{
 			ID:       userID,
 			IsBanned: false,
 		},
 	}, nil).Times(1)
 
+	mockUserRepo.EXPECT().FetchUserByID("admin-id").Return(nil, mongo.ErrNoDocuments).Times(1)
+	mockAuditService.EXPECT().Record("admin-id", models.AuditUserBan, username, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockBanRepo.EXPECT().CreateBan(gomock.Any()).Return(nil).Times(1)
+
 	// Simulate an error while banning the user
 	mockUserRepo.EXPECT().BanUser(userID).Return(errors.New("ban user error")).Times(1)
 
-	banned, err := userService.BanUser(username)
+	banned, err := userService.BanUser("admin-id", username, "spam", time.Time{})
 
 	assert.Error(t, err)
 	assert.False(t, banned)
 	assert.Equal(t, "ban user error", err.Error())
 }
+
+func TestUserService_AuditFailed(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	// The audit entry is written first, so when the audit log cannot be written nothing is changed
+	auditService := services.NewAuditService(mockAuditRepo, mockUserRepo, mockAccessService)
+	userService := services.NewUserService(mockUserRepo, mockBanRepo, nil, nil, nil, auditService, mockAccessService)
+
+	mockAccessService.EXPECT().Authorize("admin-id", gomock.Any()).Return(nil).AnyTimes()
+	mockUserRepo.EXPECT().FetchUserByID("admin-id").Return(&models.StandardUser{StandardUser: models.User{ID: "admin-id", Username: "admin"}}, nil).AnyTimes()
+	mockAuditRepo.EXPECT().AppendAuditEntry(gomock.Any()).Return(errors.New("disk full")).Times(3)
+
+	mockUserRepo.EXPECT().FetchUserByUsername("testuser").Return(&models.StandardUser{StandardUser: models.User{ID: "user-id", Username: "testuser", Role: roles.USER}}, nil).Times(2)
+	_, err := userService.BanUser("admin-id", "testuser", "spam", time.Time{})
+	assert.ErrorIs(t, err, services.ErrAuditFailed)
+	_, err = userService.ChangeUserRole("admin-id", "testuser", roles.MODERATOR)
+	assert.ErrorIs(t, err, services.ErrAuditFailed)
+
+	mockBanRepo.EXPECT().FetchBanByID("ban-id").Return(&models.Ban{
+		ID: "ban-id", UserID: "user-id", Username: "testuser",
+		Appeal: &models.BanAppeal{Message: "sorry", Status: models.AppealPending},
+	}, nil)
+	_, err = userService.ResolveBanAppeal("admin-id", "ban-id", true, "")
+	assert.ErrorIs(t, err, services.ErrAuditFailed)
+
+	// No CreateBan, BanUser, UpdateUserRole, UpdateBan or UnbanUser calls are expected
+}
+
+func TestUserService_GetStreaks(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	now := time.Now()
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		SolveHistory: []models.SolveEvent{
+			{QuestionID: "1"}, // migrated, no timestamp
+			// A four day run that ended a while ago
+			{QuestionID: "2", SolvedAt: now.AddDate(0, 0, -20)},
+			{QuestionID: "3", SolvedAt: now.AddDate(0, 0, -19)},
+			{QuestionID: "4", SolvedAt: now.AddDate(0, 0, -18)},
+			{QuestionID: "5", SolvedAt: now.AddDate(0, 0, -17)},
+			// Two solves on one day still count as one day
+			{QuestionID: "6", SolvedAt: now.AddDate(0, 0, -2)},
+			{QuestionID: "7", SolvedAt: now.AddDate(0, 0, -1)},
+			{QuestionID: "8", SolvedAt: now.AddDate(0, 0, -1)},
+		},
+	}, nil).Times(1)
+
+	streaks, err := userService.GetStreaks("user-id")
+	assert.NoError(t, err)
+
+	// Nothing solved today yet, but yesterday keeps the streak alive
+	assert.Equal(t, 2, streaks.Current)
+	assert.Equal(t, 4, streaks.Longest)
+	assert.Equal(t, now.AddDate(0, 0, -20).Day(), streaks.LongestStart.Day())
+	assert.Equal(t, now.AddDate(0, 0, -17).Day(), streaks.LongestEnd.Day())
+}
+
+func TestUserService_GetStreaks_Broken(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	now := time.Now()
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		SolveHistory: []models.SolveEvent{
+			{QuestionID: "1", SolvedAt: now.AddDate(0, 0, -3)},
+		},
+	}, nil).Times(1)
+
+	streaks, err := userService.GetStreaks("user-id")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, streaks.Current)
+	assert.Equal(t, 1, streaks.Longest)
+}
+
+func TestUserService_GetStreaks_NoHistory(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{}, nil).Times(1)
+
+	streaks, err := userService.GetStreaks("user-id")
+	assert.NoError(t, err)
+	assert.Equal(t, &models.Streaks{}, streaks)
+}
+
+func TestUserService_SyncLeetcodeProgress(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	synced := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	user := &models.StandardUser{
+		LeetcodeID:      "leet",
+		QuestionsSolved: []string{"1"},
+		SolveHistory:    []models.SolveEvent{{QuestionID: "1", SolvedAt: synced}},
+	}
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(user, nil).AnyTimes()
+
+	// Newest first, as Leetcode returns them
+	mockLeetcodeAPI.EXPECT().GetRecentACSubmissions("leet").Return(&[]models.LeetcodeSubmission{
+		{Title: "Pow(x, n)", TitleSlug: "powx-n", Language: "golang", SubmittedAt: synced.Add(3 * time.Hour)},
+		{Title: "Some New Problem", TitleSlug: "some-new-problem", SubmittedAt: synced.Add(2 * time.Hour)},
+		{Title: "Add Two Numbers", TitleSlug: "add-two-numbers", Language: "python3", SubmittedAt: synced.Add(time.Hour)},
+		{Title: "Two Sum", TitleSlug: "two-sum", SubmittedAt: synced},
+	}, nil)
+
+	// The display title of question 2 differs from Leetcode's, only the slug in its link matches
+	mockQuestionService.EXPECT().GetAllQuestions().Return(&[]models.Question{
+		{QuestionID: "1", QuestionTitle: "Two Sum", QuestionLink: "https://leetcode.com/problems/two-sum/"},
+		{QuestionID: "2", QuestionTitle: "Add two numbers (linked lists)", QuestionLink: "https://Leetcode.com/problems/add-two-numbers/description/"},
+		{QuestionID: "50", QuestionTitle: "Pow(x, n)"},
+	}, nil)
+
+	mockQuestionService.EXPECT().QuestionExists(gomock.Any()).Return(true, nil).Times(2)
+	var recorded []models.SolveEvent
+	mockUserRepo.EXPECT().UpdateUserProgress("user-id", gomock.Any()).DoAndReturn(func(userID string, event *models.SolveEvent) error {
+		recorded = append(recorded, *event)
+		return nil
+	}).Times(2)
+
+	report, err := userService.SyncLeetcodeProgress("user-id")
+	require.NoError(t, err)
+
+	// Recorded oldest first with the submission time, the already synced Two Sum is skipped
+	require.Len(t, report.Added, 2)
+	assert.Equal(t, "2", report.Added[0].QuestionID)
+	assert.True(t, report.Added[0].FirstSolve)
+	assert.Equal(t, "50", report.Added[1].QuestionID)
+	assert.Equal(t, 1, report.Skipped)
+	require.Len(t, report.Unmatched, 1)
+	assert.Equal(t, "Some New Problem", report.Unmatched[0].Title)
+
+	require.Len(t, recorded, 2)
+	assert.Equal(t, synced.Add(time.Hour), recorded[0].SolvedAt)
+	assert.Equal(t, "python3", recorded[0].Language)
+}
+
+func TestUserService_SyncLeetcodeProgress_NoLeetcodeID(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{}, nil)
+
+	report, err := userService.SyncLeetcodeProgress("user-id")
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestUserService_ExportProgress(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	solved := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		StandardUser:    models.User{ID: "user-id", Username: "ada", Name: "Ada", Country: "india"},
+		LeetcodeID:      "leet",
+		QuestionsSolved: []string{"1"},
+		SolveHistory:    []models.SolveEvent{{QuestionID: "1", SolvedAt: solved, TimeTaken: 25 * time.Minute, Language: "go"}},
+	}, nil)
+	mockQuestionService.EXPECT().GetAllQuestions().Return(&[]models.Question{
+		{QuestionID: "1", QuestionTitle: "two sum", QuestionLink: "https://leetcode.com/problems/two-sum"},
+	}, nil)
+	// The stats snapshot is optional, an unreachable Leetcode does not fail the export
+	mockLeetcodeAPI.EXPECT().GetStats("leet").Return(nil, errors.New("no network"))
+
+	var out bytes.Buffer
+	require.NoError(t, userService.ExportProgress("user-id", &out))
+
+	var export models.ProgressExport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &export))
+	assert.Equal(t, models.ProgressExportVersion, export.Version)
+	assert.Equal(t, "ada", export.Profile.Username)
+	assert.Equal(t, "leet", export.Profile.LeetcodeID)
+	assert.Nil(t, export.LeetcodeStats)
+	assert.Equal(t, []models.ExportedSolve{{
+		QuestionID:       "1",
+		QuestionTitle:    "two sum",
+		QuestionLink:     "https://leetcode.com/problems/two-sum",
+		SolvedAt:         solved,
+		TimeTakenMinutes: 25,
+		Language:         "go",
+	}}, export.Solves)
+}
+
+func TestUserService_ImportProgress(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	solved := time.Date(2024, 8, 1, 10, 0, 0, 0, time.UTC)
+	export := models.ProgressExport{
+		Version: models.ProgressExportVersion,
+		Profile: models.ExportedProfile{Username: "ada", LeetcodeID: "Leet"},
+		Solves: []models.ExportedSolve{
+			{QuestionID: "2", QuestionTitle: "add two numbers", QuestionLink: "https://leetcode.com/problems/add-two-numbers", SolvedAt: solved.Add(2 * time.Hour), TimeTakenMinutes: 30},
+			{QuestionID: "1", QuestionTitle: "two sum", QuestionLink: "https://leetcode.com/problems/two-sum", SolvedAt: solved},
+			{QuestionID: "1", QuestionTitle: "two sum", QuestionLink: "https://leetcode.com/problems/two-sum", SolvedAt: solved.Add(time.Hour)},
+			{QuestionID: "15", QuestionTitle: "3sum", QuestionLink: "https://leetcode.com/problems/3sum", SolvedAt: solved},
+			{QuestionID: "999", QuestionTitle: "missing", SolvedAt: solved},
+		},
+	}
+	data, err := json.Marshal(export)
+	require.NoError(t, err)
+
+	// Two Sum at the first time is already recorded here
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		LeetcodeID:      "leet",
+		QuestionsSolved: []string{"1"},
+		SolveHistory:    []models.SolveEvent{{QuestionID: "1", SolvedAt: solved}},
+	}, nil)
+	// Question 15 is a different problem in this bank
+	mockQuestionService.EXPECT().GetAllQuestions().Return(&[]models.Question{
+		{QuestionID: "1", QuestionTitle: "two sum", QuestionLink: "https://leetcode.com/problems/two-sum"},
+		{QuestionID: "2", QuestionTitle: "add two numbers", QuestionLink: "https://leetcode.com/problems/add-two-numbers/"},
+		{QuestionID: "15", QuestionTitle: "3sum closest", QuestionLink: "https://leetcode.com/problems/3sum-closest"},
+	}, nil)
+
+	var recorded []models.SolveEvent
+	mockUserRepo.EXPECT().UpdateUserProgress("user-id", gomock.Any()).DoAndReturn(func(userID string, event *models.SolveEvent) error {
+		recorded = append(recorded, *event)
+		return nil
+	}).Times(2)
+
+	report, err := userService.ImportProgress("user-id", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	require.Len(t, report.Added, 2)
+	assert.Equal(t, "1", report.Added[0].QuestionID)
+	assert.False(t, report.Added[0].FirstSolve)
+	assert.Equal(t, "2", report.Added[1].QuestionID)
+	assert.True(t, report.Added[1].FirstSolve)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, []string{"999"}, report.Unmatched)
+	require.Len(t, report.Conflicts, 1)
+	assert.Contains(t, report.Conflicts[0], "question 15")
+
+	require.Len(t, recorded, 2)
+	assert.Equal(t, 30*time.Minute, recorded[1].TimeTaken)
+}
+
+func TestUserService_ImportProgress_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr error
+	}{
+		{"not JSON", "progress", services.ErrInvalidProgressExport},
+		{"newer version", `{"version": 99}`, services.ErrUnsupportedProgressExport},
+		{"another Leetcode account", `{"version": 1, "profile": {"leetcode_id": "someone-else"}}`, services.ErrProgressOwnerMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{LeetcodeID: "leet"}, nil).AnyTimes()
+
+			_, err := userService.ImportProgress("user-id", strings.NewReader(tt.data))
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUserService_IsUserBanned_LiftsExpiredBan(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	expiredAt := time.Now().Add(-time.Minute)
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", IsBanned: true},
+	}, nil)
+	mockBanRepo.EXPECT().FetchActiveBan("user-id").Return(&models.Ban{ID: "ban-id", UserID: "user-id", ExpiresAt: expiredAt}, nil)
+	mockBanRepo.EXPECT().UpdateBan(gomock.Any()).DoAndReturn(func(ban *models.Ban) error {
+		// The ban ends when it expired, not when it was noticed
+		assert.True(t, ban.LiftedAt.Equal(expiredAt))
+		assert.Empty(t, ban.LiftedBy)
+		return nil
+	})
+	mockUserRepo.EXPECT().UnbanUser("user-id").Return(nil)
+
+	banned, err := userService.IsUserBanned("user-id")
+
+	assert.NoError(t, err)
+	assert.False(t, banned)
+}
+
+func TestUserService_IsUserBanned_LegacyBan(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", IsBanned: true},
+	}, nil).Times(2)
+	mockBanRepo.EXPECT().FetchActiveBan("user-id").Return(nil, mongo.ErrNoDocuments).Times(3)
+
+	banned, err := userService.IsUserBanned("user-id")
+	assert.NoError(t, err)
+	assert.True(t, banned)
+
+	_, err = userService.GetActiveBan("user-id")
+	assert.ErrorIs(t, err, services.ErrBanNotFound)
+}
+
+func TestUserService_GetActiveBan_NotBanned(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{StandardUser: models.User{ID: "user-id"}}, nil)
+
+	_, err := userService.GetActiveBan("user-id")
+
+	assert.ErrorIs(t, err, services.ErrNoActiveBan)
+}
+
+func TestUserService_SubmitBanAppeal(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "user-id", IsBanned: true},
+	}, nil)
+	mockBanRepo.EXPECT().FetchActiveBan("user-id").DoAndReturn(func(string) (*models.Ban, error) {
+		return &models.Ban{ID: "ban-id", UserID: "user-id"}, nil
+	}).Times(2)
+	mockBanRepo.EXPECT().UpdateBan(gomock.Any()).Return(nil)
+
+	ban, err := userService.SubmitBanAppeal("user-id", "  it was a misunderstanding  ")
+
+	require.NoError(t, err)
+	require.NotNil(t, ban.Appeal)
+	assert.Equal(t, "it was a misunderstanding", ban.Appeal.Message)
+	assert.Equal(t, models.AppealPending, ban.Appeal.Status)
+}
+
+func TestUserService_SubmitBanAppeal_Rejected(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		appeal  *models.BanAppeal
+		wantErr error
+	}{
+		{"empty", "   ", nil, services.ErrEmptyAppeal},
+		{"too long", strings.Repeat("a", config.APPEAL_MAX_LENGTH+1), nil, services.ErrAppealTooLong},
+		{"second appeal", "please", &models.BanAppeal{Status: models.AppealRejected}, services.ErrAppealExists},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockUserRepo.EXPECT().FetchUserByID("user-id").Return(&models.StandardUser{
+				StandardUser: models.User{ID: "user-id", IsBanned: true},
+			}, nil).AnyTimes()
+			mockBanRepo.EXPECT().FetchActiveBan("user-id").Return(&models.Ban{ID: "ban-id", Appeal: tt.appeal}, nil).AnyTimes()
+
+			_, err := userService.SubmitBanAppeal("user-id", tt.message)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUserService_ResolveBanAppeal(t *testing.T) {
+	tests := []struct {
+		name       string
+		accept     bool
+		wantStatus string
+		wantLifted bool
+	}{
+		{"accepted", true, models.AppealAccepted, true},
+		{"rejected", false, models.AppealRejected, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil)
+			mockBanRepo.EXPECT().FetchBanByID("ban-id").Return(&models.Ban{
+				ID:       "ban-id",
+				UserID:   "user-id",
+				Username: "testuser",
+				Appeal:   &models.BanAppeal{Message: "sorry", Status: models.AppealPending},
+			}, nil)
+			mockUserRepo.EXPECT().FetchUserByID("admin-id").Return(&models.StandardUser{
+				StandardUser: models.User{ID: "admin-id", Username: "admin"},
+			}, nil)
+			mockBanRepo.EXPECT().UpdateBan(gomock.Any()).Return(nil)
+			if tt.wantLifted {
+				mockUserRepo.EXPECT().UnbanUser("user-id").Return(nil)
+			}
+			mockAuditService.EXPECT().Record("admin-id", models.AuditUserAppeal, "testuser", gomock.Any(), gomock.Any()).Return(nil)
+
+			ban, err := userService.ResolveBanAppeal("admin-id", "ban-id", tt.accept, "ok")
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, ban.Appeal.Status)
+			assert.Equal(t, "admin", ban.Appeal.ResolvedByName)
+			assert.Equal(t, tt.wantLifted, !ban.LiftedAt.IsZero())
+		})
+	}
+}
+
+func TestUserService_ResolveBanAppeal_NoPendingAppeal(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_BAN).Return(nil).Times(2)
+	mockBanRepo.EXPECT().FetchBanByID("ban-id").Return(&models.Ban{
+		ID:     "ban-id",
+		Appeal: &models.BanAppeal{Status: models.AppealRejected},
+	}, nil)
+	mockBanRepo.EXPECT().FetchBanByID("missing").Return(nil, mongo.ErrNoDocuments)
+
+	_, err := userService.ResolveBanAppeal("admin-id", "ban-id", true, "")
+	assert.ErrorIs(t, err, services.ErrNoPendingAppeal)
+
+	_, err = userService.ResolveBanAppeal("admin-id", "missing", true, "")
+	assert.ErrorIs(t, err, services.ErrBanNotFound)
+}
+
+func TestUserService_BootstrapAdmin(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	user := models.StandardUser{StandardUser: models.User{Username: "Root", Email: "root@gmail.com", Password: "Password@123"}}
+
+	mockUserRepo.EXPECT().CountUsersByRole(roles.ADMIN).Return(int64(0), nil)
+	mockUserRepo.EXPECT().IsUsernameUnique("root").Return(true, nil)
+	mockUserRepo.EXPECT().IsEmailUnique("root@gmail.com").Return(true, nil)
+	mockUserRepo.EXPECT().CreateUser(gomock.Any()).DoAndReturn(func(user *models.StandardUser) error {
+		assert.Equal(t, roles.ADMIN, user.StandardUser.Role)
+		assert.Equal(t, "root", user.StandardUser.Username)
+		assert.NotEqual(t, "Password@123", user.StandardUser.Password)
+		return nil
+	})
+	mockAuditService.EXPECT().Record(gomock.Any(), models.AuditUserBootstrap, "root", nil, gomock.Any()).Return(nil)
+
+	err := userService.BootstrapAdmin(&user)
+
+	assert.NoError(t, err)
+}
+
+func TestUserService_BootstrapAdmin_Refused(t *testing.T) {
+	tests := []struct {
+		name          string
+		admins        int64
+		usernameTaken bool
+		wantErr       error
+	}{
+		{"admin exists", 1, false, services.ErrAdminExists},
+		{"username taken", 0, true, services.ErrUsernameTaken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockUserRepo.EXPECT().CountUsersByRole(roles.ADMIN).Return(tt.admins, nil)
+			mockUserRepo.EXPECT().IsUsernameUnique("root").Return(!tt.usernameTaken, nil).AnyTimes()
+
+			err := userService.BootstrapAdmin(&models.StandardUser{StandardUser: models.User{Username: "root"}})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUserService_PromoteUser(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_ROLES).Return(nil)
+	mockUserRepo.EXPECT().FetchUserByUsername("someone").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "someone-id", Role: roles.USER},
+	}, nil)
+	mockUserRepo.EXPECT().UpdateUserRole("someone-id", roles.ADMIN).Return(nil)
+	mockAuditService.EXPECT().Record("admin-id", models.AuditUserPromote, "someone", gomock.Any(), gomock.Any()).Return(nil)
+
+	alreadyAdmin, err := userService.PromoteUser("admin-id", "someone")
+
+	assert.NoError(t, err)
+	assert.False(t, alreadyAdmin)
+}
+
+func TestUserService_DemoteUser(t *testing.T) {
+	tests := []struct {
+		name    string
+		admins  int64
+		wantErr error
+	}{
+		{"another admin remains", 2, nil},
+		{"last admin", 1, services.ErrLastAdmin},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_ROLES).Return(nil)
+			mockUserRepo.EXPECT().FetchUserByUsername("other").Return(&models.StandardUser{
+				StandardUser: models.User{ID: "other-id", Role: roles.ADMIN},
+			}, nil)
+			mockUserRepo.EXPECT().CountUsersByRole(roles.ADMIN).Return(tt.admins, nil)
+			if tt.wantErr == nil {
+				mockUserRepo.EXPECT().UpdateUserRole("other-id", roles.USER).Return(nil)
+				mockAuditService.EXPECT().Record("admin-id", models.AuditUserDemote, "other", gomock.Any(), gomock.Any()).Return(nil)
+			}
+
+			_, err := userService.DemoteUser("admin-id", "other")
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestUserService_ChangeUserRole(t *testing.T) {
+	tests := []struct {
+		name       string
+		from       string
+		to         string
+		wantAction string
+	}{
+		{"user to content editor", roles.USER, roles.CONTENT_EDITOR, models.AuditUserPromote},
+		{"content editor to moderator", roles.CONTENT_EDITOR, roles.MODERATOR, models.AuditUserPromote},
+		{"moderator to content editor", roles.MODERATOR, roles.CONTENT_EDITOR, models.AuditUserDemote},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			teardown := setup(t)
+			defer teardown()
+
+			mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_ROLES).Return(nil)
+			mockUserRepo.EXPECT().FetchUserByUsername("someone").Return(&models.StandardUser{
+				StandardUser: models.User{ID: "someone-id", Role: tt.from},
+			}, nil)
+			mockUserRepo.EXPECT().UpdateUserRole("someone-id", tt.to).Return(nil)
+			mockAuditService.EXPECT().Record("admin-id", tt.wantAction, "someone", gomock.Any(), gomock.Any()).Return(nil)
+
+			alreadyHad, err := userService.ChangeUserRole("admin-id", "someone", tt.to)
+
+			assert.NoError(t, err)
+			assert.False(t, alreadyHad)
+		})
+	}
+}
+
+func TestUserService_ChangeUserRole_Refused(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	denied := fmt.Errorf("%w: the moderator role does not have users:roles", services.ErrPermissionDenied)
+	mockAccessService.EXPECT().Authorize("moderator-id", roles.USERS_ROLES).Return(denied)
+	_, err := userService.ChangeUserRole("moderator-id", "someone", roles.ADMIN)
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+
+	mockAccessService.EXPECT().Authorize("admin-id", roles.USERS_ROLES).Return(nil)
+	_, err = userService.ChangeUserRole("admin-id", "someone", "superuser")
+	assert.ErrorIs(t, err, services.ErrInvalidRole)
+}
+
+func TestUserService_BanUser_StaffNeedsRolePermission(t *testing.T) {
+	teardown := setup(t)
+	defer teardown()
+
+	mockAccessService.EXPECT().Authorize("moderator-id", roles.USERS_BAN).Return(nil)
+	mockUserRepo.EXPECT().FetchUserByUsername("admin").Return(&models.StandardUser{
+		StandardUser: models.User{ID: "admin-id", Role: roles.ADMIN},
+	}, nil)
+	mockAccessService.EXPECT().Authorize("moderator-id", roles.USERS_ROLES).Return(services.ErrPermissionDenied)
+
+	_, err := userService.BanUser("moderator-id", "admin", "spam", time.Time{})
+
+	assert.ErrorIs(t, err, services.ErrPermissionDenied)
+}
@@ -0,0 +1,69 @@
+package credentials
+
+import (
+	"cli-project/pkg/utils/credentials"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSaveAndLoad tests that saved credentials are read back and kept private.
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "codesage", "credentials.json")
+	creds := &credentials.Credentials{
+		Username:  "testuser",
+		Token:     "token",
+		ExpiresAt: time.Now().Add(time.Hour).UTC().Truncate(time.Second),
+	}
+
+	require.NoError(t, credentials.Save(path, creds))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	loaded, err := credentials.Load(path)
+	assert.NoError(t, err)
+	assert.Equal(t, creds, loaded)
+}
+
+// TestLoad_Expired tests that expired credentials are removed.
+func TestLoad_Expired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, credentials.Save(path, &credentials.Credentials{
+		Username:  "testuser",
+		Token:     "token",
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}))
+
+	_, err := credentials.Load(path)
+	assert.Equal(t, credentials.ErrNoCredentials, err)
+
+	_, err = os.Stat(path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+// TestLoad_Missing tests that a missing file is reported as no credentials.
+func TestLoad_Missing(t *testing.T) {
+	_, err := credentials.Load(filepath.Join(t.TempDir(), "credentials.json"))
+	assert.Equal(t, credentials.ErrNoCredentials, err)
+}
+
+// TestRemove tests that removing twice is not an error.
+func TestRemove(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials.json")
+	require.NoError(t, credentials.Save(path, &credentials.Credentials{Token: "token", ExpiresAt: time.Now().Add(time.Hour)}))
+
+	assert.NoError(t, credentials.Remove(path))
+	assert.NoError(t, credentials.Remove(path))
+}
+
+// TestDefaultPath tests the environment override.
+func TestDefaultPath(t *testing.T) {
+	t.Setenv("CODESAGE_CREDENTIALS", "/tmp/creds.json")
+	assert.Equal(t, "/tmp/creds.json", credentials.DefaultPath())
+}
@@ -0,0 +1,73 @@
+package data_cleaning
+
+import (
+	"cli-project/pkg/utils/data_cleaning"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTitleSlugFromLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "problem link",
+			input:    "https://leetcode.com/problems/two-sum/",
+			expected: "two-sum",
+		},
+		{
+			name:     "link with description and mixed case",
+			input:    " https://Leetcode.com/problems/Add-Two-Numbers/description/ ",
+			expected: "add-two-numbers",
+		},
+		{
+			name:     "link without a problem",
+			input:    "https://leetcode.com/explore/",
+			expected: "",
+		},
+		{
+			name:     "empty link",
+			input:    "",
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, data_cleaning.TitleSlugFromLink(tt.input))
+		})
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "plain title",
+			input:    "Two Sum",
+			expected: "two-sum",
+		},
+		{
+			name:     "punctuation is dropped",
+			input:    "Pow(x, n)",
+			expected: "powx-n",
+		},
+		{
+			name:     "roman numerals and hyphens",
+			input:    "Best Time to Buy and Sell Stock II - Revisited",
+			expected: "best-time-to-buy-and-sell-stock-ii-revisited",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, data_cleaning.Slugify(tt.input))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package editor
+
+import (
+	"cli-project/internal/config"
+	"cli-project/pkg/utils/editor"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeEditor creates a script standing in for the user's editor
+func writeEditor(t *testing.T, script string) string {
+	path := filepath.Join(t.TempDir(), "editor.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+script), 0700))
+	return path
+}
+
+// TestCommand tests that the editor comes from the environment, falling back to the default.
+func TestCommand(t *testing.T) {
+	t.Setenv(config.EDITOR_ENV, "code --wait")
+	assert.Equal(t, []string{"code", "--wait"}, editor.Command())
+
+	t.Setenv(config.EDITOR_ENV, "")
+	assert.Equal(t, []string{config.DEFAULT_EDITOR}, editor.Command())
+}
+
+// TestEdit tests that the text is handed to the editor and the saved file is returned.
+func TestEdit(t *testing.T) {
+	t.Setenv(config.EDITOR_ENV, writeEditor(t, `case "$1" in *.md) ;; *) exit 3 ;; esac
+echo "two pointers" >> "$1"
+`))
+
+	edited, err := editor.Edit("# Approach\n", ".md", strings.NewReader(""), nil, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "# Approach\ntwo pointers\n", edited)
+}
+
+// TestEdit_EditorFails tests that a failing editor is reported.
+func TestEdit_EditorFails(t *testing.T) {
+	t.Setenv(config.EDITOR_ENV, writeEditor(t, "exit 1\n"))
+
+	_, err := editor.Edit("text", ".md", strings.NewReader(""), nil, nil)
+
+	assert.Error(t, err)
+}
@@ -0,0 +1,52 @@
+package fuzzy
+
+import (
+	"cli-project/pkg/utils/fuzzy"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	assert.Equal(t, "two sum", fuzzy.Normalize("Two-Sum"))
+	assert.Equal(t, "pow x n", fuzzy.Normalize(" Pow(x, n) "))
+	assert.Equal(t, "", fuzzy.Normalize("--"))
+}
+
+func TestLevenshtein(t *testing.T) {
+	assert.Equal(t, 0, fuzzy.Levenshtein("sum", "sum"))
+	assert.Equal(t, 1, fuzzy.Levenshtein("sum", "sun"))
+	assert.Equal(t, 3, fuzzy.Levenshtein("", "abc"))
+	assert.Equal(t, 3, fuzzy.Levenshtein("kitten", "sitting"))
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		text  string
+		min   float64
+		max   float64
+	}{
+		{"same words with different punctuation", "two sum", "Two-Sum", 1, 1},
+		{"prefix of the text", "two", "two sum", 0.95, 0.95},
+		{"words run together", "twosum", "two sum", 0.8, 0.99},
+		{"typo in a word", "tow sum", "two sum", 0.5, 0.9},
+		{"typo in a long word", "palindrom", "valid palindrome", 0.7, 0.9},
+		{"unrelated text", "graph", "two sum", 0, 0},
+		{"empty query", "", "two sum", 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := fuzzy.Score(tt.query, tt.text)
+			assert.GreaterOrEqual(t, score, tt.min)
+			assert.LessOrEqual(t, score, tt.max)
+		})
+	}
+}
+
+func TestScore_RanksCloserTextsFirst(t *testing.T) {
+	assert.Greater(t, fuzzy.Score("two sum", "two sum"), fuzzy.Score("two sum", "two sum ii input array is sorted"))
+	assert.Greater(t, fuzzy.Score("3sum", "3sum"), fuzzy.Score("3sum", "3sum closest"))
+}
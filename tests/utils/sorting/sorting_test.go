@@ -0,0 +1,21 @@
+package sorting
+
+import (
+	"cli-project/pkg/utils/sorting"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLessQuestionID(t *testing.T) {
+	assert.True(t, sorting.LessQuestionID("2", "10"))
+	assert.False(t, sorting.LessQuestionID("10", "2"))
+	assert.False(t, sorting.LessQuestionID("7", "7"))
+	assert.True(t, sorting.LessQuestionID("10", "abc"))
+}
+
+func TestDifficultyRank(t *testing.T) {
+	assert.Less(t, sorting.DifficultyRank("easy"), sorting.DifficultyRank("medium"))
+	assert.Less(t, sorting.DifficultyRank("medium"), sorting.DifficultyRank("hard"))
+	assert.Less(t, sorting.DifficultyRank("hard"), sorting.DifficultyRank("unknown"))
+}
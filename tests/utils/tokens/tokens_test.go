@@ -0,0 +1,61 @@
+package tokens
+
+import (
+	"cli-project/pkg/utils/tokens"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSignAndVerify tests that a signed payload verifies only with the same secret.
+func TestSignAndVerify(t *testing.T) {
+	secret := []byte("secret")
+	token := tokens.Sign(secret, []byte(`{"sid":"abc"}`))
+
+	payload, err := tokens.Verify(secret, token)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"sid":"abc"}`, string(payload))
+
+	_, err = tokens.Verify([]byte("other"), token)
+	assert.Equal(t, tokens.ErrInvalidToken, err)
+}
+
+// TestVerify_Malformed tests that broken tokens are rejected.
+func TestVerify_Malformed(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "Empty", token: ""},
+		{name: "No signature", token: "eyJzaWQiOiJhYmMifQ"},
+		{name: "Bad encoding", token: "!!!.???"},
+		{name: "Wrong signature", token: "eyJzaWQiOiJhYmMifQ.c2lnbmF0dXJl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tokens.Verify([]byte("secret"), tt.token)
+			assert.Equal(t, tokens.ErrInvalidToken, err)
+		})
+	}
+}
+
+// TestLoadOrCreateSecret tests that the secret is generated once and reused.
+func TestLoadOrCreateSecret(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys", "session.key")
+
+	first, err := tokens.LoadOrCreateSecret(path)
+	require.NoError(t, err)
+	assert.Len(t, first, 32)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	second, err := tokens.LoadOrCreateSecret(path)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
@@ -4,6 +4,7 @@ import (
 	"cli-project/pkg/validation"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestValidateEmail(t *testing.T) {
@@ -170,6 +171,9 @@ func TestValidateQuestionLink(t *testing.T) {
 		{"Leetcode link without scheme", "Leetcode.com/problems/example-problem/", ""},
 		{"Leetcode link without host", "https://example.com/problems/example-problem/", ""},
 		{"Non-Leetcode link", "https://example.com", ""},
+		{"Surrounding whitespace is trimmed", " https://leetcode.com/problems/two-sum ", "https://leetcode.com/problems/two-sum"},
+		{"Subdomain keeps its case", "https://www.LeetCode.com/problems/Two-Sum", "https://www.LeetCode.com/problems/Two-Sum"},
+		{"Lookalike host", "https://notleetcode.com/problems/two-sum", ""},
 	}
 
 	for _, tt := range tests {
@@ -202,3 +206,242 @@ func TestValidateQuestionID(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMinutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected time.Duration
+		valid    bool
+	}{
+		{"Valid minutes", "25", 25 * time.Minute, true},
+		{"Valid minutes with spaces", " 90 ", 90 * time.Minute, true},
+		{"Zero minutes", "0", 0, false},
+		{"More than a day", "1441", 0, false},
+		{"Not a number", "half an hour", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validation.ValidateMinutes(tt.input)
+			if result != tt.expected || (err == nil) != tt.valid {
+				t.Errorf("ValidateMinutes(%q) = %v, %v, expected %v", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateRecallRating(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+		valid    bool
+	}{
+		{"Named rating", "good", 4, true},
+		{"Named rating with case and spaces", " Easy ", 5, true},
+		{"Forgotten", "again", 1, true},
+		{"Unknown rating", "meh", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validation.ValidateRecallRating(tt.input)
+			if result != tt.expected || (err == nil) != tt.valid {
+				t.Errorf("ValidateRecallRating(%q) = %v, %v, expected %v", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateProgression(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Default progression", "", "gradual"},
+		{"Gradual progression", "Gradual", "gradual"},
+		{"Fixed difficulty", " hard ", "hard"},
+		{"Invalid progression", "sideways", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := validation.ValidateProgression(tt.input)
+			if result != tt.expected {
+				t.Errorf("ValidateProgression(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateExportFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"CSV", "CSV", "csv"},
+		{"JSON", " json ", "json"},
+		{"Markdown", "markdown", "markdown"},
+		{"Markdown short form", "md", "markdown"},
+		{"Empty format", "", ""},
+		{"Invalid format", "xlsx", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := validation.ValidateExportFormat(tt.input)
+			if result != tt.expected {
+				t.Errorf("ValidateExportFormat(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateSolveStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{"Solved", "Solved", "solved", false},
+		{"Unsolved", " unsolved ", "unsolved", false},
+		{"Any", "any", "", false},
+		{"Empty status", "", "", false},
+		{"Invalid status", "attempted", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validation.ValidateSolveStatus(tt.input)
+			if result != tt.expected || (err != nil) != tt.expectErr {
+				t.Errorf("ValidateSolveStatus(%q) = %v, %v, expected %v", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateSortField(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Allowed field", "Title", "title"},
+		{"Spaces for underscores", " last seen ", "last_seen"},
+		{"Dashes for underscores", "last-seen", "last_seen"},
+		{"Empty field", "", ""},
+		{"Field not allowed", "email", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := validation.ValidateSortField(tt.input, "id", "title", "last_seen")
+			if result != tt.expected {
+				t.Errorf("ValidateSortField(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateStudyListName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Valid name", "Blind 75", "Blind 75"},
+		{"Extra spaces are collapsed", "  Google   onsite prep ", "Google onsite prep"},
+		{"Too short", "x", ""},
+		{"Too long", strings.Repeat("a", 61), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := validation.ValidateStudyListName(tt.input)
+			if result != tt.expected {
+				t.Errorf("ValidateStudyListName(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateAnnouncementAudience(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Empty is everyone", "", "everyone"},
+		{"All is everyone", "All", "everyone"},
+		{"American spelling", "organization", "organisation"},
+		{"Country", " Country ", "country"},
+		{"Invalid audience", "team", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, _ := validation.ValidateAnnouncementAudience(tt.input)
+			if result != tt.expected {
+				t.Errorf("ValidateAnnouncementAudience(%q) = %v, expected %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateExpiry(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		name      string
+		input     string
+		expected  time.Time
+		expectErr bool
+	}{
+		{"Empty never expires", "", time.Time{}, false},
+		{"Never", "Never", time.Time{}, false},
+		{"Date expires at the end of the day", "2024-05-12", time.Date(2024, 5, 13, 0, 0, 0, 0, time.Local), false},
+		{"Days", "7d", now.AddDate(0, 0, 7), false},
+		{"Duration", "36h", now.Add(36 * time.Hour), false},
+		{"Past date", "2024-05-01", time.Time{}, true},
+		{"Invalid", "next week", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validation.ValidateExpiry(tt.input, now)
+			if (err != nil) != tt.expectErr || !result.Equal(tt.expected) {
+				t.Errorf("ValidateExpiry(%q) = %v, %v, expected %v", tt.input, result, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateDateRange(t *testing.T) {
+	tests := []struct {
+		name      string
+		since     string
+		until     string
+		from      time.Time
+		to        time.Time
+		expectErr bool
+	}{
+		{"Empty", "", "", time.Time{}, time.Time{}, false},
+		{"Dates include the whole last day", "2024-05-01", "2024-05-10", time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local), time.Date(2024, 5, 11, 0, 0, 0, 0, time.Local), false},
+		{"Same day", "2024-05-01", "2024-05-01", time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local), time.Date(2024, 5, 2, 0, 0, 0, 0, time.Local), false},
+		{"Timestamp", "2024-05-01T10:30:00Z", "", time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC), time.Time{}, false},
+		{"Start after end", "2024-05-10", "2024-05-01", time.Time{}, time.Time{}, true},
+		{"Invalid", "last week", "", time.Time{}, time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			from, to, err := validation.ValidateDateRange(tt.since, tt.until)
+			if (err != nil) != tt.expectErr || !from.Equal(tt.from) || !to.Equal(tt.to) {
+				t.Errorf("ValidateDateRange(%q, %q) = %v, %v, %v, expected %v, %v", tt.since, tt.until, from, to, err, tt.from, tt.to)
+			}
+		})
+	}
+}